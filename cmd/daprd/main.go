@@ -74,9 +74,10 @@ import (
 
 	// Name resolutions
 	nr "github.com/dapr/components-contrib/nameresolution"
-	nr_kubernetes "github.com/dapr/components-contrib/nameresolution/kubernetes"
-	nr_mdns "github.com/dapr/components-contrib/nameresolution/mdns"
 	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	nr_kubernetes "github.com/dapr/dapr/pkg/components/nameresolution/kubernetes"
+	nr_local "github.com/dapr/dapr/pkg/components/nameresolution/local"
+	nr_mdns "github.com/dapr/dapr/pkg/components/nameresolution/mdns"
 
 	// Bindings
 	"github.com/dapr/components-contrib/bindings"
@@ -116,7 +117,9 @@ import (
 	"github.com/dapr/components-contrib/middleware/http/oauth2clientcredentials"
 	"github.com/dapr/components-contrib/middleware/http/opa"
 	"github.com/dapr/components-contrib/middleware/http/ratelimit"
+	grpc_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
+	grpc_ratelimit "github.com/dapr/dapr/pkg/middleware/grpc/ratelimit"
 	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/valyala/fasthttp"
 )
@@ -265,6 +268,9 @@ func main() {
 			nr_loader.New("kubernetes", func() nr.Resolver {
 				return nr_kubernetes.NewResolver(logContrib)
 			}),
+			nr_loader.New("local", func() nr.Resolver {
+				return nr_local.NewResolver(logContrib)
+			}),
 		),
 		runtime.WithInputBindings(
 			bindings_loader.NewInput("aws.sqs", func() bindings.InputBinding {
@@ -418,6 +424,9 @@ func main() {
 				return handler
 			}),
 		),
+		runtime.WithGRPCMiddleware(
+			grpc_middleware_loader.New("ratelimit", grpc_ratelimit.NewRateLimitMiddleware),
+		),
 	)
 	if err != nil {
 		log.Fatalf("fatal error from runtime: %s", err)
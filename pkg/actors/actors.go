@@ -12,6 +12,7 @@ import (
 	nethttp "net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -33,6 +34,7 @@ import (
 	"github.com/dapr/dapr/pkg/retry"
 	"github.com/dapr/dapr/pkg/runtime/security"
 	"github.com/mitchellh/mapstructure"
+	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -60,6 +62,7 @@ type Actors interface {
 	DeleteTimer(ctx context.Context, req *DeleteTimerRequest) error
 	IsActorHosted(ctx context.Context, req *ActorHostedRequest) bool
 	GetActiveActorsCount(ctx context.Context) []ActiveActorsCount
+	GetActorRuntimeMetadata() ActorRuntimeMetadata
 }
 
 type actorsRuntime struct {
@@ -82,7 +85,7 @@ type actorsRuntime struct {
 	evaluationLock      *sync.RWMutex
 	evaluationBusy      bool
 	evaluationChan      chan bool
-	appHealthy          bool
+	appHealthy          int32
 	certChain           *dapr_credentials.CertChain
 	tracingSpec         config.TracingSpec
 }
@@ -93,6 +96,12 @@ type ActiveActorsCount struct {
 	Count int    `json:"count"`
 }
 
+// ActorRuntimeMetadata describes the actor types hosted by the app and the placement table in use
+type ActorRuntimeMetadata struct {
+	HostedActorTypes      []string `json:"hostedActorTypes"`
+	PlacementTableVersion string   `json:"placementTableVersion"`
+}
+
 const (
 	lockOperation          = "lock"
 	unlockOperation        = "unlock"
@@ -126,7 +135,7 @@ func NewActors(
 		evaluationLock:      &sync.RWMutex{},
 		evaluationBusy:      false,
 		evaluationChan:      make(chan bool),
-		appHealthy:          true,
+		appHealthy:          1,
 		certChain:           certChain,
 		tracingSpec:         tracingSpec,
 	}
@@ -160,11 +169,36 @@ func (a *actorsRuntime) startAppHealthCheck(opts ...health.Option) {
 		return
 	}
 
-	healthAddress := fmt.Sprintf("%s/healthz", a.appChannel.GetBaseAddress())
-	ch := health.StartEndpointHealthCheck(healthAddress, opts...)
-	for {
-		a.appHealthy = <-ch
+	if a.config.AppHealth != nil {
+		a.setAppHealthy(a.config.AppHealth.IsHealthy())
+		a.config.AppHealth.OnHealthChange(a.setAppHealthy)
+		return
+	}
+
+	// the app is probed through the app channel, which reaches it over TLS or on its Unix domain socket
+	prober, ok := a.appChannel.(channel.HealthProbeAppChannel)
+	if !ok {
+		log.Warn("actors: the app channel can't probe the health of the app")
+		return
+	}
+	appHealth := health.NewAppHealth(func(ctx context.Context) error {
+		return prober.HealthProbe(ctx, "/healthz")
+	}, opts...)
+	appHealth.OnHealthChange(a.setAppHealthy)
+	appHealth.Start(context.Background())
+}
+
+// setAppHealthy records the health status of the app, which is read by the placement heartbeat
+func (a *actorsRuntime) setAppHealthy(healthy bool) {
+	var v int32
+	if healthy {
+		v = 1
 	}
+	atomic.StoreInt32(&a.appHealthy, v)
+}
+
+func (a *actorsRuntime) isAppHealthy() bool {
+	return atomic.LoadInt32(&a.appHealthy) == 1
 }
 
 func (a *actorsRuntime) constructCompositeKey(keys ...string) string {
@@ -237,7 +271,9 @@ func (a *actorsRuntime) startDeactivationTicker(interval, actorIdleTimeout time.
 
 func (a *actorsRuntime) Call(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	if a.placementBlock {
+		_, span := diag.StartChildSpan(ctx, "WaitForActorPlacement", trace.SpanKindUnspecified, a.tracingSpec)
 		<-a.placementSignal
+		endSpan(span, nil, nil)
 	}
 
 	actor := req.Actor()
@@ -288,10 +324,17 @@ func (a *actorsRuntime) callRemoteActorWithRetry(
 	return nil, errors.Errorf("failed to invoke target %s after %v retries", targetAddress, numRetries)
 }
 
-func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.InvokeMethodRequest) (resp *invokev1.InvokeMethodResponse, err error) {
 	actorTypeID := req.Actor()
 	key := a.constructCompositeKey(actorTypeID.GetActorType(), actorTypeID.GetActorId())
 
+	method := req.Message().Method
+	spanName := fmt.Sprintf("CallLocalActor/%s/%s", actorTypeID.GetActorType(), method)
+	ctx, span := diag.StartChildSpan(ctx, spanName, trace.SpanKindUnspecified, a.tracingSpec)
+	defer func() {
+		endSpan(span, diag.ConstructActorSpanAttributes(actorTypeID.GetActorType(), actorTypeID.GetActorId(), method), err)
+	}()
+
 	val, _ := a.actorsTable.LoadOrStore(key, newActor(actorTypeID.GetActorType(), actorTypeID.GetActorId()))
 	act := val.(*actor)
 	act.lock()
@@ -305,7 +348,7 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 	} else {
 		req.Message().HttpExtension.Verb = commonv1pb.HTTPExtension_PUT
 	}
-	resp, err := a.appChannel.InvokeMethod(ctx, req)
+	resp, err = a.appChannel.InvokeMethod(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -322,21 +365,39 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 func (a *actorsRuntime) callRemoteActor(
 	ctx context.Context,
 	targetAddress, targetID string,
-	req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	req *invokev1.InvokeMethodRequest) (resp *invokev1.InvokeMethodResponse, err error) {
+	actor := req.Actor()
+	method := req.Message().Method
+	spanName := fmt.Sprintf("CallRemoteActor/%s/%s", actor.GetActorType(), method)
+	ctx, span := diag.StartChildSpan(ctx, spanName, trace.SpanKindClient, a.tracingSpec)
+	defer func() {
+		endSpan(span, diag.ConstructActorSpanAttributes(actor.GetActorType(), actor.GetActorId(), method), err)
+	}()
+
 	conn, err := a.grpcConnectionFn(targetAddress, targetID, a.config.Namespace, false, false)
 	if err != nil {
 		return nil, err
 	}
 
-	span := diag_utils.SpanFromContext(ctx)
-	ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
+	callSpan := diag_utils.SpanFromContext(ctx)
+	ctx = diag.SpanContextToGRPCMetadata(ctx, callSpan.SpanContext())
 	client := internalv1pb.NewServiceInvocationClient(conn)
-	resp, err := client.CallActor(ctx, req.Proto())
+	internalResp, err := client.CallActor(ctx, req.Proto())
 	if err != nil {
 		return nil, err
 	}
 
-	return invokev1.InternalInvokeResponse(resp)
+	return invokev1.InternalInvokeResponse(internalResp)
+}
+
+// endSpan ends the span of an actor operation, with the status of its error
+func endSpan(span *trace.Span, attributes map[string]string, err error) {
+	if span == nil {
+		return
+	}
+	diag.AddAttributesToSpan(span, attributes)
+	diag.UpdateSpanStatusFromGRPCError(span, err)
+	span.End()
 }
 
 func (a *actorsRuntime) isActorLocal(targetActorAddress, hostAddress string, grpcPort int) bool {
@@ -344,11 +405,16 @@ func (a *actorsRuntime) isActorLocal(targetActorAddress, hostAddress string, grp
 		targetActorAddress == fmt.Sprintf("%s:%v", hostAddress, grpcPort)
 }
 
-func (a *actorsRuntime) GetState(ctx context.Context, req *GetStateRequest) (*StateResponse, error) {
+func (a *actorsRuntime) GetState(ctx context.Context, req *GetStateRequest) (_ *StateResponse, err error) {
 	if a.store == nil {
 		return nil, errors.New("actors: state store does not exist or incorrectly configured")
 	}
 
+	_, span := diag.StartChildSpan(ctx, fmt.Sprintf("GetActorState/%s", req.ActorType), trace.SpanKindClient, a.tracingSpec)
+	defer func() {
+		endSpan(span, diag.ConstructActorStateSpanAttributes(req.ActorType, req.ActorID, "get"), err)
+	}()
+
 	partitionKey := a.constructCompositeKey(a.config.AppID, req.ActorType, req.ActorID)
 	metadata := map[string]string{metadataPartitionKey: partitionKey}
 
@@ -366,10 +432,15 @@ func (a *actorsRuntime) GetState(ctx context.Context, req *GetStateRequest) (*St
 	}, nil
 }
 
-func (a *actorsRuntime) TransactionalStateOperation(ctx context.Context, req *TransactionalRequest) error {
+func (a *actorsRuntime) TransactionalStateOperation(ctx context.Context, req *TransactionalRequest) (err error) {
 	if a.store == nil {
 		return errors.New("actors: state store does not exist or incorrectly configured")
 	}
+
+	_, span := diag.StartChildSpan(ctx, fmt.Sprintf("ExecuteActorStateTransaction/%s", req.ActorType), trace.SpanKindClient, a.tracingSpec)
+	defer func() {
+		endSpan(span, diag.ConstructActorStateSpanAttributes(req.ActorType, req.ActorID, "transaction"), err)
+	}()
 	operations := []state.TransactionalStateOperation{}
 	partitionKey := a.constructCompositeKey(a.config.AppID, req.ActorType, req.ActorID)
 	metadata := map[string]string{metadataPartitionKey: partitionKey}
@@ -416,11 +487,10 @@ func (a *actorsRuntime) TransactionalStateOperation(ctx context.Context, req *Tr
 		return errors.New(incompatibleStateStore)
 	}
 
-	err := transactionalStore.Multi(&state.TransactionalStateRequest{
+	return transactionalStore.Multi(&state.TransactionalStateRequest{
 		Operations: operations,
 		Metadata:   metadata,
 	})
-	return err
 }
 
 func (a *actorsRuntime) IsActorHosted(ctx context.Context, req *ActorHostedRequest) bool {
@@ -479,7 +549,7 @@ func (a *actorsRuntime) connectToPlacementService(placementAddress, hostAddress
 
 			// appHealthy is the health status of actor service application. This allows placement to update
 			// memberlist and hashing table quickly.
-			if !a.appHealthy {
+			if !a.isAppHealthy() {
 				// app is unresponsive, close the stream and disconnect from the placement service.
 				// Then Placement will remove this host from the member list.
 				err := stream.CloseSend()
@@ -822,6 +892,9 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 		return err
 	}
 
+	// reminder firings are linked to the trace which created the reminder
+	link, _ := diag.SpanContextFromW3CString(reminder.TraceParent)
+
 	go func(reminder *Reminder, stop chan bool) {
 		now := time.Now().UTC()
 		initialDuration := nextInvokeTime.Sub(now)
@@ -836,7 +909,7 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 			break
 		}
 
-		err = a.executeReminder(reminder.ActorType, reminder.ActorID, reminder.DueTime, reminder.Period, reminder.Name, reminder.Data)
+		err = a.executeReminder(reminder.ActorType, reminder.ActorID, reminder.DueTime, reminder.Period, reminder.Name, reminder.Data, link)
 		if err != nil {
 			log.Errorf("error executing reminder: %s", err)
 		}
@@ -858,7 +931,7 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 				for {
 					select {
 					case <-ticker.C:
-						err := a.executeReminder(actorType, actorID, dueTime, period, reminder, data)
+						err := a.executeReminder(actorType, actorID, dueTime, period, reminder, data, link)
 						if err != nil {
 							log.Debugf("error invoking reminder on actor %s: %s", a.constructCompositeKey(actorType, actorID), err)
 						}
@@ -883,7 +956,12 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 	return nil
 }
 
-func (a *actorsRuntime) executeReminder(actorType, actorID, dueTime, period, reminder string, data interface{}) error {
+func (a *actorsRuntime) executeReminder(actorType, actorID, dueTime, period, reminder string, data interface{}, link trace.SpanContext) (err error) {
+	ctx, span := diag.StartLinkedRootSpan(fmt.Sprintf("ActorReminder/%s/%s", actorType, reminder), link, a.tracingSpec)
+	defer func() {
+		endSpan(span, diag.ConstructActorReminderSpanAttributes(actorType, actorID, reminder), err)
+	}()
+
 	r := ReminderResponse{
 		DueTime: dueTime,
 		Period:  period,
@@ -894,17 +972,17 @@ func (a *actorsRuntime) executeReminder(actorType, actorID, dueTime, period, rem
 		return err
 	}
 
-	log.Debugf("executing reminder %s for actor type %s with id %s", reminder, actorType, actorID)
+	log.WithContext(ctx).Debugf("executing reminder %s for actor type %s with id %s", reminder, actorType, actorID)
 	req := invokev1.NewInvokeMethodRequest(fmt.Sprintf("remind/%s", reminder))
 	req.WithActor(actorType, actorID)
 	req.WithRawData(b, invokev1.JSONContentType)
 
-	_, err = a.callLocalActor(context.Background(), req)
+	_, err = a.callLocalActor(ctx, req)
 	if err == nil {
 		key := a.constructCompositeKey(actorType, actorID)
 		a.updateReminderTrack(key, reminder)
 	} else {
-		log.Debugf("error execution of reminder %s for actor type %s with id %s: %s", reminder, actorType, actorID, err)
+		log.WithContext(ctx).Debugf("error execution of reminder %s for actor type %s with id %s: %s", reminder, actorType, actorID, err)
 	}
 	return err
 }
@@ -975,6 +1053,9 @@ func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderR
 		DueTime:        req.DueTime,
 		RegisteredTime: time.Now().UTC().Format(time.RFC3339),
 	}
+	if span := diag_utils.SpanFromContext(ctx); span != nil {
+		reminder.TraceParent = diag.SpanContextToW3CString(span.SpanContext())
+	}
 
 	reminders, err := a.getRemindersForActorType(req.ActorType)
 	if err != nil {
@@ -1028,6 +1109,12 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 	stop := make(chan bool, 1)
 	a.activeTimers.Store(timerKey, stop)
 
+	// timer firings are linked to the trace which created the timer
+	var link trace.SpanContext
+	if span := diag_utils.SpanFromContext(ctx); span != nil {
+		link = span.SpanContext()
+	}
+
 	go func(ticker *time.Ticker, stop chan (bool), actorType, actorID, name, dueTime, period, callback string, data interface{}) {
 		if dueTime != "" {
 			d, err := time.ParseDuration(dueTime)
@@ -1045,7 +1132,7 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 			break
 		}
 
-		err := a.executeTimer(actorType, actorID, name, dueTime, period, callback, data)
+		err := a.executeTimer(actorType, actorID, name, dueTime, period, callback, data, link)
 		if err != nil {
 			log.Debugf("error invoking timer on actor %s: %s", actorKey, err)
 		}
@@ -1057,7 +1144,7 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 			case <-ticker.C:
 				_, exists := a.actorsTable.Load(actorKey)
 				if exists {
-					err := a.executeTimer(actorType, actorID, name, dueTime, period, callback, data)
+					err := a.executeTimer(actorType, actorID, name, dueTime, period, callback, data, link)
 					if err != nil {
 						log.Debugf("error invoking timer on actor %s: %s", actorKey, err)
 					}
@@ -1087,7 +1174,12 @@ func (a *actorsRuntime) configureTicker(d time.Duration) *time.Ticker {
 	return t
 }
 
-func (a *actorsRuntime) executeTimer(actorType, actorID, name, dueTime, period, callback string, data interface{}) error {
+func (a *actorsRuntime) executeTimer(actorType, actorID, name, dueTime, period, callback string, data interface{}, link trace.SpanContext) (err error) {
+	ctx, span := diag.StartLinkedRootSpan(fmt.Sprintf("ActorTimer/%s/%s", actorType, name), link, a.tracingSpec)
+	defer func() {
+		endSpan(span, diag.ConstructActorTimerSpanAttributes(actorType, actorID, name), err)
+	}()
+
 	t := TimerResponse{
 		Callback: callback,
 		Data:     data,
@@ -1099,13 +1191,13 @@ func (a *actorsRuntime) executeTimer(actorType, actorID, name, dueTime, period,
 		return err
 	}
 
-	log.Debugf("executing timer %s for actor type %s with id %s", name, actorType, actorID)
+	log.WithContext(ctx).Debugf("executing timer %s for actor type %s with id %s", name, actorType, actorID)
 	req := invokev1.NewInvokeMethodRequest(fmt.Sprintf("timer/%s", name))
 	req.WithActor(actorType, actorID)
 	req.WithRawData(b, invokev1.JSONContentType)
-	_, err = a.callLocalActor(context.Background(), req)
+	_, err = a.callLocalActor(ctx, req)
 	if err != nil {
-		log.Debugf("error execution of timer %s for actor type %s with id %s: %s", name, actorType, actorID, err)
+		log.WithContext(ctx).Debugf("error execution of timer %s for actor type %s with id %s: %s", name, actorType, actorID, err)
 	}
 	return err
 }
@@ -1226,6 +1318,18 @@ func (a *actorsRuntime) GetActiveActorsCount(ctx context.Context) []ActiveActors
 	return activeActorsCount
 }
 
+func (a *actorsRuntime) GetActorRuntimeMetadata() ActorRuntimeMetadata {
+	a.placementTableLock.RLock()
+	defer a.placementTableLock.RUnlock()
+
+	hostedActorTypes := make([]string, len(a.config.HostedActorTypes))
+	copy(hostedActorTypes, a.config.HostedActorTypes)
+	return ActorRuntimeMetadata{
+		HostedActorTypes:      hostedActorTypes,
+		PlacementTableVersion: a.placementTables.Version,
+	}
+}
+
 // ValidateHostEnvironment validates that actors can be initialized properly given a set of parameters
 // And the mode the runtime is operating in.
 func ValidateHostEnvironment(mTLSEnabled bool, mode modes.DaprMode, namespace string) error {
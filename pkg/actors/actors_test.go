@@ -17,12 +17,14 @@ import (
 	"github.com/dapr/components-contrib/state"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/health"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/modes"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opencensus.io/trace"
 )
 
 const (
@@ -186,7 +188,7 @@ func TestTimerExecution(t *testing.T) {
 	actorType, actorID := getTestActorTypeAndID()
 	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
 
-	err := testActorsRuntime.executeTimer(actorType, actorID, "timer1", "2s", "2s", "callback", "data")
+	err := testActorsRuntime.executeTimer(actorType, actorID, "timer1", "2s", "2s", "callback", "data", trace.SpanContext{})
 	assert.Nil(t, err)
 }
 
@@ -195,7 +197,7 @@ func TestTimerExecutionZeroDuration(t *testing.T) {
 	actorType, actorID := getTestActorTypeAndID()
 	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
 
-	err := testActorsRuntime.executeTimer(actorType, actorID, "timer1", "0ms", "0ms", "callback", "data")
+	err := testActorsRuntime.executeTimer(actorType, actorID, "timer1", "0ms", "0ms", "callback", "data", trace.SpanContext{})
 	assert.Nil(t, err)
 }
 
@@ -204,7 +206,7 @@ func TestReminderExecution(t *testing.T) {
 	actorType, actorID := getTestActorTypeAndID()
 	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
 
-	err := testActorsRuntime.executeReminder(actorType, actorID, "2s", "2s", "reminder1", "data")
+	err := testActorsRuntime.executeReminder(actorType, actorID, "2s", "2s", "reminder1", "data", trace.SpanContext{})
 	assert.Nil(t, err)
 }
 
@@ -213,7 +215,7 @@ func TestReminderExecutionZeroDuration(t *testing.T) {
 	actorType, actorID := getTestActorTypeAndID()
 	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
 
-	err := testActorsRuntime.executeReminder(actorType, actorID, "0ms", "0ms", "reminder0", "data")
+	err := testActorsRuntime.executeReminder(actorType, actorID, "0ms", "0ms", "reminder0", "data", trace.SpanContext{})
 	assert.Nil(t, err)
 }
 
@@ -256,6 +258,21 @@ func TestCreateReminder(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCreateReminderLinksTrace(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx, span := trace.StartSpan(context.Background(), "CreateReminder", trace.WithSampler(trace.AlwaysSample()))
+	defer span.End()
+
+	reminder := createReminderData(actorID, actorType, "reminder1", "1s", "1s", "a")
+	err := testActorsRuntime.CreateReminder(ctx, &reminder)
+	assert.Nil(t, err)
+
+	r, exists := testActorsRuntime.getReminder(&reminder)
+	assert.True(t, exists)
+	assert.Equal(t, diag.SpanContextToW3CString(span.SpanContext()), r.TraceParent)
+}
+
 func TestOverrideReminder(t *testing.T) {
 	ctx := context.Background()
 	t.Run("override data", func(t *testing.T) {
@@ -817,13 +834,65 @@ func TestActiveActorsCount(t *testing.T) {
 	})
 }
 
+func TestGetActorRuntimeMetadata(t *testing.T) {
+	testActorRuntime := newTestActorsRuntime()
+	testActorRuntime.config.HostedActorTypes = []string{"cat", "dog"}
+	testActorRuntime.placementTables.Version = "3"
+
+	md := testActorRuntime.GetActorRuntimeMetadata()
+	assert.Equal(t, []string{"cat", "dog"}, md.HostedActorTypes)
+	assert.Equal(t, "3", md.PlacementTableVersion)
+}
+
+// healthProbeAppChannel is an app channel probing the health of the app with probe
+type healthProbeAppChannel struct {
+	*channelt.MockAppChannel
+	probe func(ctx context.Context, path string) error
+}
+
+func (c *healthProbeAppChannel) HealthProbe(ctx context.Context, path string) error {
+	return c.probe(ctx, path)
+}
+
 func TestActorsAppHealthCheck(t *testing.T) {
 	testActorRuntime := newTestActorsRuntime()
 	testActorRuntime.config.HostedActorTypes = []string{"actor1"}
-	go testActorRuntime.startAppHealthCheck(health.WithFailureThreshold(1), health.WithInterval(1))
+	probedPath := make(chan string, 1)
+	testActorRuntime.appChannel = &healthProbeAppChannel{
+		MockAppChannel: new(channelt.MockAppChannel),
+		probe: func(ctx context.Context, path string) error {
+			select {
+			case probedPath <- path:
+			default:
+			}
+			return assert.AnError
+		},
+	}
+	testActorRuntime.startAppHealthCheck(health.WithFailureThreshold(1), health.WithInterval(1), health.WithInitialDelay(0))
 
-	time.Sleep(time.Second * 2)
-	assert.False(t, testActorRuntime.appHealthy)
+	assert.Equal(t, "/healthz", <-probedPath)
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, testActorRuntime.isAppHealthy())
+}
+
+func TestActorsAppHealthCheckFollowsAppHealth(t *testing.T) {
+	appHealth := health.NewAppHealth(func(ctx context.Context) error {
+		return assert.AnError
+	}, health.WithInitialDelay(0), health.WithInterval(time.Millisecond*10), health.WithFailureThreshold(1))
+
+	testActorRuntime := newTestActorsRuntime()
+	testActorRuntime.config.HostedActorTypes = []string{"actor1"}
+	testActorRuntime.config.AppHealth = appHealth
+	testActorRuntime.startAppHealthCheck()
+	assert.True(t, testActorRuntime.isAppHealthy())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	appHealth.Start(ctx)
+	for appHealth.IsHealthy() {
+		time.Sleep(time.Millisecond * 5)
+	}
+	assert.False(t, testActorRuntime.isAppHealthy())
 }
 
 func TestConstructCompositeKeyWithThreeArgs(t *testing.T) {
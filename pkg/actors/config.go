@@ -5,7 +5,11 @@
 
 package actors
 
-import "time"
+import (
+	"time"
+
+	"github.com/dapr/dapr/pkg/health"
+)
 
 // Config is the actor runtime configuration
 type Config struct {
@@ -20,6 +24,9 @@ type Config struct {
 	DrainOngoingCallTimeout       time.Duration
 	DrainRebalancedActors         bool
 	Namespace                     string
+	// AppHealth reports the health of the app if the app health check is enabled. Otherwise the actor runtime
+	// probes the health endpoint of the app itself.
+	AppHealth *health.AppHealth
 }
 
 const (
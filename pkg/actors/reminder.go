@@ -14,4 +14,6 @@ type Reminder struct {
 	Period         string      `json:"period"`
 	DueTime        string      `json:"dueTime"`
 	RegisteredTime string      `json:"registeredTime,omitempty"`
+	// TraceParent is the W3C trace context of the request which created the reminder
+	TraceParent string `json:"traceParent,omitempty"`
 }
@@ -27,13 +27,133 @@ type ConfigurationSpec struct {
 	// +optional
 	HTTPPipelineSpec PipelineSpec `json:"httpPipeline,omitempty"`
 	// +optional
+	GRPCPipelineSpec PipelineSpec `json:"grpcPipeline,omitempty"`
+	// +optional
 	TracingSpec TracingSpec `json:"tracing,omitempty"`
 	// +optional
 	MTLSSpec MTLSSpec `json:"mtls,omitempty"`
 	// +optional
+	MetricSpec MetricSpec `json:"metric,omitempty"`
+	// +optional
 	Secrets SecretsSpec `json:"secrets,omitempty"`
 	// +optional
+	Bindings BindingsSpec `json:"bindings,omitempty"`
+	// +optional
 	AccessControlSpec AccessControlSpec `json:"accessControl,omitempty"`
+	// +optional
+	ServiceInvocation ServiceInvocationSpec `json:"serviceInvocation,omitempty"`
+	// +optional
+	NameResolution NameResolutionSpec `json:"nameResolution,omitempty"`
+	// +optional
+	APITokens APITokensSpec `json:"apiTokens,omitempty"`
+	// +optional
+	JWTAuthentication JWTAuthenticationSpec `json:"jwtAuthentication,omitempty"`
+	// +optional
+	ComponentHealth ComponentHealthSpec `json:"componentHealth,omitempty"`
+}
+
+// ComponentHealthSpec is the spec for probing the health of the components
+type ComponentHealthSpec struct {
+	// +optional
+	ProbeInterval string `json:"probeInterval,omitempty"`
+	// +optional
+	ProbeTimeout string `json:"probeTimeout,omitempty"`
+	// +optional
+	RequiredComponents []string `json:"requiredComponents,omitempty"`
+}
+
+// MetricSpec is the spec for the metrics and the rules bounding the cardinality of their tags
+type MetricSpec struct {
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// +optional
+	Rules []MetricRule `json:"rules,omitempty"`
+}
+
+// MetricRule is the spec for the tags of a metric
+type MetricRule struct {
+	Name string `json:"name"`
+	// +optional
+	AllowTags []string `json:"allowTags,omitempty"`
+	// +optional
+	DropTags []string `json:"dropTags,omitempty"`
+	// +optional
+	Tags []MetricTagRule `json:"tags,omitempty"`
+}
+
+// MetricTagRule is the spec for rewriting the values of a tag
+type MetricTagRule struct {
+	Name string `json:"name"`
+	// +optional
+	Replacements []MetricTagReplacement `json:"replacements,omitempty"`
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+	// +optional
+	MaxValues int `json:"maxValues,omitempty"`
+}
+
+// MetricTagReplacement is the spec for replacing the parts of a tag value matching a regular expression
+type MetricTagReplacement struct {
+	Regex       string `json:"regex"`
+	Replacement string `json:"replacement"`
+}
+
+// JWTAuthenticationSpec is the spec for authenticating the callers of the Dapr APIs with bearer JWTs
+type JWTAuthenticationSpec struct {
+	// +optional
+	Issuers []string `json:"issuers,omitempty"`
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+	// +optional
+	JWKSFile string `json:"jwksFile,omitempty"`
+	// +optional
+	JWKSURL string `json:"jwksUrl,omitempty"`
+	// +optional
+	ForwardedClaims []string `json:"forwardedClaims,omitempty"`
+}
+
+// APITokensSpec is the spec for loading and rotating the api tokens
+type APITokensSpec struct {
+	// +optional
+	File string `json:"file,omitempty"`
+	// +optional
+	SecretStore string `json:"secretStore,omitempty"`
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+	// +optional
+	RefreshInterval string `json:"refreshInterval,omitempty"`
+}
+
+// NameResolutionSpec is the spec for selecting the name resolution component
+type NameResolutionSpec struct {
+	// +optional
+	Component string `json:"component,omitempty"`
+}
+
+// ServiceInvocationSpec is the spec for the timeouts and load balancing of service invocations
+type ServiceInvocationSpec struct {
+	// +optional
+	DefaultRequestTimeout string `json:"defaultRequestTimeout,omitempty"`
+	// +optional
+	Apps []AppInvocationSpec `json:"apps,omitempty"`
+	// +optional
+	LoadBalancing LoadBalancingSpec `json:"loadBalancing,omitempty"`
+}
+
+// LoadBalancingSpec defines how invocations are spread across the instances of an app
+type LoadBalancingSpec struct {
+	// +optional
+	Policy string `json:"policy,omitempty"`
+	// +optional
+	EjectionDuration string `json:"ejectionDuration,omitempty"`
+}
+
+// AppInvocationSpec defines the timeout of invocations of an app
+type AppInvocationSpec struct {
+	AppID          string `json:"appId"`
+	RequestTimeout string `json:"requestTimeout"`
 }
 
 // SecretsSpec is the spec for secrets configuration
@@ -52,6 +172,31 @@ type SecretsScope struct {
 	DeniedSecrets []string `json:"deniedSecrets,omitempty"`
 }
 
+// BindingsSpec is the spec for bindings configuration
+type BindingsSpec struct {
+	Scopes []BindingsScope `json:"scopes"`
+}
+
+// BindingsScope defines which operations apps are allowed to invoke on an output binding
+type BindingsScope struct {
+	// +optional
+	DefaultAccess string `json:"defaultAccess,omitempty"`
+	BindingName   string `json:"bindingName"`
+	// +optional
+	AppPolicies []BindingAppPolicy `json:"appPolicies,omitempty"`
+}
+
+// BindingAppPolicy defines the operations an app is allowed or denied to invoke on an output binding
+type BindingAppPolicy struct {
+	AppID string `json:"appId"`
+	// +optional
+	DefaultAccess string `json:"defaultAccess,omitempty"`
+	// +optional
+	AllowedOperations []string `json:"allowedOperations,omitempty"`
+	// +optional
+	DeniedOperations []string `json:"deniedOperations,omitempty"`
+}
+
 // PipelineSpec defines the middleware pipeline
 type PipelineSpec struct {
 	Handlers []HandlerSpec `json:"handlers"`
@@ -85,6 +230,60 @@ type SelectorField struct {
 // TracingSpec is the spec object in ConfigurationSpec
 type TracingSpec struct {
 	SamplingRate string `json:"samplingRate"`
+	// +optional
+	Stdout bool `json:"stdout,omitempty"`
+	// +optional
+	Otel OtelSpec `json:"otel,omitempty"`
+	// +optional
+	Zipkin ZipkinSpec `json:"zipkin,omitempty"`
+}
+
+// OtelSpec is the spec for exporting spans with OTLP
+type OtelSpec struct {
+	EndpointAddress string `json:"endpointAddress"`
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// +optional
+	TLS TraceExporterTLSSpec `json:"tls,omitempty"`
+	// +optional
+	Batching TraceExporterBatching `json:"batching,omitempty"`
+}
+
+// ZipkinSpec is the spec for exporting spans to Zipkin
+type ZipkinSpec struct {
+	EndpointAddress string `json:"endpointAddress"`
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// +optional
+	TLS TraceExporterTLSSpec `json:"tls,omitempty"`
+	// +optional
+	Batching TraceExporterBatching `json:"batching,omitempty"`
+}
+
+// TraceExporterTLSSpec is the spec for the TLS connections to a trace collector
+type TraceExporterTLSSpec struct {
+	// +optional
+	CAFile string `json:"caFile,omitempty"`
+	// +optional
+	CertFile string `json:"certFile,omitempty"`
+	// +optional
+	KeyFile string `json:"keyFile,omitempty"`
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// TraceExporterBatching is the spec for batching the exported spans
+type TraceExporterBatching struct {
+	// +optional
+	MaxBatchSize int `json:"maxBatchSize,omitempty"`
+	// +optional
+	MaxQueueSize int `json:"maxQueueSize,omitempty"`
+	// +optional
+	FlushInterval string `json:"flushInterval,omitempty"`
 }
 
 // AppPolicySpec defines the policy data structure for each app
@@ -94,6 +293,12 @@ type AppPolicySpec struct {
 	TrustDomain         string               `json:"trustDomain" yaml:"trustDomain"`
 	Namespace           string               `json:"namespace" yaml:"namespace"`
 	AppOperationActions []AppOperationAction `json:"operations" yaml:"operations"`
+	// +optional
+	ActorOperations []AppOperationAction `json:"actors,omitempty" yaml:"actors,omitempty"`
+	// +optional
+	PubSubOperations []AppOperationAction `json:"pubsub,omitempty" yaml:"pubsub,omitempty"`
+	// +optional
+	StateOperations []AppOperationAction `json:"state,omitempty" yaml:"state,omitempty"`
 }
 
 // AppOperationAction defines the data structure for each app operation
@@ -101,6 +306,8 @@ type AppOperationAction struct {
 	Operation string   `json:"name" yaml:"name"`
 	HTTPVerb  []string `json:"httpVerb" yaml:"httpVerb"`
 	Action    string   `json:"action" yaml:"action"`
+	// +optional
+	Claims map[string]string `json:"claims,omitempty" yaml:"claims,omitempty"`
 }
 
 // AccessControlSpec is the spec object in ConfigurationSpec
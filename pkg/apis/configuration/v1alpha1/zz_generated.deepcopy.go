@@ -24,6 +24,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokensSpec) DeepCopyInto(out *APITokensSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokensSpec.
+func (in *APITokensSpec) DeepCopy() *APITokensSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokensSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessControlSpec) DeepCopyInto(out *AccessControlSpec) {
 	*out = *in
@@ -46,6 +61,21 @@ func (in *AccessControlSpec) DeepCopy() *AccessControlSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppInvocationSpec) DeepCopyInto(out *AppInvocationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInvocationSpec.
+func (in *AppInvocationSpec) DeepCopy() *AppInvocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppInvocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppOperationAction) DeepCopyInto(out *AppOperationAction) {
 	*out = *in
@@ -54,6 +84,13 @@ func (in *AppOperationAction) DeepCopyInto(out *AppOperationAction) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppOperationAction.
@@ -76,6 +113,27 @@ func (in *AppPolicySpec) DeepCopyInto(out *AppPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ActorOperations != nil {
+		in, out := &in.ActorOperations, &out.ActorOperations
+		*out = make([]AppOperationAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PubSubOperations != nil {
+		in, out := &in.PubSubOperations, &out.PubSubOperations
+		*out = make([]AppOperationAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StateOperations != nil {
+		in, out := &in.StateOperations, &out.StateOperations
+		*out = make([]AppOperationAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPolicySpec.
@@ -88,6 +146,95 @@ func (in *AppPolicySpec) DeepCopy() *AppPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingAppPolicy) DeepCopyInto(out *BindingAppPolicy) {
+	*out = *in
+	if in.AllowedOperations != nil {
+		in, out := &in.AllowedOperations, &out.AllowedOperations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedOperations != nil {
+		in, out := &in.DeniedOperations, &out.DeniedOperations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingAppPolicy.
+func (in *BindingAppPolicy) DeepCopy() *BindingAppPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingAppPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingsScope) DeepCopyInto(out *BindingsScope) {
+	*out = *in
+	if in.AppPolicies != nil {
+		in, out := &in.AppPolicies, &out.AppPolicies
+		*out = make([]BindingAppPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingsScope.
+func (in *BindingsScope) DeepCopy() *BindingsScope {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingsScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingsSpec) DeepCopyInto(out *BindingsSpec) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]BindingsScope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingsSpec.
+func (in *BindingsSpec) DeepCopy() *BindingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentHealthSpec) DeepCopyInto(out *ComponentHealthSpec) {
+	*out = *in
+	if in.RequiredComponents != nil {
+		in, out := &in.RequiredComponents, &out.RequiredComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentHealthSpec.
+func (in *ComponentHealthSpec) DeepCopy() *ComponentHealthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentHealthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -150,10 +297,18 @@ func (in *ConfigurationList) DeepCopyObject() runtime.Object {
 func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 	*out = *in
 	in.HTTPPipelineSpec.DeepCopyInto(&out.HTTPPipelineSpec)
-	out.TracingSpec = in.TracingSpec
+	in.GRPCPipelineSpec.DeepCopyInto(&out.GRPCPipelineSpec)
+	in.TracingSpec.DeepCopyInto(&out.TracingSpec)
 	out.MTLSSpec = in.MTLSSpec
+	in.MetricSpec.DeepCopyInto(&out.MetricSpec)
 	in.Secrets.DeepCopyInto(&out.Secrets)
+	in.Bindings.DeepCopyInto(&out.Bindings)
 	in.AccessControlSpec.DeepCopyInto(&out.AccessControlSpec)
+	in.ServiceInvocation.DeepCopyInto(&out.ServiceInvocation)
+	out.NameResolution = in.NameResolution
+	out.APITokens = in.APITokens
+	in.JWTAuthentication.DeepCopyInto(&out.JWTAuthentication)
+	in.ComponentHealth.DeepCopyInto(&out.ComponentHealth)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
@@ -182,6 +337,51 @@ func (in *HandlerSpec) DeepCopy() *HandlerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthenticationSpec) DeepCopyInto(out *JWTAuthenticationSpec) {
+	*out = *in
+	if in.Issuers != nil {
+		in, out := &in.Issuers, &out.Issuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForwardedClaims != nil {
+		in, out := &in.ForwardedClaims, &out.ForwardedClaims
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthenticationSpec.
+func (in *JWTAuthenticationSpec) DeepCopy() *JWTAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancingSpec) DeepCopyInto(out *LoadBalancingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingSpec.
+func (in *LoadBalancingSpec) DeepCopy() *LoadBalancingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MTLSSpec) DeepCopyInto(out *MTLSSpec) {
 	*out = *in
@@ -197,6 +397,139 @@ func (in *MTLSSpec) DeepCopy() *MTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricRule) DeepCopyInto(out *MetricRule) {
+	*out = *in
+	if in.AllowTags != nil {
+		in, out := &in.AllowTags, &out.AllowTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DropTags != nil {
+		in, out := &in.DropTags, &out.DropTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]MetricTagRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricRule.
+func (in *MetricRule) DeepCopy() *MetricRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MetricRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSpec.
+func (in *MetricSpec) DeepCopy() *MetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricTagReplacement) DeepCopyInto(out *MetricTagReplacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricTagReplacement.
+func (in *MetricTagReplacement) DeepCopy() *MetricTagReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricTagReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricTagRule) DeepCopyInto(out *MetricTagRule) {
+	*out = *in
+	if in.Replacements != nil {
+		in, out := &in.Replacements, &out.Replacements
+		*out = make([]MetricTagReplacement, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricTagRule.
+func (in *MetricTagRule) DeepCopy() *MetricTagRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricTagRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NameResolutionSpec) DeepCopyInto(out *NameResolutionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameResolutionSpec.
+func (in *NameResolutionSpec) DeepCopy() *NameResolutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NameResolutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OtelSpec) DeepCopyInto(out *OtelSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.TLS = in.TLS
+	out.Batching = in.Batching
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtelSpec.
+func (in *OtelSpec) DeepCopy() *OtelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OtelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -301,9 +634,62 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInvocationSpec) DeepCopyInto(out *ServiceInvocationSpec) {
+	*out = *in
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]AppInvocationSpec, len(*in))
+		copy(*out, *in)
+	}
+	out.LoadBalancing = in.LoadBalancing
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInvocationSpec.
+func (in *ServiceInvocationSpec) DeepCopy() *ServiceInvocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInvocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceExporterBatching) DeepCopyInto(out *TraceExporterBatching) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceExporterBatching.
+func (in *TraceExporterBatching) DeepCopy() *TraceExporterBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceExporterBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceExporterTLSSpec) DeepCopyInto(out *TraceExporterTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceExporterTLSSpec.
+func (in *TraceExporterTLSSpec) DeepCopy() *TraceExporterTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceExporterTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 	*out = *in
+	in.Otel.DeepCopyInto(&out.Otel)
+	in.Zipkin.DeepCopyInto(&out.Zipkin)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSpec.
@@ -315,3 +701,27 @@ func (in *TracingSpec) DeepCopy() *TracingSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZipkinSpec) DeepCopyInto(out *ZipkinSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.TLS = in.TLS
+	out.Batching = in.Batching
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipkinSpec.
+func (in *ZipkinSpec) DeepCopy() *ZipkinSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZipkinSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -6,10 +6,17 @@
 package channel
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"io/ioutil"
 	"time"
 
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -20,8 +27,95 @@ const (
 	DefaultChannelRequestTimeout = time.Minute * 1
 )
 
+// RequestTimeout returns the time left until the deadline of ctx if it has one, so that a deadline supplied
+// by the caller is honoured, or defaultTimeout otherwise
+func RequestTimeout(ctx context.Context, defaultTimeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	if defaultTimeout <= 0 {
+		return DefaultChannelRequestTimeout
+	}
+	return defaultTimeout
+}
+
+// AppConnection describes how Dapr connects to user code
+type AppConnection struct {
+	// UnixSocket is the path of the Unix domain socket user code listens on, used instead of the app port if set
+	UnixSocket string
+	// TLSConfig encrypts the connection to user code if set
+	TLSConfig *tls.Config
+}
+
+// NewAppTLSConfig returns the TLS config used to connect to user code. The certificate of user code is
+// verified with the PEM encoded CA certificates in caCertPath, or is not verified if caCertPath is empty.
+// nolint:gosec
+func NewAppTLSConfig(caCertPath string) (*tls.Config, error) {
+	if caCertPath == "" {
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+
+	caCert, err := ioutil.ReadFile(caCertPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading app CA certificate")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.Errorf("no valid certificates found in app CA certificate %s", caCertPath)
+	}
+	return &tls.Config{RootCAs: pool}, nil
+}
+
 // AppChannel is an abstraction over communications with user code
 type AppChannel interface {
 	GetBaseAddress() string
 	InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error)
 }
+
+// StreamingAppChannel is implemented by app channels that can stream request and response bodies
+// to and from user code without buffering them in memory
+type StreamingAppChannel interface {
+	// InvokeMethodStream invokes user code with the request body read from body.
+	// The returned response has no data; the response body is read from the returned ReadCloser,
+	// which the caller must close.
+	InvokeMethodStream(ctx context.Context, req *invokev1.InvokeMethodRequest, body io.Reader) (*invokev1.InvokeMethodResponse, io.ReadCloser, error)
+}
+
+// ProxyAppChannel is implemented by app channels that can transparently proxy gRPC calls to user code
+type ProxyAppChannel interface {
+	// ProxyStream forwards the gRPC call received on stream to the fullMethod of user code, with its raw frames.
+	ProxyStream(ctx context.Context, fullMethod string, stream grpc.ServerStream) error
+}
+
+// HealthProbeAppChannel is implemented by app channels that can probe the health of user code
+type HealthProbeAppChannel interface {
+	// HealthProbe returns an error if user code isn't healthy. path is the path of the health endpoint of
+	// HTTP apps, app channels probing with the gRPC health protocol ignore it.
+	HealthProbe(ctx context.Context, path string) error
+}
+
+// InvokeMethodStream invokes user code with a streamed request body, returning the response body as a stream.
+// App channels that do not implement StreamingAppChannel are invoked with the request and response bodies
+// buffered in memory.
+func InvokeMethodStream(ctx context.Context, appChannel AppChannel, req *invokev1.InvokeMethodRequest, body io.Reader) (*invokev1.InvokeMethodResponse, io.ReadCloser, error) {
+	if streaming, ok := appChannel.(StreamingAppChannel); ok {
+		return streaming.InvokeMethodStream(ctx, req, body)
+	}
+
+	if body != nil {
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.WithRawData(data, req.Message().GetContentType())
+	}
+
+	resp, err := appChannel.InvokeMethod(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contentType, data := resp.RawData()
+	resp.WithRawData(nil, contentType)
+	return resp, ioutil.NopCloser(bytes.NewReader(data)), nil
+}
@@ -8,32 +8,37 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/grpc/proxy"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 // Channel is a concrete AppChannel implementation for interacting with gRPC based user code
 type Channel struct {
-	client      *grpc.ClientConn
-	baseAddress string
-	ch          chan int
-	tracingSpec config.TracingSpec
+	client         *grpc.ClientConn
+	baseAddress    string
+	ch             chan int
+	tracingSpec    config.TracingSpec
+	requestTimeout time.Duration
 }
 
-// CreateLocalChannel creates a gRPC connection with user code
-func CreateLocalChannel(port, maxConcurrency int, conn *grpc.ClientConn, spec config.TracingSpec) *Channel {
+// CreateLocalChannel creates a gRPC connection with user code. requestTimeout is the timeout of requests without a deadline.
+func CreateLocalChannel(port, maxConcurrency int, conn *grpc.ClientConn, spec config.TracingSpec, requestTimeout time.Duration) *Channel {
 	c := &Channel{
-		client:      conn,
-		baseAddress: fmt.Sprintf("%s:%d", channel.DefaultChannelAddress, port),
-		tracingSpec: spec,
+		client:         conn,
+		baseAddress:    fmt.Sprintf("%s:%d", channel.DefaultChannelAddress, port),
+		tracingSpec:    spec,
+		requestTimeout: requestTimeout,
 	}
 	if maxConcurrency > 0 {
 		c.ch = make(chan int, maxConcurrency)
@@ -64,6 +69,22 @@ func (g *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRe
 	return rsp, err
 }
 
+// HealthProbe probes user code with the gRPC health protocol, user code is healthy if it's serving.
+// path is ignored.
+func (g *Channel) HealthProbe(ctx context.Context, path string) error {
+	ctx, cancel := context.WithTimeout(ctx, channel.RequestTimeout(ctx, g.requestTimeout))
+	defer cancel()
+
+	resp, err := grpc_health_v1.NewHealthClient(g.client).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("app health status is %s", resp.GetStatus())
+	}
+	return nil
+}
+
 // invokeMethodV1 calls user applications using daprclient v1
 func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	if g.ch != nil {
@@ -72,10 +93,11 @@ func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 
 	clientV1 := runtimev1pb.NewAppCallbackClient(g.client)
 	grpcMetadata := invokev1.InternalMetadataToGrpcMetadata(ctx, req.Metadata(), true)
+	timeout := channel.RequestTimeout(ctx, g.requestTimeout)
 	// Prepare gRPC Metadata
 	ctx = metadata.NewOutgoingContext(context.Background(), grpcMetadata)
 
-	ctx, cancel := context.WithTimeout(ctx, channel.DefaultChannelRequestTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	var header, trailer metadata.MD
 	resp, err := clientV1.OnInvoke(ctx, req.Message(), grpc.Header(&header), grpc.Trailer(&trailer))
@@ -98,3 +120,15 @@ func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 
 	return rsp.WithMessage(resp), nil
 }
+
+// ProxyStream forwards a gRPC call to user code with its raw frames
+func (g *Channel) ProxyStream(ctx context.Context, fullMethod string, stream grpc.ServerStream) error {
+	if g.ch != nil {
+		g.ch <- 1
+		defer func() {
+			<-g.ch
+		}()
+	}
+
+	return proxy.Forward(ctx, g.client, fullMethod, stream)
+}
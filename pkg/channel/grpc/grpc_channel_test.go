@@ -21,6 +21,8 @@ import (
 	empty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -98,6 +100,27 @@ func TestInvokeMethod(t *testing.T) {
 	assert.Equal(t, "{\"param1\":\"val1\",\"param2\":\"val2\"}", actual["querystring"])
 }
 
+func TestHealthProbe(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:9997")
+	assert.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial("localhost:9997", grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer close(t, conn)
+
+	c := Channel{baseAddress: "localhost:9997", client: conn}
+	assert.NoError(t, c.HealthProbe(context.Background(), ""))
+
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	assert.EqualError(t, c.HealthProbe(context.Background(), ""), "app health status is NOT_SERVING")
+}
+
 func close(t *testing.T, c io.Closer) {
 	err := c.Close()
 	if err != nil {
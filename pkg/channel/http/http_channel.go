@@ -9,6 +9,9 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
+	"net"
+	net_http "net/http"
 	"strconv"
 	"time"
 
@@ -20,6 +23,7 @@ import (
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/valyala/fasthttp"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -30,24 +34,53 @@ const (
 
 // Channel is an HTTP implementation of an AppChannel
 type Channel struct {
-	client      *fasthttp.Client
-	baseAddress string
-	ch          chan int
-	tracingSpec config.TracingSpec
+	client *fasthttp.Client
+	// streamClient is used for streamed invocations, as the fasthttp client buffers response bodies
+	streamClient   *net_http.Client
+	baseAddress    string
+	ch             chan int
+	tracingSpec    config.TracingSpec
+	requestTimeout time.Duration
 }
 
-// CreateLocalChannel creates an HTTP AppChannel
+// CreateLocalChannel creates an HTTP AppChannel. requestTimeout is the timeout of requests without a deadline.
 // nolint:gosec
-func CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec) (channel.AppChannel, error) {
+func CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec, requestTimeout time.Duration, conn channel.AppConnection) (channel.AppChannel, error) {
+	scheme := "http"
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if conn.TLSConfig != nil {
+		scheme = "https"
+		tlsConfig = conn.TLSConfig
+	}
+	host := fmt.Sprintf("%s:%d", channel.DefaultChannelAddress, port)
+
+	// the timeout of each request is set by DoTimeout, as callers may supply a longer deadline
+	client := &fasthttp.Client{
+		MaxConnsPerHost:           1000000,
+		TLSConfig:                 tlsConfig,
+		MaxIdemponentCallAttempts: 0,
+	}
+	transport := &net_http.Transport{
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: 1024,
+	}
+	if conn.UnixSocket != "" {
+		host = "localhost"
+		client.Dial = func(string) (net.Conn, error) {
+			return net.Dial("unix", conn.UnixSocket)
+		}
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", conn.UnixSocket)
+		}
+	}
+
 	c := &Channel{
-		client: &fasthttp.Client{
-			MaxConnsPerHost:           1000000,
-			TLSConfig:                 &tls.Config{InsecureSkipVerify: true},
-			ReadTimeout:               channel.DefaultChannelRequestTimeout,
-			MaxIdemponentCallAttempts: 0,
-		},
-		baseAddress: fmt.Sprintf("http://%s:%d", channel.DefaultChannelAddress, port),
-		tracingSpec: spec,
+		client:         client,
+		streamClient:   &net_http.Client{Transport: transport},
+		baseAddress:    fmt.Sprintf("%s://%s", scheme, host),
+		tracingSpec:    spec,
+		requestTimeout: requestTimeout,
 	}
 
 	if maxConcurrency > 0 {
@@ -100,7 +133,7 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 
 	// Send request to user application
 	var resp = fasthttp.AcquireResponse()
-	err := h.client.DoTimeout(channelReq, resp, channel.DefaultChannelRequestTimeout)
+	err := h.client.DoTimeout(channelReq, resp, channel.RequestTimeout(ctx, h.requestTimeout))
 	defer func() {
 		fasthttp.ReleaseRequest(channelReq)
 		fasthttp.ReleaseResponse(resp)
@@ -118,6 +151,104 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 	return rsp, nil
 }
 
+// HealthProbe probes the health endpoint of user code at path, which is healthy if it answers with a 2xx status code
+func (h *Channel) HealthProbe(ctx context.Context, path string) error {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer func() {
+		fasthttp.ReleaseRequest(req)
+		fasthttp.ReleaseResponse(resp)
+	}()
+
+	req.SetRequestURI(h.baseAddress + path)
+	req.Header.SetMethod(fasthttp.MethodGet)
+	if err := h.client.DoTimeout(req, resp, channel.RequestTimeout(ctx, h.requestTimeout)); err != nil {
+		return err
+	}
+	if code := resp.StatusCode(); code < 200 || code >= 300 {
+		return fmt.Errorf("health endpoint %s returned status code %d", path, code)
+	}
+	return nil
+}
+
+// InvokeMethodStream invokes user code via HTTP, streaming the request and response bodies with chunked
+// transfer encoding. No timeout is applied other than the context's, to allow long-lived responses.
+func (h *Channel) InvokeMethodStream(ctx context.Context, req *invokev1.InvokeMethodRequest, body io.Reader) (*invokev1.InvokeMethodResponse, io.ReadCloser, error) {
+	httpExt := req.Message().GetHttpExtension()
+	if httpExt == nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "missing HTTP extension field")
+	}
+	if httpExt.GetVerb() == commonv1pb.HTTPExtension_NONE {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid HTTP verb")
+	}
+	if req.APIVersion() != internalv1pb.APIVersion_V1 {
+		return nil, nil, status.Error(codes.Unimplemented, fmt.Sprintf("Unsupported spec version: %d", req.APIVersion()))
+	}
+
+	uri := fmt.Sprintf("%s/%s", h.baseAddress, req.Message().GetMethod())
+	if qs := req.EncodeHTTPQueryString(); qs != "" {
+		uri += "?" + qs
+	}
+	verb := httpExt.GetVerb().String()
+	channelReq, err := net_http.NewRequestWithContext(ctx, verb, uri, body)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Recover headers
+	invokev1.InternalMetadataToHTTPHeader(ctx, req.Metadata(), channelReq.Header.Set)
+	if contentType := req.Message().GetContentType(); contentType != "" {
+		channelReq.Header.Set("Content-Type", contentType)
+	}
+
+	if h.ch != nil {
+		h.ch <- 1
+	}
+	release := func() {
+		if h.ch != nil {
+			<-h.ch
+		}
+	}
+
+	diag.DefaultHTTPMonitoring.ClientRequestStarted(ctx, verb, req.Message().Method, channelReq.ContentLength)
+	startRequest := time.Now()
+
+	client := h.streamClient
+	if client == nil {
+		client = net_http.DefaultClient
+	}
+	resp, err := client.Do(channelReq)
+	elapsedMs := float64(time.Since(startRequest) / time.Millisecond)
+	if err != nil {
+		release()
+		diag.DefaultHTTPMonitoring.ClientRequestCompleted(ctx, verb, req.Message().GetMethod(), strconv.Itoa(fasthttp.StatusInternalServerError), 0, elapsedMs)
+		return nil, nil, status.Error(codes.Internal, fmt.Sprintf("client error: %s", err))
+	}
+	diag.DefaultHTTPMonitoring.ClientRequestCompleted(ctx, verb, req.Message().GetMethod(), strconv.Itoa(resp.StatusCode), resp.ContentLength, elapsedMs)
+
+	rsp := invokev1.NewInvokeMethodResponse(int32(resp.StatusCode), "", nil)
+	rsp.WithHeaders(metadata.MD(resp.Header)).WithRawData(nil, resp.Header.Get("Content-Type"))
+
+	// the concurrency slot is held until the response body is consumed
+	return rsp, &releaseOnClose{ReadCloser: resp.Body, release: release}, nil
+}
+
+// releaseOnClose calls release once when the wrapped ReadCloser is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	closed  bool
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	if !r.closed {
+		r.closed = true
+		r.release()
+	}
+	return err
+}
+
 func (h *Channel) constructRequest(ctx context.Context, req *invokev1.InvokeMethodRequest) *fasthttp.Request {
 	var channelReq = fasthttp.AcquireRequest()
 
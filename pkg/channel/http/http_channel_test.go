@@ -7,13 +7,20 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"io"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/stretchr/testify/assert"
@@ -123,6 +130,30 @@ func TestInvokeMethod(t *testing.T) {
 	server.Close()
 }
 
+func TestHealthProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	c := Channel{baseAddress: server.URL, client: &fasthttp.Client{}}
+
+	t.Run("healthy", func(t *testing.T) {
+		assert.NoError(t, c.HealthProbe(context.Background(), "/healthz"))
+	})
+
+	t.Run("unhealthy status code", func(t *testing.T) {
+		err := c.HealthProbe(context.Background(), "/ready")
+		assert.EqualError(t, err, "health endpoint /ready returned status code 503")
+	})
+
+	t.Run("app unreachable", func(t *testing.T) {
+		c := Channel{baseAddress: "http://127.0.0.1:1", client: &fasthttp.Client{}}
+		assert.Error(t, c.HealthProbe(context.Background(), "/healthz"))
+	})
+}
+
 func TestInvokeMethodMaxConcurrency(t *testing.T) {
 	ctx := context.Background()
 	t.Run("single concurrency", func(t *testing.T) {
@@ -269,3 +300,97 @@ func TestContentType(t *testing.T) {
 		testServer.Close()
 	})
 }
+
+type testEchoHandler struct {
+}
+
+func (t *testEchoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+	io.Copy(w, r.Body)
+}
+
+func TestInvokeMethodStream(t *testing.T) {
+	ctx := context.Background()
+	testServer := httptest.NewServer(&testEchoHandler{})
+	defer testServer.Close()
+
+	t.Run("streams request and response bodies", func(t *testing.T) {
+		c := Channel{baseAddress: testServer.URL, client: &fasthttp.Client{}}
+		req := invokev1.NewInvokeMethodRequest("method")
+		req.WithRawData(nil, "application/octet-stream")
+		req.WithHTTPExtension(http.MethodPost, "")
+
+		// act
+		resp, body, err := c.InvokeMethodStream(ctx, req, strings.NewReader("streamed data"))
+
+		// assert
+		assert.NoError(t, err)
+		defer body.Close()
+		data, err := ioutil.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, "streamed data", string(data))
+		assert.Equal(t, int32(200), resp.Status().Code)
+		contentType, _ := resp.RawData()
+		assert.Equal(t, "application/octet-stream", contentType)
+	})
+
+	t.Run("releases concurrency slot on close", func(t *testing.T) {
+		c := Channel{baseAddress: testServer.URL, client: &fasthttp.Client{}}
+		c.ch = make(chan int, 1)
+
+		for i := 0; i < 2; i++ {
+			req := invokev1.NewInvokeMethodRequest("method")
+			req.WithHTTPExtension(http.MethodPost, "")
+
+			// act
+			_, body, err := c.InvokeMethodStream(ctx, req, strings.NewReader("data"))
+
+			// assert
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(c.ch))
+			body.Close()
+			assert.Equal(t, 0, len(c.ch))
+		}
+	})
+}
+
+func TestCreateLocalChannel(t *testing.T) {
+	t.Run("tcp", func(t *testing.T) {
+		c, err := CreateLocalChannel(3000, 0, config.TracingSpec{}, 0, channel.AppConnection{})
+		assert.NoError(t, err)
+		assert.Equal(t, "http://127.0.0.1:3000", c.GetBaseAddress())
+	})
+
+	t.Run("tls", func(t *testing.T) {
+		c, err := CreateLocalChannel(3000, 0, config.TracingSpec{}, 0, channel.AppConnection{TLSConfig: &tls.Config{}})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://127.0.0.1:3000", c.GetBaseAddress())
+	})
+
+	t.Run("unix domain socket", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "dapr")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		socket := filepath.Join(dir, "app.socket")
+		lis, err := net.Listen("unix", socket)
+		assert.NoError(t, err)
+		server := &http.Server{Handler: &testContentTypeHandler{}}
+		go server.Serve(lis)
+		defer server.Close()
+
+		c, err := CreateLocalChannel(0, 0, config.TracingSpec{}, 0, channel.AppConnection{UnixSocket: socket})
+		assert.NoError(t, err)
+		fakeReq := invokev1.NewInvokeMethodRequest("method")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+		fakeReq.WithRawData([]byte("data"), "text/plain")
+
+		// act
+		response, err := c.InvokeMethod(context.Background(), fakeReq)
+
+		// assert
+		assert.NoError(t, err)
+		_, body := response.RawData()
+		assert.Equal(t, "text/plain", string(body))
+	})
+}
@@ -0,0 +1,78 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package bindings
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxStreamBufferSize is the maximum payload size buffered in memory when streaming
+// to an output binding that does not implement StreamingOutputBinding.
+const DefaultMaxStreamBufferSize = 4 << 20
+
+type (
+	// StreamInvokeRequest is the request to invoke an output binding with a streamed payload.
+	StreamInvokeRequest struct {
+		Data      io.Reader
+		Metadata  map[string]string
+		Operation bindings.OperationKind
+	}
+
+	// StreamInvokeResponse is the response of an output binding invoked with a streamed payload.
+	// The caller must close Data once done reading it.
+	StreamInvokeResponse struct {
+		Data     io.ReadCloser
+		Metadata map[string]string
+	}
+
+	// StreamingOutputBinding is an optional interface for output bindings that can read request
+	// payloads and write response payloads without buffering them in memory.
+	StreamingOutputBinding interface {
+		InvokeStream(req *StreamInvokeRequest) (*StreamInvokeResponse, error)
+	}
+)
+
+// InvokeStream invokes binding with a streamed payload. Bindings implementing StreamingOutputBinding
+// receive the stream as is, others receive the payload buffered in memory, up to maxBufferSize bytes.
+func InvokeStream(binding bindings.OutputBinding, req *StreamInvokeRequest, maxBufferSize int64) (*StreamInvokeResponse, error) {
+	if streaming, ok := binding.(StreamingOutputBinding); ok {
+		return streaming.InvokeStream(req)
+	}
+
+	var data []byte
+	if req.Data != nil {
+		var err error
+		// read one byte past the limit to detect payloads that exceed it
+		data, err = ioutil.ReadAll(io.LimitReader(req.Data, maxBufferSize+1))
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading binding payload")
+		}
+		if int64(len(data)) > maxBufferSize {
+			return nil, errors.Errorf("binding payload exceeds the maximum buffer size of %v bytes for non-streaming bindings", maxBufferSize)
+		}
+	}
+
+	resp, err := binding.Invoke(&bindings.InvokeRequest{
+		Data:      data,
+		Metadata:  req.Metadata,
+		Operation: req.Operation,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streamResp := &StreamInvokeResponse{Data: ioutil.NopCloser(bytes.NewReader(nil))}
+	if resp != nil {
+		streamResp.Data = ioutil.NopCloser(bytes.NewReader(resp.Data))
+		streamResp.Metadata = resp.Metadata
+	}
+	return streamResp, nil
+}
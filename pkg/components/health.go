@@ -0,0 +1,12 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package components
+
+// Pinger is implemented by components that can check the health of the connection
+// to their backing service. Ping returns an error when the service can't be reached.
+type Pinger interface {
+	Ping() error
+}
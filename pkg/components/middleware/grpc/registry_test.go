@@ -0,0 +1,80 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dapr/components-contrib/middleware"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestCreateFullName(t *testing.T) {
+	t.Run("create ratelimit middleware key name", func(t *testing.T) {
+		assert.Equal(t, "middleware.grpc.ratelimit", createFullName("ratelimit"))
+	})
+
+	t.Run("create custom middleware key name", func(t *testing.T) {
+		assert.Equal(t, "middleware.grpc.custom.auth", createFullName("custom.auth"))
+	})
+}
+
+func TestCreateMiddleware(t *testing.T) {
+	testRegistry := NewRegistry()
+
+	t.Run("middleware is registered", func(t *testing.T) {
+		const middlewareName = "mockMiddleware"
+		var gotMetadata middleware.Metadata
+		unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return "intercepted", nil
+		}
+
+		// act
+		testRegistry.Register(New(middlewareName, func(metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+			gotMetadata = metadata
+			return grpc_middleware.Middleware{Unary: unary}, nil
+		}))
+		metadata := middleware.Metadata{Properties: map[string]string{"key": "value"}}
+		m, err := testRegistry.Create(createFullName(middlewareName), metadata)
+
+		// assert
+		assert.NoError(t, err)
+		assert.Equal(t, metadata, gotMetadata)
+		assert.Nil(t, m.Stream)
+		resp, err := m.Unary(context.Background(), nil, &grpc.UnaryServerInfo{}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "intercepted", resp)
+	})
+
+	t.Run("middleware fails to be created", func(t *testing.T) {
+		const middlewareName = "failingMiddleware"
+
+		// act
+		testRegistry.Register(New(middlewareName, func(metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+			return grpc_middleware.Middleware{}, errors.New("invalid metadata")
+		}))
+		m, err := testRegistry.Create(createFullName(middlewareName), middleware.Metadata{})
+
+		// assert
+		assert.Equal(t, grpc_middleware.Middleware{}, m)
+		assert.EqualError(t, err, "failed to create gRPC middleware middleware.grpc.failingMiddleware: invalid metadata")
+	})
+
+	t.Run("middleware is not registered", func(t *testing.T) {
+		const middlewareName = "fakeMiddleware"
+
+		// act
+		m, err := testRegistry.Create(createFullName(middlewareName), middleware.Metadata{})
+
+		// assert
+		assert.Equal(t, grpc_middleware.Middleware{}, m)
+		assert.EqualError(t, err, "gRPC middleware middleware.grpc.fakeMiddleware has not been registered")
+	})
+}
@@ -0,0 +1,54 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package nameresolution
+
+import (
+	"sort"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	"github.com/pkg/errors"
+)
+
+// ZoneProperty is the name resolution metadata property with the locality zone of the Dapr instance
+const ZoneProperty = "zone"
+
+// Endpoint is an instance of an app resolved by name resolution
+type Endpoint struct {
+	// Address is the address of the Dapr instance of the app
+	Address string
+	// Zone is the locality zone of the instance, empty if unknown
+	Zone string
+}
+
+// EndpointsResolver is implemented by name resolvers that can resolve an app to all of its instances
+type EndpointsResolver interface {
+	// ResolveEndpoints resolves name to the endpoints of all the instances of the app.
+	ResolveEndpoints(req nr.ResolveRequest) ([]Endpoint, error)
+}
+
+// ResolveEndpoints resolves the endpoints of the instances of an app, sorted by address.
+// Resolvers that do not implement EndpointsResolver resolve an app to a single endpoint.
+func ResolveEndpoints(resolver nr.Resolver, req nr.ResolveRequest) ([]Endpoint, error) {
+	if endpointsResolver, ok := resolver.(EndpointsResolver); ok {
+		endpoints, err := endpointsResolver.ResolveEndpoints(req)
+		if err != nil {
+			return nil, err
+		}
+		if len(endpoints) == 0 {
+			return nil, errors.Errorf("couldn't find service: %s", req.ID)
+		}
+		sort.Slice(endpoints, func(i, j int) bool {
+			return endpoints[i].Address < endpoints[j].Address
+		})
+		return endpoints, nil
+	}
+
+	address, err := resolver.ResolveID(req)
+	if err != nil {
+		return nil, err
+	}
+	return []Endpoint{{Address: address}}, nil
+}
@@ -0,0 +1,134 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package kubernetes
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_kubernetes "github.com/dapr/components-contrib/nameresolution/kubernetes"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/pkg/errors"
+	core_v1 "k8s.io/api/core/v1"
+	discovery_v1beta1 "k8s.io/api/discovery/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// endpointsTTL is how long the resolved pods of an app are resolved from the cache
+const endpointsTTL = time.Second * 5
+
+// zoneLookupFunc returns the zone of the pod IPs of a service
+type zoneLookupFunc func(namespace, service string) (map[string]string, error)
+
+// Resolver is the Kubernetes name resolver, which also resolves every instance of an app. The Dapr service
+// of each app is headless, so the DNS name of the service resolves to the addresses of all of its pods.
+// The zones of the pods are read from the topology of the EndpointSlices of the service, if they can be listed.
+type Resolver struct {
+	nr.Resolver
+	logger      logger.Logger
+	lookupHost  func(host string) ([]string, error)
+	lookupZones zoneLookupFunc
+
+	lock      sync.Mutex
+	endpoints map[string]cachedEndpoints
+}
+
+type cachedEndpoints struct {
+	endpoints []nr_loader.Endpoint
+	expires   time.Time
+}
+
+// NewResolver creates a Kubernetes name resolver.
+func NewResolver(logger logger.Logger) *Resolver {
+	r := &Resolver{
+		Resolver:   nr_kubernetes.NewResolver(logger),
+		logger:     logger,
+		lookupHost: net.LookupHost,
+		endpoints:  map[string]cachedEndpoints{},
+	}
+	if config, err := rest.InClusterConfig(); err == nil {
+		if client, err := kubernetes.NewForConfig(config); err == nil {
+			r.lookupZones = newZoneLookup(client)
+		}
+	}
+	return r
+}
+
+// newZoneLookup returns the lookup of the zones of the pod IPs of a service in the topology of its EndpointSlices
+func newZoneLookup(client kubernetes.Interface) zoneLookupFunc {
+	return func(namespace, service string) (map[string]string, error) {
+		slices, err := client.DiscoveryV1beta1().EndpointSlices(namespace).List(meta_v1.ListOptions{
+			LabelSelector: discovery_v1beta1.LabelServiceName + "=" + service,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		zones := map[string]string{}
+		for _, slice := range slices.Items {
+			for _, endpoint := range slice.Endpoints {
+				zone := endpoint.Topology[core_v1.LabelZoneFailureDomainStable]
+				if zone == "" {
+					continue
+				}
+				for _, addr := range endpoint.Addresses {
+					zones[addr] = zone
+				}
+			}
+		}
+		return zones, nil
+	}
+}
+
+// ResolveEndpoints resolves name to the endpoints of the pods of the Dapr service of the app.
+func (r *Resolver) ResolveEndpoints(req nr.ResolveRequest) ([]nr_loader.Endpoint, error) {
+	address, err := r.ResolveID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	cached, ok := r.endpoints[address]
+	r.lock.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return append([]nr_loader.Endpoint(nil), cached.endpoints...), nil
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := r.lookupHost(host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't find service: %s", req.ID)
+	}
+
+	var zones map[string]string
+	// the host is the DNS name of the service: <service>.<namespace>.svc.<cluster domain>
+	if labels := strings.SplitN(host, ".", 3); r.lookupZones != nil && len(labels) == 3 {
+		zones, err = r.lookupZones(labels[1], labels[0])
+		if err != nil {
+			r.logger.Debugf("kubernetes: couldn't read the zones of the pods of service %s: %s", labels[0], err)
+		}
+	}
+
+	endpoints := make([]nr_loader.Endpoint, 0, len(addrs))
+	for _, addr := range addrs {
+		endpoints = append(endpoints, nr_loader.Endpoint{Address: net.JoinHostPort(addr, port), Zone: zones[addr]})
+	}
+	if len(endpoints) > 0 {
+		r.lock.Lock()
+		r.endpoints[address] = cachedEndpoints{endpoints: endpoints, expires: time.Now().Add(endpointsTTL)}
+		r.lock.Unlock()
+	}
+	return append([]nr_loader.Endpoint(nil), endpoints...), nil
+}
@@ -0,0 +1,100 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	discovery_v1beta1 "k8s.io/api/discovery/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResolveEndpoints(t *testing.T) {
+	r := NewResolver(logger.NewLogger("test"))
+	r.lookupZones = nil
+	var lookedUp string
+	lookups := 0
+	r.lookupHost = func(host string) ([]string, error) {
+		lookedUp = host
+		lookups++
+		return []string{"10.0.0.2", "10.0.0.1"}, nil
+	}
+
+	t.Run("pods of the headless service are resolved", func(t *testing.T) {
+		endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1", Namespace: "default", Port: 50002})
+		assert.NoError(t, err)
+		assert.Equal(t, "app1-dapr.default.svc.cluster.local", lookedUp)
+		assert.Equal(t, []nr_loader.Endpoint{
+			{Address: "10.0.0.1:50002"},
+			{Address: "10.0.0.2:50002"},
+		}, endpoints)
+	})
+
+	t.Run("resolved pods are cached", func(t *testing.T) {
+		lookups = 0
+		endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1", Namespace: "default", Port: 50002})
+		assert.NoError(t, err)
+		assert.Len(t, endpoints, 2)
+		assert.Equal(t, 0, lookups)
+
+		r.endpoints["app1-dapr.default.svc.cluster.local:50002"] = cachedEndpoints{expires: time.Now()}
+		_, err = nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1", Namespace: "default", Port: 50002})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, lookups)
+	})
+
+	t.Run("service without pods", func(t *testing.T) {
+		r.lookupHost = func(host string) ([]string, error) {
+			return nil, nil
+		}
+
+		_, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app2", Namespace: "default", Port: 50002})
+		assert.Error(t, err)
+	})
+}
+
+func TestResolveEndpointZones(t *testing.T) {
+	client := fake.NewSimpleClientset(&discovery_v1beta1.EndpointSlice{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "app1-dapr-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{discovery_v1beta1.LabelServiceName: "app1-dapr"},
+		},
+		Endpoints: []discovery_v1beta1.Endpoint{
+			{Addresses: []string{"10.0.0.1"}, Topology: map[string]string{core_v1.LabelZoneFailureDomainStable: "zone-a"}},
+			{Addresses: []string{"10.0.0.2"}},
+		},
+	}, &discovery_v1beta1.EndpointSlice{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "app2-dapr-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{discovery_v1beta1.LabelServiceName: "app2-dapr"},
+		},
+		Endpoints: []discovery_v1beta1.Endpoint{
+			{Addresses: []string{"10.0.0.2"}, Topology: map[string]string{core_v1.LabelZoneFailureDomainStable: "zone-b"}},
+		},
+	})
+
+	r := NewResolver(logger.NewLogger("test"))
+	r.lookupZones = newZoneLookup(client)
+	r.lookupHost = func(host string) ([]string, error) {
+		return []string{"10.0.0.1", "10.0.0.2"}, nil
+	}
+
+	endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1", Namespace: "default", Port: 50002})
+	assert.NoError(t, err)
+	assert.Equal(t, []nr_loader.Endpoint{
+		{Address: "10.0.0.1:50002", Zone: "zone-a"},
+		{Address: "10.0.0.2:50002"},
+	}, endpoints)
+}
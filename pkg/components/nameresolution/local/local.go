@@ -0,0 +1,139 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package local
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/pkg/errors"
+)
+
+// DirectoryProperty is the metadata property with the directory shared by the Dapr instances of the host
+const DirectoryProperty = "directory"
+
+// registration is the content of the file each Dapr instance advertises itself with
+type registration struct {
+	Address string `json:"address"`
+	Zone    string `json:"zone,omitempty"`
+}
+
+// Resolver is a name resolver for the Dapr instances of a single host, a stand-in for mDNS that resolves
+// every instance of an app. Each Dapr instance advertises itself with a file in a directory shared by the
+// instances of the host, named after its app id.
+type Resolver struct {
+	dir    string
+	logger logger.Logger
+	// file is the registration file of the instance, empty if it isn't registered
+	file string
+}
+
+// NewResolver creates a local name resolver.
+func NewResolver(logger logger.Logger) *Resolver {
+	return &Resolver{logger: logger}
+}
+
+// Init registers the Dapr instance described by the mDNS metadata properties and the zone property,
+// if it has a name.
+func (r *Resolver) Init(metadata nr.Metadata) error {
+	props := metadata.Properties
+	r.dir = props[DirectoryProperty]
+	if r.dir == "" {
+		r.dir = filepath.Join(os.TempDir(), "dapr-nameresolution")
+	}
+
+	appID := props[nr.MDNSInstanceName]
+	if appID == "" {
+		return nil
+	}
+	address, port := props[nr.MDNSInstanceAddress], props[nr.MDNSInstancePort]
+	if address == "" || port == "" {
+		return errors.New("local name resolution requires the address and port of the instance")
+	}
+
+	reg := registration{
+		Address: net.JoinHostPort(address, port),
+		Zone:    props[nr_loader.ZoneProperty],
+	}
+	data, err := json.Marshal(reg)
+	if err != nil {
+		return err
+	}
+
+	appDir := filepath.Join(r.dir, appID)
+	if err := os.MkdirAll(appDir, 0700); err != nil {
+		return errors.Wrap(err, "error creating local name resolution directory")
+	}
+	file := filepath.Join(appDir, registrationFileName(reg.Address))
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		return errors.Wrap(err, "error registering instance with local name resolution")
+	}
+
+	r.file = file
+
+	r.logger.Infof("local name resolution registered %s at %s", appID, reg.Address)
+	return nil
+}
+
+// Close deregisters the Dapr instance.
+func (r *Resolver) Close() error {
+	if r.file == "" {
+		return nil
+	}
+	if err := os.Remove(r.file); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "error deregistering instance from local name resolution")
+	}
+	r.file = ""
+	return nil
+}
+
+// ResolveID resolves name to the address of the first instance of the app.
+func (r *Resolver) ResolveID(req nr.ResolveRequest) (string, error) {
+	endpoints, err := r.ResolveEndpoints(req)
+	if err != nil {
+		return "", err
+	}
+	return endpoints[0].Address, nil
+}
+
+// ResolveEndpoints resolves name to the endpoints of all the registered instances of the app.
+func (r *Resolver) ResolveEndpoints(req nr.ResolveRequest) ([]nr_loader.Endpoint, error) {
+	files, err := ioutil.ReadDir(filepath.Join(r.dir, req.ID))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var endpoints []nr_loader.Endpoint
+	for _, f := range files {
+		data, err := ioutil.ReadFile(filepath.Join(r.dir, req.ID, f.Name()))
+		if err != nil {
+			continue
+		}
+		var reg registration
+		if err := json.Unmarshal(data, &reg); err != nil {
+			r.logger.Warnf("invalid local name resolution registration %s: %s", f.Name(), err)
+			continue
+		}
+		endpoints = append(endpoints, nr_loader.Endpoint{Address: reg.Address, Zone: reg.Zone})
+	}
+
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("couldn't find service: %s", req.ID)
+	}
+	return endpoints, nil
+}
+
+func registrationFileName(address string) string {
+	return strings.NewReplacer(":", "_", "[", "", "]", "").Replace(address) + ".json"
+}
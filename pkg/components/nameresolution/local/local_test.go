@@ -0,0 +1,78 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func register(t *testing.T, dir, appID, address, port, zone string) *Resolver {
+	r := NewResolver(logger.NewLogger("test"))
+	err := r.Init(nr.Metadata{Properties: map[string]string{
+		DirectoryProperty:      dir,
+		nr.MDNSInstanceName:    appID,
+		nr.MDNSInstanceAddress: address,
+		nr.MDNSInstancePort:    port,
+		nr_loader.ZoneProperty: zone,
+	}})
+	assert.NoError(t, err)
+	return r
+}
+
+func TestResolveEndpoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dapr")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	r := register(t, dir, "app1", "127.0.0.1", "50002", "zone1")
+	register(t, dir, "app1", "127.0.0.1", "50003", "zone2")
+	register(t, dir, "app2", "127.0.0.1", "50004", "")
+
+	t.Run("all instances are resolved", func(t *testing.T) {
+		endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []nr_loader.Endpoint{
+			{Address: "127.0.0.1:50002", Zone: "zone1"},
+			{Address: "127.0.0.1:50003", Zone: "zone2"},
+		}, endpoints)
+	})
+
+	t.Run("resolve id returns an instance", func(t *testing.T) {
+		address, err := r.ResolveID(nr.ResolveRequest{ID: "app2"})
+		assert.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:50004", address)
+	})
+
+	t.Run("unknown app", func(t *testing.T) {
+		_, err := r.ResolveID(nr.ResolveRequest{ID: "app3"})
+		assert.Error(t, err)
+	})
+
+	t.Run("closed instances are deregistered", func(t *testing.T) {
+		closed := register(t, dir, "app2", "127.0.0.1", "50005", "")
+		assert.NoError(t, closed.Close())
+
+		endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app2"})
+		assert.NoError(t, err)
+		assert.Equal(t, []nr_loader.Endpoint{{Address: "127.0.0.1:50004"}}, endpoints)
+	})
+
+	t.Run("missing port", func(t *testing.T) {
+		err := NewResolver(logger.NewLogger("test")).Init(nr.Metadata{Properties: map[string]string{
+			DirectoryProperty:      dir,
+			nr.MDNSInstanceName:    "app1",
+			nr.MDNSInstanceAddress: "127.0.0.1",
+		}})
+		assert.Error(t, err)
+	})
+}
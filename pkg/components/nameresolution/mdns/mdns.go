@@ -0,0 +1,216 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package mdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_mdns "github.com/dapr/components-contrib/nameresolution/mdns"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/grandcat/zeroconf"
+)
+
+const (
+	// browseTimeout is how long the instances of an app are browsed for
+	browseTimeout = time.Second
+	// endpointsTTL is how long the browsed instances of an app are resolved from the cache
+	endpointsTTL = time.Second * 30
+	// zonePrefix prefixes the zone of an instance in its text records
+	zonePrefix = "zone="
+)
+
+// browseFunc sends the endpoints of the instances of an app announced on the network to found until ctx is done
+type browseFunc func(ctx context.Context, appID string, found chan<- nr_loader.Endpoint) error
+
+// Resolver is the mDNS name resolver, which also resolves every instance of an app announced on the network.
+// As browsing takes a while, the instances of each app are cached: once they expire, the cached instances
+// are still resolved while they are browsed again in the background.
+type Resolver struct {
+	nr.Resolver
+	logger logger.Logger
+	browse browseFunc
+
+	lock      sync.Mutex
+	endpoints map[string]*cachedEndpoints
+}
+
+type cachedEndpoints struct {
+	endpoints  []nr_loader.Endpoint
+	expires    time.Time
+	refreshing bool
+}
+
+// NewResolver creates an mDNS name resolver.
+func NewResolver(logger logger.Logger) *Resolver {
+	return &Resolver{
+		Resolver:  nr_mdns.NewResolver(logger),
+		logger:    logger,
+		browse:    browseZeroconf,
+		endpoints: map[string]*cachedEndpoints{},
+	}
+}
+
+// Init announces the instance on the network, with its zone in the text records.
+func (r *Resolver) Init(metadata nr.Metadata) error {
+	props := metadata.Properties
+	id, ok := props[nr.MDNSInstanceName]
+	if !ok {
+		return errors.New("name is missing")
+	}
+	hostAddress, ok := props[nr.MDNSInstanceAddress]
+	if !ok {
+		return errors.New("address is missing")
+	}
+	p, ok := props[nr.MDNSInstancePort]
+	if !ok {
+		return errors.New("port is missing")
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		return errors.New("port is invalid")
+	}
+
+	text := []string{id}
+	if zone := props[nr_loader.ZoneProperty]; zone != "" {
+		text = append(text, zonePrefix+zone)
+	}
+	host, _ := os.Hostname()
+	server, err := zeroconf.RegisterProxy(host, id, "local.", port, host, []string{hostAddress}, text, nil)
+	if err != nil {
+		return err
+	}
+	r.logger.Infof("local service entry announced: %s -> %s:%d", id, hostAddress, port)
+
+	go func() {
+		// announce the instance until the process is terminated
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		server.Shutdown()
+	}()
+	return nil
+}
+
+// ResolveEndpoints resolves name to the endpoints of the instances of the app announced on the network.
+func (r *Resolver) ResolveEndpoints(req nr.ResolveRequest) ([]nr_loader.Endpoint, error) {
+	r.lock.Lock()
+	cached, ok := r.endpoints[req.ID]
+	if ok {
+		endpoints := append([]nr_loader.Endpoint(nil), cached.endpoints...)
+		if time.Now().After(cached.expires) && !cached.refreshing {
+			cached.refreshing = true
+			go r.refresh(req.ID)
+		}
+		r.lock.Unlock()
+		return endpoints, nil
+	}
+	r.lock.Unlock()
+
+	endpoints := r.browseAll(req.ID)
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("couldn't find service: %s", req.ID)
+	}
+	r.lock.Lock()
+	r.endpoints[req.ID] = &cachedEndpoints{endpoints: endpoints, expires: time.Now().Add(endpointsTTL)}
+	r.lock.Unlock()
+	return append([]nr_loader.Endpoint(nil), endpoints...), nil
+}
+
+// refresh browses the instances of app again, and forgets the app if none is announced anymore
+func (r *Resolver) refresh(appID string) {
+	endpoints := r.browseAll(appID)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if len(endpoints) == 0 {
+		delete(r.endpoints, appID)
+		return
+	}
+	r.endpoints[appID] = &cachedEndpoints{endpoints: endpoints, expires: time.Now().Add(endpointsTTL)}
+}
+
+// browseAll returns the endpoints of the instances of app found within the browse timeout
+func (r *Resolver) browseAll(appID string) []nr_loader.Endpoint {
+	ctx, cancel := context.WithTimeout(context.Background(), browseTimeout)
+	found := make(chan nr_loader.Endpoint)
+	go func() {
+		defer cancel()
+		if err := r.browse(ctx, appID, found); err != nil {
+			r.logger.Warnf("mdns: failed to browse %s: %s", appID, err)
+		}
+		close(found)
+	}()
+
+	var endpoints []nr_loader.Endpoint
+	seen := map[string]bool{}
+	for endpoint := range found {
+		if seen[endpoint.Address] {
+			continue
+		}
+		seen[endpoint.Address] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+func browseZeroconf(ctx context.Context, appID string, found chan<- nr_loader.Endpoint) error {
+	resolver, err := zeroconf.NewResolver(nil)
+	if err != nil {
+		return err
+	}
+	entries := make(chan *zeroconf.ServiceEntry)
+	if err := resolver.Browse(ctx, appID, "local.", entries); err != nil {
+		return err
+	}
+
+	// entries is closed once ctx is done
+	for entry := range entries {
+		if !announces(entry, appID) {
+			continue
+		}
+		found <- entryEndpoint(entry)
+	}
+	return nil
+}
+
+// entryEndpoint returns the endpoint of the instance that announced entry
+func entryEndpoint(entry *zeroconf.ServiceEntry) nr_loader.Endpoint {
+	addr := "localhost"
+	if len(entry.AddrIPv4) > 0 {
+		addr = entry.AddrIPv4[0].String()
+	} else if len(entry.AddrIPv6) > 0 {
+		addr = entry.AddrIPv6[0].String()
+	}
+	endpoint := nr_loader.Endpoint{Address: net.JoinHostPort(addr, strconv.Itoa(entry.Port))}
+	for _, text := range entry.Text {
+		if strings.HasPrefix(text, zonePrefix) {
+			endpoint.Zone = strings.TrimPrefix(text, zonePrefix)
+		}
+	}
+	return endpoint
+}
+
+// announces returns whether entry was announced by an instance of app, which has its id in the text records
+func announces(entry *zeroconf.ServiceEntry, appID string) bool {
+	for _, text := range entry.Text {
+		if text == appID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package mdns
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/grandcat/zeroconf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveEndpoints(t *testing.T) {
+	t.Run("every browsed instance is resolved", func(t *testing.T) {
+		r := NewResolver(logger.NewLogger("test"))
+		browses := 0
+		r.browse = func(ctx context.Context, appID string, found chan<- nr_loader.Endpoint) error {
+			browses++
+			found <- nr_loader.Endpoint{Address: "10.0.0.2:50002", Zone: "zone-a"}
+			found <- nr_loader.Endpoint{Address: "10.0.0.1:50002"}
+			found <- nr_loader.Endpoint{Address: "10.0.0.2:50002", Zone: "zone-a"}
+			return nil
+		}
+
+		expected := []nr_loader.Endpoint{
+			{Address: "10.0.0.1:50002"},
+			{Address: "10.0.0.2:50002", Zone: "zone-a"},
+		}
+		endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1"})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, endpoints)
+
+		endpoints, err = nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1"})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, endpoints)
+		assert.Equal(t, 1, browses)
+	})
+
+	t.Run("expired instances are resolved while they are browsed again", func(t *testing.T) {
+		r := NewResolver(logger.NewLogger("test"))
+		r.endpoints["app1"] = &cachedEndpoints{
+			endpoints: []nr_loader.Endpoint{{Address: "10.0.0.1:50002"}},
+			expires:   time.Now(),
+		}
+		release := make(chan struct{})
+		browses := make(chan struct{}, 2)
+		r.browse = func(ctx context.Context, appID string, found chan<- nr_loader.Endpoint) error {
+			browses <- struct{}{}
+			<-release
+			found <- nr_loader.Endpoint{Address: "10.0.0.2:50002"}
+			return nil
+		}
+
+		for i := 0; i < 2; i++ {
+			endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1"})
+			assert.NoError(t, err)
+			assert.Equal(t, []nr_loader.Endpoint{{Address: "10.0.0.1:50002"}}, endpoints)
+		}
+		<-browses
+		close(release)
+
+		assert.Eventually(t, func() bool {
+			endpoints, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1"})
+			return err == nil && endpoints[0].Address == "10.0.0.2:50002"
+		}, time.Second, time.Millisecond*10)
+		assert.Len(t, browses, 0)
+	})
+
+	t.Run("unknown app", func(t *testing.T) {
+		r := NewResolver(logger.NewLogger("test"))
+		r.browse = func(ctx context.Context, appID string, found chan<- nr_loader.Endpoint) error {
+			return nil
+		}
+
+		_, err := nr_loader.ResolveEndpoints(r, nr.ResolveRequest{ID: "app1"})
+		assert.Error(t, err)
+	})
+}
+
+func TestEntryEndpoint(t *testing.T) {
+	entry := zeroconf.NewServiceEntry("host", "app1", "local.")
+	entry.AddrIPv4 = []net.IP{net.ParseIP("10.0.0.1")}
+	entry.Port = 50002
+	entry.Text = []string{"app1", "zone=zone-a"}
+
+	assert.Equal(t, nr_loader.Endpoint{Address: "10.0.0.1:50002", Zone: "zone-a"}, entryEndpoint(entry))
+
+	entry.Text = []string{"app1"}
+	assert.Equal(t, nr_loader.Endpoint{Address: "10.0.0.1:50002"}, entryEndpoint(entry))
+}
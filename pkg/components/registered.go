@@ -0,0 +1,24 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package components
+
+const (
+	// StatusLoaded is the status of a component that was initialized
+	StatusLoaded = "loaded"
+	// StatusFailed is the status of a component that failed to initialize
+	StatusFailed = "failed"
+	// StatusPending is the status of a component waiting for one of its dependencies to be loaded
+	StatusPending = "pending"
+)
+
+// RegisteredComponent describes a component processed by the runtime as reported by the metadata API.
+// It never carries the component metadata, which can hold secrets.
+type RegisteredComponent struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Version string `json:"version"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,24 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package secretstores
+
+type (
+	// BulkGetSecretRequest is the request to retrieve all secrets exposed by a secret store.
+	BulkGetSecretRequest struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+
+	// BulkGetSecretResponse holds all secrets exposed by a secret store, keyed by secret name.
+	BulkGetSecretResponse struct {
+		Data map[string]map[string]string `json:"data"`
+	}
+
+	// BulkSecretStore is implemented by secret stores that can enumerate and return
+	// all of their secrets in a single call.
+	BulkSecretStore interface {
+		BulkGetSecret(req BulkGetSecretRequest) (BulkGetSecretResponse, error)
+	}
+)
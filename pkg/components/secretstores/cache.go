@@ -0,0 +1,229 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package secretstores
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/logger"
+)
+
+var log = logger.NewLogger("dapr.components.secretstores")
+
+type (
+	// CachedSecretStore is a secret store that serves secrets from an in-memory cache
+	// and refreshes them in the background.
+	CachedSecretStore interface {
+		secretstores.SecretStore
+		// Close stops the background refresh of the cache.
+		Close()
+	}
+
+	cachedSecretStore struct {
+		store    secretstores.SecretStore
+		ttl      time.Duration
+		onChange func(name string)
+		keep     func(name string) bool
+		entries  map[string]*cacheEntry
+		lock     sync.RWMutex
+		stopCh   chan struct{}
+		stopOnce sync.Once
+	}
+
+	// cachedBulkSecretStore is used when the wrapped store also supports bulk retrieval,
+	// so callers can still discover the BulkSecretStore capability on the cached store.
+	cachedBulkSecretStore struct {
+		*cachedSecretStore
+		bulk BulkSecretStore
+	}
+
+	cacheEntry struct {
+		req     secretstores.GetSecretRequest
+		resp    secretstores.GetSecretResponse
+		expires time.Time
+		// read is set when the entry is read and cleared by the refresh, so entries that aren't read
+		// between two refreshes can be evicted
+		read bool
+	}
+)
+
+// NewCachedSecretStore wraps an initialized secret store with a cache.
+// Cached secrets are served until ttl elapses and are re-read from the store every ttl.
+// Secrets that aren't read within ttl are evicted instead of being re-read, unless keep
+// reports that they are still in use, e.g. because they are referenced by components.
+// onChange, if given, is called with the secret name whenever a refresh returns a value
+// different from the cached one.
+func NewCachedSecretStore(store secretstores.SecretStore, ttl time.Duration, onChange func(name string), keep func(name string) bool) CachedSecretStore {
+	c := &cachedSecretStore{
+		store:    store,
+		ttl:      ttl,
+		onChange: onChange,
+		keep:     keep,
+		entries:  map[string]*cacheEntry{},
+		stopCh:   make(chan struct{}),
+	}
+	go c.refreshLoop()
+
+	if bulk, ok := store.(BulkSecretStore); ok {
+		return &cachedBulkSecretStore{cachedSecretStore: c, bulk: bulk}
+	}
+	return c
+}
+
+// Init initializes the wrapped secret store.
+func (c *cachedSecretStore) Init(metadata secretstores.Metadata) error {
+	return c.store.Init(metadata)
+}
+
+// GetSecret returns the cached secret if it has not expired, otherwise it reads it from the wrapped store.
+func (c *cachedSecretStore) GetSecret(req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	key := cacheKey(req)
+
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	if ok && time.Now().Before(entry.expires) {
+		entry.read = true
+		resp := copyResponse(entry.resp)
+		c.lock.Unlock()
+		return resp, nil
+	}
+	c.lock.Unlock()
+
+	resp, err := c.store.GetSecret(req)
+	if err != nil {
+		return resp, err
+	}
+
+	c.lock.Lock()
+	c.entries[key] = &cacheEntry{
+		req:     req,
+		resp:    copyResponse(resp),
+		expires: time.Now().Add(c.ttl),
+		read:    true,
+	}
+	c.lock.Unlock()
+	return resp, nil
+}
+
+// Close stops the background refresh of the cache.
+func (c *cachedSecretStore) Close() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+}
+
+func (c *cachedSecretStore) refreshLoop() {
+	ticker := time.NewTicker(c.ttl)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.refresh()
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// refresh re-reads the cached secrets that were read since the previous refresh or are kept,
+// evicts the others and notifies about the secrets whose value changed.
+// keep and the notifications are called without holding the lock, as they may read from the cache.
+func (c *cachedSecretStore) refresh() {
+	c.lock.Lock()
+	reqs := make(map[string]secretstores.GetSecretRequest, len(c.entries))
+	unread := map[string]secretstores.GetSecretRequest{}
+	for key, entry := range c.entries {
+		if entry.read {
+			reqs[key] = entry.req
+		} else {
+			unread[key] = entry.req
+		}
+		entry.read = false
+	}
+	c.lock.Unlock()
+
+	for key, req := range unread {
+		if c.keep != nil && c.keep(req.Name) {
+			reqs[key] = req
+			continue
+		}
+		c.lock.Lock()
+		if entry, ok := c.entries[key]; ok && !entry.read {
+			delete(c.entries, key)
+		}
+		c.lock.Unlock()
+	}
+
+	changed := map[string]bool{}
+	for key, req := range reqs {
+		resp, err := c.store.GetSecret(req)
+
+		c.lock.Lock()
+		entry, ok := c.entries[key]
+		if !ok {
+			c.lock.Unlock()
+			continue
+		}
+		if err != nil {
+			// Expire the entry so that the next read goes to the store and surfaces the error.
+			log.Warnf("error refreshing secret %s: %s", req.Name, err)
+			entry.expires = time.Time{}
+		} else {
+			if !reflect.DeepEqual(entry.resp.Data, resp.Data) {
+				changed[req.Name] = true
+			}
+			entry.resp = copyResponse(resp)
+			entry.expires = time.Now().Add(c.ttl)
+		}
+		c.lock.Unlock()
+	}
+
+	if c.onChange == nil {
+		return
+	}
+	for name := range changed {
+		log.Debugf("secret %s changed", name)
+		c.onChange(name)
+	}
+}
+
+// BulkGetSecret retrieves all secrets from the wrapped store. Bulk reads are not cached.
+func (c *cachedBulkSecretStore) BulkGetSecret(req BulkGetSecretRequest) (BulkGetSecretResponse, error) {
+	return c.bulk.BulkGetSecret(req)
+}
+
+func cacheKey(req secretstores.GetSecretRequest) string {
+	keys := make([]string, 0, len(req.Metadata))
+	for k := range req.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(req.Name)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "||%s=%s", k, req.Metadata[k])
+	}
+	return b.String()
+}
+
+func copyResponse(resp secretstores.GetSecretResponse) secretstores.GetSecretResponse {
+	if resp.Data == nil {
+		return resp
+	}
+	data := make(map[string]string, len(resp.Data))
+	for k, v := range resp.Data {
+		data[k] = v
+	}
+	return secretstores.GetSecretResponse{Data: data}
+}
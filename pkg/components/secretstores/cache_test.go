@@ -0,0 +1,153 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package secretstores
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingSecretStore struct {
+	lock  sync.Mutex
+	value string
+	reads int
+}
+
+func (s *countingSecretStore) Init(metadata secretstores.Metadata) error {
+	return nil
+}
+
+func (s *countingSecretStore) GetSecret(req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.reads++
+	return secretstores.GetSecretResponse{
+		Data: map[string]string{req.Name: s.value},
+	}, nil
+}
+
+func (s *countingSecretStore) set(value string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.value = value
+}
+
+func (s *countingSecretStore) readCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.reads
+}
+
+type bulkCountingSecretStore struct {
+	countingSecretStore
+}
+
+func (s *bulkCountingSecretStore) BulkGetSecret(req BulkGetSecretRequest) (BulkGetSecretResponse, error) {
+	return BulkGetSecretResponse{}, nil
+}
+
+func TestCachedSecretStore(t *testing.T) {
+	t.Run("serves secrets from cache until expired", func(t *testing.T) {
+		store := &countingSecretStore{value: "v1"}
+		cached := NewCachedSecretStore(store, time.Hour, nil, nil)
+		defer cached.Close()
+
+		for i := 0; i < 3; i++ {
+			resp, err := cached.GetSecret(secretstores.GetSecretRequest{Name: "secret"})
+			assert.NoError(t, err)
+			assert.Equal(t, "v1", resp.Data["secret"])
+		}
+		assert.Equal(t, 1, store.readCount())
+
+		// different metadata is cached separately
+		_, err := cached.GetSecret(secretstores.GetSecretRequest{
+			Name:     "secret",
+			Metadata: map[string]string{"namespace": "ns"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, store.readCount())
+	})
+
+	t.Run("refresh notifies changed secrets", func(t *testing.T) {
+		store := &countingSecretStore{value: "v1"}
+		changed := make(chan string, 1)
+		cached := NewCachedSecretStore(store, 10*time.Millisecond, func(name string) {
+			changed <- name
+		}, nil)
+		defer cached.Close()
+
+		_, err := cached.GetSecret(secretstores.GetSecretRequest{Name: "secret"})
+		assert.NoError(t, err)
+
+		store.set("v2")
+		select {
+		case name := <-changed:
+			assert.Equal(t, "secret", name)
+		case <-time.After(time.Second):
+			assert.Fail(t, "secret change was not notified")
+		}
+
+		resp, err := cached.GetSecret(secretstores.GetSecretRequest{Name: "secret"})
+		assert.NoError(t, err)
+		assert.Equal(t, "v2", resp.Data["secret"])
+	})
+
+	t.Run("evicts secrets that are not read", func(t *testing.T) {
+		store := &countingSecretStore{value: "v1"}
+		cached := NewCachedSecretStore(store, 10*time.Millisecond, nil, nil)
+		defer cached.Close()
+
+		_, err := cached.GetSecret(secretstores.GetSecretRequest{Name: "secret"})
+		assert.NoError(t, err)
+
+		c := cached.(*cachedSecretStore)
+		assert.Eventually(t, func() bool {
+			c.lock.RLock()
+			defer c.lock.RUnlock()
+			return len(c.entries) == 0
+		}, time.Second, 5*time.Millisecond)
+
+		// evicted secrets are no longer read from the store
+		reads := store.readCount()
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, reads, store.readCount())
+	})
+
+	t.Run("keeps refreshing secrets in use", func(t *testing.T) {
+		store := &countingSecretStore{value: "v1"}
+		cached := NewCachedSecretStore(store, 10*time.Millisecond, nil, func(name string) bool {
+			return name == "referenced"
+		})
+		defer cached.Close()
+
+		_, err := cached.GetSecret(secretstores.GetSecretRequest{Name: "referenced"})
+		assert.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			return store.readCount() > 3
+		}, time.Second, 5*time.Millisecond)
+		c := cached.(*cachedSecretStore)
+		c.lock.RLock()
+		assert.Len(t, c.entries, 1)
+		c.lock.RUnlock()
+	})
+
+	t.Run("bulk support is preserved", func(t *testing.T) {
+		cached := NewCachedSecretStore(&countingSecretStore{}, time.Hour, nil, nil)
+		defer cached.Close()
+		_, ok := cached.(BulkSecretStore)
+		assert.False(t, ok)
+
+		cachedBulk := NewCachedSecretStore(&bulkCountingSecretStore{}, time.Hour, nil, nil)
+		defer cachedBulk.Close()
+		_, ok = cachedBulk.(BulkSecretStore)
+		assert.True(t, ok)
+	})
+}
@@ -11,7 +11,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -24,6 +26,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	yaml "gopkg.in/yaml.v2"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -41,10 +44,22 @@ const (
 	SpiffeIDPrefix      = "spiffe://"
 	HTTPProtocol        = "http"
 	GRPCProtocol        = "grpc"
+	RoundRobinPolicy    = "roundRobin"
+	LeastRequestPolicy  = "leastRequest"
+
+	ActorsBuildingBlock = "actors"
+	PubSubBuildingBlock = "pubsub"
+	StateBuildingBlock  = "state"
+
+	StateGetOperation         = "get"
+	StateSaveOperation        = "save"
+	StateDeleteOperation      = "delete"
+	StateTransactionOperation = "transaction"
 )
 
 type Configuration struct {
-	Spec ConfigurationSpec `json:"spec" yaml:"spec"`
+	meta_v1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Spec               ConfigurationSpec `json:"spec" yaml:"spec"`
 }
 
 // AccessControlList is an in-memory access control list config for fast lookup
@@ -52,6 +67,8 @@ type AccessControlList struct {
 	DefaultAction string
 	TrustDomain   string
 	PolicySpec    map[string]AccessControlListPolicySpec
+	// GuardedBuildingBlocks are the building blocks with operation policies in at least one app policy
+	GuardedBuildingBlocks map[string]bool
 }
 
 // AccessControlListPolicySpec is an in-memory access control list config per app for fast lookup
@@ -61,22 +78,120 @@ type AccessControlListPolicySpec struct {
 	TrustDomain         string
 	Namespace           string
 	AppOperationActions map[string]AccessControlListOperationAction
+	// BuildingBlockOperationActions are the operation actions of the building blocks other than service invocation
+	BuildingBlockOperationActions map[string]map[string]AccessControlListOperationAction
 }
 
 // AccessControlListOperationAction is an in-memory access control list config per operation for fast lookup
 type AccessControlListOperationAction struct {
-	VerbAction       map[string]string
-	OperationPostFix string
-	OperationAction  string
+	VerbAction      map[string]string
+	OperationAction string
+	// Claims are the verified claims the caller must have for the operation to match
+	Claims map[string]string
+	// OperationSegments are the path segments of the operation. A segment can be the glob * matching a single segment,
+	// the glob ** matching any number of segments or a named path parameter like {id} matching a single segment
+	OperationSegments []string
+}
+
+// AccessControlDecision explains which policy and operation the access control policies applied to an operation
+type AccessControlDecision struct {
+	Allowed bool   `json:"allowed"`
+	Action  string `json:"action"`
+	// ActionPolicy is the level of the default action that applied, app or global
+	ActionPolicy string `json:"actionPolicy"`
+	// AppID and Namespace identify the app policy that applied, if any
+	AppID     string `json:"appId,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Operation is the operation of the app policy that matched, if any
+	Operation string `json:"operation,omitempty"`
+	// HTTPVerb is the verb of the operation that matched, if any
+	HTTPVerb string `json:"httpVerb,omitempty"`
+	// PathParameters are the values of the named path parameters of the operation that matched
+	PathParameters map[string]string `json:"pathParameters,omitempty"`
 }
 
 type ConfigurationSpec struct {
-	HTTPPipelineSpec  PipelineSpec      `json:"httpPipeline,omitempty" yaml:"httpPipeline,omitempty"`
-	TracingSpec       TracingSpec       `json:"tracing,omitempty" yaml:"tracing,omitempty"`
-	MTLSSpec          MTLSSpec          `json:"mtls,omitempty"`
-	MetricSpec        MetricSpec        `json:"metric,omitempty" yaml:"metric,omitempty"`
-	Secrets           SecretsSpec       `json:"secrets,omitempty" yaml:"secrets,omitempty"`
-	AccessControlSpec AccessControlSpec `json:"accessControl,omitempty" yaml:"accessControl,omitempty"`
+	HTTPPipelineSpec  PipelineSpec          `json:"httpPipeline,omitempty" yaml:"httpPipeline,omitempty"`
+	GRPCPipelineSpec  PipelineSpec          `json:"grpcPipeline,omitempty" yaml:"grpcPipeline,omitempty"`
+	TracingSpec       TracingSpec           `json:"tracing,omitempty" yaml:"tracing,omitempty"`
+	MTLSSpec          MTLSSpec              `json:"mtls,omitempty"`
+	MetricSpec        MetricSpec            `json:"metric,omitempty" yaml:"metric,omitempty"`
+	Secrets           SecretsSpec           `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	Bindings          BindingsSpec          `json:"bindings,omitempty" yaml:"bindings,omitempty"`
+	AccessControlSpec AccessControlSpec     `json:"accessControl,omitempty" yaml:"accessControl,omitempty"`
+	ServiceInvocation ServiceInvocationSpec `json:"serviceInvocation,omitempty" yaml:"serviceInvocation,omitempty"`
+	NameResolution    NameResolutionSpec    `json:"nameResolution,omitempty" yaml:"nameResolution,omitempty"`
+	APITokens         APITokensSpec         `json:"apiTokens,omitempty" yaml:"apiTokens,omitempty"`
+	JWTAuthentication JWTAuthenticationSpec `json:"jwtAuthentication,omitempty" yaml:"jwtAuthentication,omitempty"`
+	ComponentHealth   ComponentHealthSpec   `json:"componentHealth,omitempty" yaml:"componentHealth,omitempty"`
+}
+
+// ComponentHealthSpec defines the health probing of the components and which of them must be healthy
+// for Dapr to report ready
+type ComponentHealthSpec struct {
+	// ProbeInterval is how often the components are probed, as a duration string
+	ProbeInterval string `json:"probeInterval,omitempty" yaml:"probeInterval,omitempty"`
+	// ProbeTimeout is how long a component has to answer a probe, as a duration string
+	ProbeTimeout string `json:"probeTimeout,omitempty" yaml:"probeTimeout,omitempty"`
+	// RequiredComponents are the names of the components that must be healthy for Dapr to report ready
+	RequiredComponents []string `json:"requiredComponents,omitempty" yaml:"requiredComponents,omitempty"`
+}
+
+// JWTAuthenticationSpec defines the authentication of the callers of the Dapr APIs with bearer JWTs.
+// The signing keys are read from a JWKS document, which is either a local file or published at a URL.
+type JWTAuthenticationSpec struct {
+	// Issuers are the accepted issuers of the tokens. JWT authentication is enabled if it is not empty
+	Issuers []string `json:"issuers,omitempty" yaml:"issuers,omitempty"`
+	// Audiences are the accepted audiences of the tokens, any audience is accepted if empty
+	Audiences []string `json:"audiences,omitempty" yaml:"audiences,omitempty"`
+	JWKSFile  string   `json:"jwksFile,omitempty" yaml:"jwksFile,omitempty"`
+	JWKSURL   string   `json:"jwksUrl,omitempty" yaml:"jwksUrl,omitempty"`
+	// ForwardedClaims are the custom claims forwarded to the app along with the subject, issuer and audience
+	ForwardedClaims []string `json:"forwardedClaims,omitempty" yaml:"forwardedClaims,omitempty"`
+}
+
+// APITokensSpec defines where the tokens that authenticate calls to the Dapr APIs are loaded from.
+// The tokens are reloaded periodically so that they can be rotated without downtime.
+type APITokensSpec struct {
+	// File is the path of a file with the tokens
+	File string `json:"file,omitempty" yaml:"file,omitempty"`
+	// SecretStore and SecretName identify a secret with the tokens, under SecretKey which defaults to the secret name
+	SecretStore string `json:"secretStore,omitempty" yaml:"secretStore,omitempty"`
+	SecretName  string `json:"secretName,omitempty" yaml:"secretName,omitempty"`
+	SecretKey   string `json:"secretKey,omitempty" yaml:"secretKey,omitempty"`
+	// RefreshInterval is how often the tokens are reloaded, as a duration string
+	RefreshInterval string `json:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty"`
+}
+
+// NameResolutionSpec selects the name resolution component
+type NameResolutionSpec struct {
+	// Component is the name of the name resolution component, which defaults to mdns in standalone mode
+	// and kubernetes in Kubernetes mode
+	Component string `json:"component,omitempty" yaml:"component,omitempty"`
+}
+
+// ServiceInvocationSpec defines the timeouts of service invocations without a caller supplied deadline
+type ServiceInvocationSpec struct {
+	// DefaultRequestTimeout is the timeout of invocations of any app, as a duration string such as "30s"
+	DefaultRequestTimeout string `json:"defaultRequestTimeout,omitempty" yaml:"defaultRequestTimeout,omitempty"`
+	// Apps overrides the timeout of invocations of specific apps
+	Apps []AppInvocationSpec `json:"apps,omitempty" yaml:"apps,omitempty"`
+	// LoadBalancing defines how invocations are spread across the instances of the target app
+	LoadBalancing LoadBalancingSpec `json:"loadBalancing,omitempty" yaml:"loadBalancing,omitempty"`
+}
+
+// LoadBalancingSpec defines how invocations are spread across the instances of an app
+type LoadBalancingSpec struct {
+	// Policy is roundRobin, the default, or leastRequest
+	Policy string `json:"policy,omitempty" yaml:"policy,omitempty"`
+	// EjectionDuration is how long an instance that failed is not invoked, as a duration string such as "30s"
+	EjectionDuration string `json:"ejectionDuration,omitempty" yaml:"ejectionDuration,omitempty"`
+}
+
+// AppInvocationSpec defines the timeout of invocations of an app
+type AppInvocationSpec struct {
+	AppID          string `json:"appId" yaml:"appId"`
+	RequestTimeout string `json:"requestTimeout" yaml:"requestTimeout"`
 }
 
 type SecretsSpec struct {
@@ -91,6 +206,25 @@ type SecretsScope struct {
 	DeniedSecrets  []string `json:"deniedSecrets,omitempty" yaml:"deniedSecrets,omitempty"`
 }
 
+type BindingsSpec struct {
+	Scopes []BindingsScope `json:"scopes"`
+}
+
+// BindingsScope defines which operations apps are allowed to invoke on an output binding
+type BindingsScope struct {
+	DefaultAccess string             `json:"defaultAccess,omitempty" yaml:"defaultAccess,omitempty"`
+	BindingName   string             `json:"bindingName" yaml:"bindingName"`
+	AppPolicies   []BindingAppPolicy `json:"appPolicies,omitempty" yaml:"appPolicies,omitempty"`
+}
+
+// BindingAppPolicy defines the operations an app is allowed or denied to invoke on an output binding
+type BindingAppPolicy struct {
+	AppID             string   `json:"appId" yaml:"appId"`
+	DefaultAccess     string   `json:"defaultAccess,omitempty" yaml:"defaultAccess,omitempty"`
+	AllowedOperations []string `json:"allowedOperations,omitempty" yaml:"allowedOperations,omitempty"`
+	DeniedOperations  []string `json:"deniedOperations,omitempty" yaml:"deniedOperations,omitempty"`
+}
+
 type PipelineSpec struct {
 	Handlers []HandlerSpec `json:"handlers" yaml:"handlers"`
 }
@@ -113,11 +247,92 @@ type SelectorField struct {
 type TracingSpec struct {
 	SamplingRate string `json:"samplingRate" yaml:"samplingRate"`
 	Stdout       bool   `json:"stdout" yaml:"stdout"`
+	// Otel exports the spans to an OpenTelemetry collector with OTLP, if its endpoint is set
+	Otel OtelSpec `json:"otel,omitempty" yaml:"otel,omitempty"`
+	// Zipkin exports the spans to a Zipkin collector, if its endpoint is set
+	Zipkin ZipkinSpec `json:"zipkin,omitempty" yaml:"zipkin,omitempty"`
+}
+
+// OtelSpec defines the export of spans with OTLP over gRPC or HTTP
+type OtelSpec struct {
+	// EndpointAddress is host:port for the grpc protocol and the URL of the traces endpoint for the http protocol,
+	// e.g. http://localhost:4318/v1/traces
+	EndpointAddress string `json:"endpointAddress,omitempty" yaml:"endpointAddress,omitempty"`
+	// Protocol is either grpc or http, defaults to grpc
+	Protocol string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	// Insecure disables TLS for the grpc protocol. The URL scheme decides for the http protocol
+	Insecure bool                  `json:"insecure,omitempty" yaml:"insecure,omitempty"`
+	Headers  map[string]string     `json:"headers,omitempty" yaml:"headers,omitempty"`
+	TLS      TraceExporterTLSSpec  `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Batching TraceExporterBatching `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
+// ZipkinSpec defines the export of spans to the v2 API of Zipkin
+type ZipkinSpec struct {
+	// EndpointAddress is the URL of the spans endpoint, e.g. http://localhost:9411/api/v2/spans
+	EndpointAddress string                `json:"endpointAddress,omitempty" yaml:"endpointAddress,omitempty"`
+	Headers         map[string]string     `json:"headers,omitempty" yaml:"headers,omitempty"`
+	TLS             TraceExporterTLSSpec  `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Batching        TraceExporterBatching `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
+// TraceExporterTLSSpec defines the TLS settings of the connections to a trace collector
+type TraceExporterTLSSpec struct {
+	// CAFile is the path of the PEM encoded CA certificates of the collector, the system pool is used if empty
+	CAFile string `json:"caFile,omitempty" yaml:"caFile,omitempty"`
+	// CertFile and KeyFile are the paths of the PEM encoded client certificate and key, if the collector requires one
+	CertFile           string `json:"certFile,omitempty" yaml:"certFile,omitempty"`
+	KeyFile            string `json:"keyFile,omitempty" yaml:"keyFile,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty" yaml:"insecureSkipVerify,omitempty"`
+}
+
+// TraceExporterBatching defines how spans are batched before being exported
+type TraceExporterBatching struct {
+	// MaxBatchSize is the maximum number of spans exported at once
+	MaxBatchSize int `json:"maxBatchSize,omitempty" yaml:"maxBatchSize,omitempty"`
+	// MaxQueueSize is the maximum number of spans waiting to be exported, more spans are dropped
+	MaxQueueSize int `json:"maxQueueSize,omitempty" yaml:"maxQueueSize,omitempty"`
+	// FlushInterval is the maximum time a span waits to be exported, e.g. 5s
+	FlushInterval string `json:"flushInterval,omitempty" yaml:"flushInterval,omitempty"`
 }
 
 // MetricSpec configuration for metrics
 type MetricSpec struct {
 	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Rules drop and rewrite the tags of the metrics to bound their cardinality
+	Rules []MetricRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// MetricRule controls the tags of the metrics with a name, e.g. http/server/latency, or of all the metrics with *.
+// The tag keys are removed from the metric, while the tag values are rewritten when they are recorded and so
+// apply to the other metrics of the same measurement, e.g. http/server/response_count.
+type MetricRule struct {
+	Name string `json:"name" yaml:"name"`
+	// AllowTags are the only tags kept by the metric, all the tags are kept if empty
+	AllowTags []string `json:"allowTags,omitempty" yaml:"allowTags,omitempty"`
+	// DropTags are removed from the metric
+	DropTags []string `json:"dropTags,omitempty" yaml:"dropTags,omitempty"`
+	// Tags rewrite the values of the tags of the metric
+	Tags []MetricTagRule `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// MetricTagRule rewrites the values of a tag. The values which are not allowed or above the
+// maximum number of distinct values are reported as the _other overflow value.
+type MetricTagRule struct {
+	Name string `json:"name" yaml:"name"`
+	// Replacements are applied in order to the values, e.g. ^/orders/[^/]+ replaced with /orders/{id}
+	Replacements []MetricTagReplacement `json:"replacements,omitempty" yaml:"replacements,omitempty"`
+	// AllowedValues are the only values reported after the replacements, any value is reported if empty
+	AllowedValues []string `json:"allowedValues,omitempty" yaml:"allowedValues,omitempty"`
+	// MaxValues is the maximum number of distinct values reported, 0 means unlimited
+	MaxValues int `json:"maxValues,omitempty" yaml:"maxValues,omitempty"`
+}
+
+// MetricTagReplacement replaces the parts of a tag value which match a regular expression.
+// The replacement can refer to the groups of the expression with $1 or ${name}.
+type MetricTagReplacement struct {
+	Regex       string `json:"regex" yaml:"regex"`
+	Replacement string `json:"replacement" yaml:"replacement"`
 }
 
 // AppPolicySpec defines the policy data structure for each app
@@ -127,6 +342,13 @@ type AppPolicySpec struct {
 	TrustDomain         string         `json:"trustDomain" yaml:"trustDomain"`
 	Namespace           string         `json:"namespace" yaml:"namespace"`
 	AppOperationActions []AppOperation `json:"operations" yaml:"operations"`
+	// ActorOperations are matched against actorType/method of the actors the app invokes
+	ActorOperations []AppOperation `json:"actors,omitempty" yaml:"actors,omitempty"`
+	// PubSubOperations are matched against pubsubName/topic of the messages the app publishes
+	PubSubOperations []AppOperation `json:"pubsub,omitempty" yaml:"pubsub,omitempty"`
+	// StateOperations are matched against storeName/operation of the state the app accesses,
+	// where operation is one of get, save, delete and transaction
+	StateOperations []AppOperation `json:"state,omitempty" yaml:"state,omitempty"`
 }
 
 // AppOperation defines the data structure for each app operation
@@ -134,6 +356,8 @@ type AppOperation struct {
 	Operation string   `json:"name" yaml:"name"`
 	HTTPVerb  []string `json:"httpVerb" yaml:"httpVerb"`
 	Action    string   `json:"action" yaml:"action"`
+	// Claims restricts the operation to callers authenticated with a JWT with these claims
+	Claims map[string]string `json:"claims,omitempty" yaml:"claims,omitempty"`
 }
 
 // AccessControlSpec is the spec object in ConfigurationSpec
@@ -195,6 +419,14 @@ func LoadStandaloneConfiguration(config string) (*Configuration, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = sortAndValidateBindingsConfiguration(&conf)
+	if err != nil {
+		return nil, err
+	}
+	err = validateConfiguration(&conf)
+	if err != nil {
+		return nil, err
+	}
 
 	return &conf, nil
 }
@@ -221,6 +453,14 @@ func LoadKubernetesConfiguration(config, namespace string, operatorClient operat
 	if err != nil {
 		return nil, err
 	}
+	err = sortAndValidateBindingsConfiguration(&conf)
+	if err != nil {
+		return nil, err
+	}
+	err = validateConfiguration(&conf)
+	if err != nil {
+		return nil, err
+	}
 
 	return &conf, nil
 }
@@ -234,10 +474,8 @@ func sortAndValidateSecretsConfiguration(conf *Configuration) error {
 		if set.Has(scope.StoreName) {
 			return errors.Errorf("%q storeName is repeated in secrets configuration", scope.StoreName)
 		}
-		if scope.DefaultAccess != "" &&
-			!strings.EqualFold(scope.DefaultAccess, AllowAccess) &&
-			!strings.EqualFold(scope.DefaultAccess, DenyAccess) {
-			return errors.Errorf("defaultAccess %q can be either allow or deny", scope.DefaultAccess)
+		if err := validateDefaultAccess(scope.DefaultAccess); err != nil {
+			return err
 		}
 		set.Insert(scope.StoreName)
 
@@ -249,6 +487,287 @@ func sortAndValidateSecretsConfiguration(conf *Configuration) error {
 	return nil
 }
 
+// Validate the bindings configuration and sort the allow and deny lists if present.
+func sortAndValidateBindingsConfiguration(conf *Configuration) error {
+	set := sets.NewString()
+	for _, scope := range conf.Spec.Bindings.Scopes {
+		if set.Has(scope.BindingName) {
+			return errors.Errorf("%q bindingName is repeated in bindings configuration", scope.BindingName)
+		}
+		if err := validateDefaultAccess(scope.DefaultAccess); err != nil {
+			return err
+		}
+		set.Insert(scope.BindingName)
+
+		apps := sets.NewString()
+		for _, policy := range scope.AppPolicies {
+			if apps.Has(policy.AppID) {
+				return errors.Errorf("%q appId is repeated in bindings configuration for %q", policy.AppID, scope.BindingName)
+			}
+			if err := validateDefaultAccess(policy.DefaultAccess); err != nil {
+				return err
+			}
+			apps.Insert(policy.AppID)
+
+			sort.Strings(policy.AllowedOperations)
+			sort.Strings(policy.DeniedOperations)
+		}
+	}
+
+	return nil
+}
+
+// Validate the service invocation, API tokens, JWT authentication, tracing, metric and component health configurations.
+func validateConfiguration(conf *Configuration) error {
+	validators := []func(conf *Configuration) error{
+		validateServiceInvocationConfiguration,
+		validateAPITokensConfiguration,
+		validateJWTAuthenticationConfiguration,
+		validateTracingConfiguration,
+		validateMetricConfiguration,
+		validateComponentHealthConfiguration,
+	}
+	for _, validate := range validators {
+		if err := validate(conf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate the request timeouts and load balancing of the service invocation configuration.
+func validateServiceInvocationConfiguration(conf *Configuration) error {
+	spec := conf.Spec.ServiceInvocation
+	if err := validatePositiveDuration("defaultRequestTimeout", spec.DefaultRequestTimeout); err != nil {
+		return err
+	}
+
+	set := sets.NewString()
+	for _, app := range spec.Apps {
+		if set.Has(app.AppID) {
+			return errors.Errorf("%q appId is repeated in service invocation configuration", app.AppID)
+		}
+		if err := validatePositiveDuration("requestTimeout", app.RequestTimeout); err != nil {
+			return errors.Wrapf(err, "invalid service invocation configuration for %q", app.AppID)
+		}
+		set.Insert(app.AppID)
+	}
+
+	policy := spec.LoadBalancing.Policy
+	if policy != "" && policy != RoundRobinPolicy && policy != LeastRequestPolicy {
+		return errors.Errorf("load balancing policy %q must be one of %s or %s", policy, RoundRobinPolicy, LeastRequestPolicy)
+	}
+	return validatePositiveDuration("ejectionDuration", spec.LoadBalancing.EjectionDuration)
+}
+
+func validateAPITokensConfiguration(conf *Configuration) error {
+	spec := conf.Spec.APITokens
+	if spec.File != "" && spec.SecretStore != "" {
+		return errors.New("api tokens can be loaded from either a file or a secret store")
+	}
+	if spec.SecretStore != "" && spec.SecretName == "" {
+		return errors.New("api tokens secret store requires a secret name")
+	}
+	return validatePositiveDuration("refreshInterval", spec.RefreshInterval)
+}
+
+func validateJWTAuthenticationConfiguration(conf *Configuration) error {
+	spec := conf.Spec.JWTAuthentication
+	if len(spec.Issuers) == 0 {
+		if spec.JWKSFile != "" || spec.JWKSURL != "" {
+			return errors.New("jwt authentication requires at least one issuer")
+		}
+		return nil
+	}
+	if (spec.JWKSFile == "") == (spec.JWKSURL == "") {
+		return errors.New("jwt authentication requires either a jwks file or a jwks url")
+	}
+	return nil
+}
+
+func validateTracingConfiguration(conf *Configuration) error {
+	otel := conf.Spec.TracingSpec.Otel
+	if otel.EndpointAddress != "" {
+		switch otel.Protocol {
+		case "", GRPCProtocol:
+		case HTTPProtocol:
+			if err := validateCollectorURL("otel", otel.EndpointAddress); err != nil {
+				return err
+			}
+		default:
+			return errors.Errorf("invalid otel protocol %s, must be %s or %s", otel.Protocol, GRPCProtocol, HTTPProtocol)
+		}
+		if err := validateTraceExporterBatching(otel.Batching); err != nil {
+			return err
+		}
+	}
+
+	zipkin := conf.Spec.TracingSpec.Zipkin
+	if zipkin.EndpointAddress != "" {
+		if err := validateCollectorURL("zipkin", zipkin.EndpointAddress); err != nil {
+			return err
+		}
+		if err := validateTraceExporterBatching(zipkin.Batching); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMetricConfiguration(conf *Configuration) error {
+	for _, rule := range conf.Spec.MetricSpec.Rules {
+		if rule.Name == "" {
+			return errors.New("metric rule must have a name")
+		}
+		for _, tagRule := range rule.Tags {
+			if tagRule.Name == "" {
+				return errors.Errorf("tag rule of metric %s must have a name", rule.Name)
+			}
+			if tagRule.MaxValues < 0 {
+				return errors.Errorf("maxValues of tag %s of metric %s can't be negative", tagRule.Name, rule.Name)
+			}
+			for _, r := range tagRule.Replacements {
+				if _, err := regexp.Compile(r.Regex); err != nil {
+					return errors.Wrapf(err, "invalid regex of tag %s of metric %s", tagRule.Name, rule.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateComponentHealthConfiguration(conf *Configuration) error {
+	spec := conf.Spec.ComponentHealth
+	if err := validatePositiveDuration("probeInterval", spec.ProbeInterval); err != nil {
+		return err
+	}
+	return validatePositiveDuration("probeTimeout", spec.ProbeTimeout)
+}
+
+func validateCollectorURL(collector, address string) error {
+	u, err := url.Parse(address)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid %s endpoint address %s, must be an http or https url", collector, address)
+	}
+	return nil
+}
+
+func validateTraceExporterBatching(batching TraceExporterBatching) error {
+	if batching.MaxBatchSize < 0 || batching.MaxQueueSize < 0 {
+		return errors.New("the batch and queue sizes of a trace exporter can't be negative")
+	}
+	return validatePositiveDuration("flushInterval", batching.FlushInterval)
+}
+
+func validatePositiveDuration(field, value string) error {
+	if value == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return errors.Wrapf(err, "%s %q is not a valid duration", field, value)
+	}
+	if d <= 0 {
+		return errors.Errorf("%s %q must be positive", field, value)
+	}
+	return nil
+}
+
+// RequestTimeout returns the timeout of invocations of the app without a caller supplied deadline,
+// or 0 if neither the app nor the default timeout is configured.
+func (s ServiceInvocationSpec) RequestTimeout(appID string) time.Duration {
+	timeout := s.DefaultRequestTimeout
+	for _, app := range s.Apps {
+		if app.AppID == appID && app.RequestTimeout != "" {
+			timeout = app.RequestTimeout
+			break
+		}
+	}
+
+	// the timeouts are validated when the configuration is loaded
+	d, _ := time.ParseDuration(timeout)
+	return d
+}
+
+// GetRefreshInterval returns how often the api tokens are reloaded, or defaultInterval if not configured.
+func (s APITokensSpec) GetRefreshInterval(defaultInterval time.Duration) time.Duration {
+	// the interval is validated when the configuration is loaded
+	if d, err := time.ParseDuration(s.RefreshInterval); err == nil {
+		return d
+	}
+	return defaultInterval
+}
+
+// GetFlushInterval returns the maximum time a span waits to be exported, or defaultInterval if not configured.
+func (b TraceExporterBatching) GetFlushInterval(defaultInterval time.Duration) time.Duration {
+	// the interval is validated when the configuration is loaded
+	if d, err := time.ParseDuration(b.FlushInterval); err == nil {
+		return d
+	}
+	return defaultInterval
+}
+
+// GetEjectionDuration returns how long an instance that failed is not invoked, or defaultDuration if not configured.
+func (s LoadBalancingSpec) GetEjectionDuration(defaultDuration time.Duration) time.Duration {
+	// the duration is validated when the configuration is loaded
+	if d, err := time.ParseDuration(s.EjectionDuration); err == nil {
+		return d
+	}
+	return defaultDuration
+}
+
+// GetProbeInterval returns how often the components are probed, or defaultInterval if not configured.
+func (s ComponentHealthSpec) GetProbeInterval(defaultInterval time.Duration) time.Duration {
+	// the interval is validated when the configuration is loaded
+	if d, err := time.ParseDuration(s.ProbeInterval); err == nil {
+		return d
+	}
+	return defaultInterval
+}
+
+// GetProbeTimeout returns how long a component has to answer a probe, or defaultTimeout if not configured.
+func (s ComponentHealthSpec) GetProbeTimeout(defaultTimeout time.Duration) time.Duration {
+	// the timeout is validated when the configuration is loaded
+	if d, err := time.ParseDuration(s.ProbeTimeout); err == nil {
+		return d
+	}
+	return defaultTimeout
+}
+
+func validateDefaultAccess(access string) error {
+	if access != "" &&
+		!strings.EqualFold(access, AllowAccess) &&
+		!strings.EqualFold(access, DenyAccess) {
+		return errors.Errorf("defaultAccess %q can be either allow or deny", access)
+	}
+	return nil
+}
+
+// IsOperationAllowed checks if the app is allowed to invoke the operation on the binding.
+func (c BindingsScope) IsOperationAllowed(appID, operation string) bool {
+	access := c.DefaultAccess
+	for _, policy := range c.AppPolicies {
+		if policy.AppID != appID {
+			continue
+		}
+
+		// If the allowedOperations list is not empty then only the listed operations are allowed.
+		if len(policy.AllowedOperations) != 0 {
+			return containsKey(policy.AllowedOperations, operation)
+		}
+		if containsKey(policy.DeniedOperations, operation) {
+			return false
+		}
+		if policy.DefaultAccess != "" {
+			access = policy.DefaultAccess
+		}
+		break
+	}
+
+	// By default allow access to the binding.
+	return !strings.EqualFold(access, DenyAccess)
+}
+
 // Check if the secret is allowed to be accessed.
 func (c SecretsScope) IsSecretAllowed(key string) bool {
 	// By default set allow access for the secret store.
@@ -315,6 +834,7 @@ func ParseAccessControlSpec(accessControlSpec AccessControlSpec) (*AccessControl
 	var invalidNamespace []string
 	var invalidAppName bool
 	accessControlList.PolicySpec = make(map[string]AccessControlListPolicySpec)
+	accessControlList.GuardedBuildingBlocks = make(map[string]bool)
 	for _, appPolicySpec := range accessControlSpec.AppPolicies {
 		invalid := false
 		if appPolicySpec.AppName == "" {
@@ -334,40 +854,28 @@ func ParseAccessControlSpec(accessControlSpec AccessControlSpec) (*AccessControl
 			continue
 		}
 
-		operationPolicy := make(map[string]AccessControlListOperationAction)
-
-		// Iterate over all the operations and create a map for fast lookup
-		for _, appPolicy := range appPolicySpec.AppOperationActions {
-			// The operation name might be specified as /invoke/*
-			// Store the prefix as the key and use the remainder as post fix for faster lookups
-			// Also, prepend "/" in case it is missing in the operation name
-			operation := appPolicy.Operation
-			if !strings.HasPrefix(operation, "/") {
-				operation = "/" + operation
-			}
-			operationPrefix, operationPostfix := getOperationPrefixAndPostfix(operation)
-
-			operationActions := AccessControlListOperationAction{
-				OperationPostFix: operationPostfix,
-				VerbAction:       make(map[string]string),
-			}
-
-			// Iterate over all the http verbs and create a map and set the action for fast lookup
-			for _, verb := range appPolicy.HTTPVerb {
-				operationActions.VerbAction[verb] = appPolicy.Action
+		buildingBlockOperations := map[string][]AppOperation{
+			ActorsBuildingBlock: appPolicySpec.ActorOperations,
+			PubSubBuildingBlock: appPolicySpec.PubSubOperations,
+			StateBuildingBlock:  appPolicySpec.StateOperations,
+		}
+		buildingBlockPolicy := make(map[string]map[string]AccessControlListOperationAction)
+		for buildingBlock, operations := range buildingBlockOperations {
+			if len(operations) == 0 {
+				continue
 			}
-
-			// Store the operation action for grpc invocations where no http verb is specified
-			operationActions.OperationAction = appPolicy.Action
-
-			operationPolicy[operationPrefix] = operationActions
+			buildingBlockPolicy[buildingBlock] = parseOperationActions(operations)
+			accessControlList.GuardedBuildingBlocks[buildingBlock] = true
 		}
+
 		aclPolicySpec := AccessControlListPolicySpec{
 			AppName:             appPolicySpec.AppName,
 			DefaultAction:       appPolicySpec.DefaultAction,
 			TrustDomain:         appPolicySpec.TrustDomain,
 			Namespace:           appPolicySpec.Namespace,
-			AppOperationActions: operationPolicy,
+			AppOperationActions: parseOperationActions(appPolicySpec.AppOperationActions),
+
+			BuildingBlockOperationActions: buildingBlockPolicy,
 		}
 
 		// The policy spec can have the same appID which belongs to different namespaces
@@ -386,6 +894,52 @@ func ParseAccessControlSpec(accessControlSpec AccessControlSpec) (*AccessControl
 	return &accessControlList, nil
 }
 
+// parseOperationActions creates a map of the operations for fast lookup
+func parseOperationActions(operations []AppOperation) map[string]AccessControlListOperationAction {
+	operationPolicy := make(map[string]AccessControlListOperationAction)
+
+	// Iterate over all the operations and create a map for fast lookup
+	for _, appPolicy := range operations {
+		// The operation name might be specified as /orders/{id}/*
+		// Store the path segments for matching and use the normalized operation name as the key
+		segments := getOperationSegments(appPolicy.Operation)
+
+		operationActions := AccessControlListOperationAction{
+			OperationSegments: segments,
+			VerbAction:        make(map[string]string),
+			Claims:            appPolicy.Claims,
+		}
+
+		// Iterate over all the http verbs and create a map and set the action for fast lookup
+		for _, verb := range appPolicy.HTTPVerb {
+			operationActions.VerbAction[verb] = appPolicy.Action
+		}
+
+		// Store the operation action for grpc invocations where no http verb is specified
+		operationActions.OperationAction = appPolicy.Action
+
+		operationPolicy[getOperationKey(segments, appPolicy.Claims)] = operationActions
+	}
+	return operationPolicy
+}
+
+// getOperationKey returns the normalized operation name followed by the required claims, if any,
+// so that the same operation can have different actions for callers with different claims.
+// e.g.: /orders/*[role=admin]
+func getOperationKey(segments []string, claims map[string]string) string {
+	key := "/" + strings.Join(segments, "/")
+	if len(claims) == 0 {
+		return key
+	}
+
+	pairs := make([]string, 0, len(claims))
+	for name, value := range claims {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return key + "[" + strings.Join(pairs, ",") + "]"
+}
+
 // GetAndParseSpiffeID retrieves the SPIFFE Id from the cert and parses it
 func GetAndParseSpiffeID(ctx context.Context) (*SpiffeID, error) {
 	spiffeID, err := getSpiffeID(ctx)
@@ -473,10 +1027,18 @@ func getSpiffeID(ctx context.Context) (string, error) {
 }
 
 // IsOperationAllowedByAccessControlPolicy determines if access control policies allow the operation on the target app
-func IsOperationAllowedByAccessControlPolicy(spiffeID *SpiffeID, srcAppID string, inputOperation string, httpVerb common.HTTPExtension_Verb, appProtocol string, accessControlList *AccessControlList) (bool, string) {
+func IsOperationAllowedByAccessControlPolicy(spiffeID *SpiffeID, srcAppID string, inputOperation string, httpVerb common.HTTPExtension_Verb, appProtocol string, claims map[string]string, accessControlList *AccessControlList) (bool, string) {
+	decision := ExplainOperationAccessControlPolicy(spiffeID, srcAppID, inputOperation, httpVerb, appProtocol, claims, accessControlList)
+	return decision.Allowed, decision.ActionPolicy
+}
+
+// ExplainOperationAccessControlPolicy applies the access control policies to the operation on the target app
+// and returns the decision along with the policy and operation that matched.
+// claims are the verified claims of the JWT the caller was authenticated with, if any.
+func ExplainOperationAccessControlPolicy(spiffeID *SpiffeID, srcAppID string, inputOperation string, httpVerb common.HTTPExtension_Verb, appProtocol string, claims map[string]string, accessControlList *AccessControlList) *AccessControlDecision {
 	if accessControlList == nil {
 		// No access control list is provided. Do nothing
-		return isActionAllowed(AllowAccess), ""
+		return newAccessControlDecision(AllowAccess, "")
 	}
 
 	action := accessControlList.DefaultAction
@@ -484,31 +1046,18 @@ func IsOperationAllowedByAccessControlPolicy(spiffeID *SpiffeID, srcAppID string
 
 	if srcAppID == "" {
 		// Did not receive the src app id correctly
-		return isActionAllowed(action), actionPolicy
+		return newAccessControlDecision(action, actionPolicy)
 	}
 
 	if spiffeID == nil {
 		// Could not retrieve spiffe id or it is invalid. Apply global default action
-		return isActionAllowed(action), actionPolicy
+		return newAccessControlDecision(action, actionPolicy)
 	}
 
-	// Look up the src app id in the in-memory table. The key is appID||namespace
-	key := getKeyForAppID(srcAppID, spiffeID.Namespace)
-	appPolicy, found := accessControlList.PolicySpec[key]
-
+	appPolicy, found := accessControlList.getAppPolicy(srcAppID, spiffeID)
 	if !found {
 		// no policies found for this src app id. Apply global default action
-		return isActionAllowed(action), actionPolicy
-	}
-
-	// Match trust domain
-	if appPolicy.TrustDomain != spiffeID.TrustDomain {
-		return isActionAllowed(action), actionPolicy
-	}
-
-	// Match namespace
-	if appPolicy.Namespace != spiffeID.Namespace {
-		return isActionAllowed(action), actionPolicy
+		return newAccessControlDecision(action, actionPolicy)
 	}
 
 	if appPolicy.DefaultAction != "" {
@@ -518,35 +1067,25 @@ func IsOperationAllowedByAccessControlPolicy(spiffeID *SpiffeID, srcAppID string
 		actionPolicy = ActionPolicyApp
 	}
 
-	// the in-memory table has operations stored in the format "/operation name".
-	// Prepend a "/" in case missing so that the match works
-	if !strings.HasPrefix(inputOperation, "/") {
-		inputOperation = "/" + inputOperation
-	}
-
-	inputOperationPrefix, inputOperationPostfix := getOperationPrefixAndPostfix(inputOperation)
-
-	// The acl may specify the operation in a format /invoke/*, get and match only the prefix first
-	operationPolicy, found := appPolicy.AppOperationActions[inputOperationPrefix]
+	var matchedOperation, matchedVerb string
+	operationPolicy, operation, params, found := matchOperationAction(appPolicy.AppOperationActions, inputOperation, claims)
 	if found {
-		// The ACL might have the operation specified as /invoke/*. Here "/*" is stored as the postfix.
-		// Match postfix
-		if operationPolicy.OperationPostFix != "/*" && !strings.HasPrefix(operationPolicy.OperationPostFix, inputOperationPostfix) {
-			return isActionAllowed(action), actionPolicy
-		}
+		matchedOperation = operation
 
-		// Operation prefix and postfix match. Now check the operation specific policy
+		// Operation matched. Now check the operation specific policy
 		if appProtocol == HTTPProtocol {
 			if httpVerb != common.HTTPExtension_NONE {
 				verbAction, found := operationPolicy.VerbAction[httpVerb.String()]
 				if found {
 					// An action for a specific verb is matched
 					action = verbAction
+					matchedVerb = httpVerb.String()
 				} else {
 					verbAction, found = operationPolicy.VerbAction["*"]
 					if found {
 						// The verb matched the wildcard "*"
 						action = verbAction
+						matchedVerb = "*"
 					}
 				}
 			} else {
@@ -559,9 +1098,80 @@ func IsOperationAllowedByAccessControlPolicy(spiffeID *SpiffeID, srcAppID string
 		}
 	}
 
+	decision := newAccessControlDecision(action, actionPolicy)
+	decision.AppID = appPolicy.AppName
+	decision.Namespace = appPolicy.Namespace
+	decision.Operation = matchedOperation
+	decision.HTTPVerb = matchedVerb
+	decision.PathParameters = params
+	return decision
+}
+
+// IsBuildingBlockOperationAllowedByAccessControlPolicy determines if access control policies allow the app to perform
+// the operation on a building block other than service invocation, e.g. actorType/method for actors.
+// Building blocks without operation policies in any app policy are not guarded.
+func IsBuildingBlockOperationAllowedByAccessControlPolicy(spiffeID *SpiffeID, buildingBlock, inputOperation string, accessControlList *AccessControlList) (bool, string) {
+	if accessControlList == nil || !accessControlList.GuardedBuildingBlocks[buildingBlock] {
+		return isActionAllowed(AllowAccess), ""
+	}
+
+	action := accessControlList.DefaultAction
+	actionPolicy := ActionPolicyGlobal
+
+	if spiffeID == nil {
+		// Could not retrieve spiffe id or it is invalid. Apply global default action
+		return isActionAllowed(action), actionPolicy
+	}
+
+	appPolicy, found := accessControlList.getAppPolicy(spiffeID.AppID, spiffeID)
+	if !found {
+		return isActionAllowed(action), actionPolicy
+	}
+
+	if appPolicy.DefaultAction != "" {
+		action = appPolicy.DefaultAction
+		actionPolicy = ActionPolicyApp
+	}
+
+	if operationPolicy, _, _, found := matchOperationAction(appPolicy.BuildingBlockOperationActions[buildingBlock], inputOperation, nil); found {
+		action = operationPolicy.OperationAction
+	}
+
 	return isActionAllowed(action), actionPolicy
 }
 
+// LocalSpiffeID returns the identity of the local app in the trust domain of the access control list.
+// It is used to apply the policies to the building block operations an app performs through its own Dapr instance.
+func (a *AccessControlList) LocalSpiffeID(appID, namespace string) *SpiffeID {
+	if a == nil {
+		return nil
+	}
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return &SpiffeID{
+		TrustDomain: a.TrustDomain,
+		Namespace:   namespace,
+		AppID:       appID,
+	}
+}
+
+// getAppPolicy returns the policy of the app if its trust domain and namespace match the spiffe id
+func (a *AccessControlList) getAppPolicy(appID string, spiffeID *SpiffeID) (AccessControlListPolicySpec, bool) {
+	// Look up the app id in the in-memory table. The key is appID||namespace
+	key := getKeyForAppID(appID, spiffeID.Namespace)
+	appPolicy, found := a.PolicySpec[key]
+	if !found {
+		return appPolicy, false
+	}
+
+	// Match trust domain and namespace
+	if appPolicy.TrustDomain != spiffeID.TrustDomain || appPolicy.Namespace != spiffeID.Namespace {
+		return appPolicy, false
+	}
+	return appPolicy, true
+}
+
 func isActionAllowed(action string) bool {
 	return strings.EqualFold(action, AllowAccess)
 }
@@ -571,13 +1181,191 @@ func getKeyForAppID(appID, namespace string) string {
 	return key
 }
 
-// getOperationPrefixAndPostfix returns an app operation prefix and postfix
-// The prefix can be stored in the in-memory ACL for fast lookup
-// e.g.: /invoke/*, prefix = /invoke, postfix = /*
-func getOperationPrefixAndPostfix(operation string) (string, string) {
-	operationParts := strings.Split(operation, "/")
-	operationPrefix := "/" + operationParts[1]
-	operationPostfix := "/" + strings.Join(operationParts[2:], "/")
+func newAccessControlDecision(action, actionPolicy string) *AccessControlDecision {
+	return &AccessControlDecision{
+		Allowed:      isActionAllowed(action),
+		Action:       action,
+		ActionPolicy: actionPolicy,
+	}
+}
+
+// getOperationSegments returns the path segments of an operation
+// e.g.: /orders/{id}/*, segments = [orders {id} *]
+func getOperationSegments(operation string) []string {
+	return strings.Split(strings.Trim(operation, "/"), "/")
+}
+
+// matchOperationAction returns the most specific operation matching the input operation and the claims of the caller
+// along with its name and the values of its named path parameters.
+// An operation named exactly as the input is the most specific, then literal segments are more specific
+// than named path parameters, which are more specific than * and then **.
+func matchOperationAction(operationActions map[string]AccessControlListOperationAction, inputOperation string, claims map[string]string) (AccessControlListOperationAction, string, map[string]string, bool) {
+	inputSegments := getOperationSegments(inputOperation)
+	inputKey := getOperationKey(inputSegments, nil)
+
+	// Callers without claims can't match operations requiring claims, so an exact match is the most specific
+	if len(claims) == 0 {
+		if operationAction, ok := operationActions[inputKey]; ok {
+			return operationAction, inputKey, nil, true
+		}
+	}
+
+	var matched AccessControlListOperationAction
+	var matchedName string
+	var matchedParams map[string]string
+	var matchedRanks []int
+	var matchedClaims int
+	var matchedExact bool
+	for name, operationAction := range operationActions {
+		if !matchClaims(operationAction.Claims, claims) {
+			continue
+		}
+
+		params := map[string]string{}
+		if !matchOperation(operationAction.OperationSegments, inputSegments, params) {
+			continue
+		}
+
+		exact := getOperationKey(operationAction.OperationSegments, nil) == inputKey
+		ranks := getOperationSegmentRanks(operationAction.OperationSegments)
+		if matchedRanks == nil || (exact && !matchedExact) ||
+			(exact == matchedExact && isMoreSpecific(ranks, matchedRanks, len(operationAction.Claims), matchedClaims, name, matchedName)) {
+			matched, matchedName, matchedRanks, matchedClaims, matchedExact = operationAction, name, ranks, len(operationAction.Claims), exact
+			matchedParams = nil
+			if len(params) > 0 {
+				matchedParams = params
+			}
+		}
+	}
+	return matched, matchedName, matchedParams, matchedRanks != nil
+}
+
+// matchClaims returns whether the claims of the caller have the values of all the required claims
+func matchClaims(required, claims map[string]string) bool {
+	for name, value := range required {
+		if v, ok := claims[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// matchOperation returns whether the input operation segments match the segments of an operation.
+// Operations ending with * and without any other glob or path parameter keep their original matching rules:
+// /invoke/* matches /invoke and any operation under it, and /invoke/a/* matches the operations /invoke/a/*
+// starts with after /invoke, such as /invoke and /invoke/a.
+func matchOperation(segments, input []string, params map[string]string) bool {
+	if !isLegacyOperation(segments) {
+		return matchOperationSegments(segments, input, params)
+	}
+
+	if segments[0] != input[0] {
+		return false
+	}
+	postfix := "/" + strings.Join(segments[1:], "/")
+	return postfix == "/*" || strings.HasPrefix(postfix, "/"+strings.Join(input[1:], "/"))
+}
+
+func isLegacyOperation(segments []string) bool {
+	last := len(segments) - 1
+	if last == 0 || segments[last] != "*" {
+		return false
+	}
+	for _, segment := range segments[:last] {
+		if segment == "*" || segment == "**" || isPathParameter(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchOperationSegments(pattern, input []string, params map[string]string) bool {
+	if len(pattern) == 0 {
+		return len(input) == 0
+	}
 
-	return operationPrefix, operationPostfix
+	segment := pattern[0]
+	if segment == "**" {
+		// ** matches any number of segments, including none
+		for i := 0; i <= len(input); i++ {
+			if matchOperationSegments(pattern[1:], input[i:], params) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(input) == 0 {
+		return false
+	}
+
+	switch {
+	case segment == "*":
+	case isPathParameter(segment):
+		params[segment[1:len(segment)-1]] = input[0]
+	case segment != input[0]:
+		return false
+	}
+
+	if !matchOperationSegments(pattern[1:], input[1:], params) {
+		if isPathParameter(segment) {
+			delete(params, segment[1:len(segment)-1])
+		}
+		return false
+	}
+	return true
+}
+
+func isPathParameter(segment string) bool {
+	return len(segment) > 2 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// getOperationSegmentRanks returns how specific each segment of an operation is
+func getOperationSegmentRanks(segments []string) []int {
+	ranks := make([]int, len(segments))
+	for i, segment := range segments {
+		switch {
+		case segment == "**":
+			ranks[i] = 0
+		case segment == "*":
+			ranks[i] = 1
+		case isPathParameter(segment):
+			ranks[i] = 2
+		default:
+			ranks[i] = 3
+		}
+	}
+	return ranks
+}
+
+// isMoreSpecific compares the segment ranks of two operations from the first segment on.
+// Longer operations are more specific when one is a prefix of the other, unless they only add ** segments,
+// then operations requiring more claims,
+// and names break ties for stable results.
+func isMoreSpecific(ranks, otherRanks []int, claims, otherClaims int, name, otherName string) bool {
+	for i := 0; i < len(ranks) && i < len(otherRanks); i++ {
+		if ranks[i] != otherRanks[i] {
+			return ranks[i] > otherRanks[i]
+		}
+	}
+	if len(ranks) != len(otherRanks) {
+		// Trailing ** segments matching no input segments don't make an operation more specific
+		if len(ranks) > len(otherRanks) {
+			return !onlyAnySegments(ranks[len(otherRanks):])
+		}
+		return onlyAnySegments(otherRanks[len(ranks):])
+	}
+	if claims != otherClaims {
+		return claims > otherClaims
+	}
+	return name < otherName
+}
+
+func onlyAnySegments(ranks []int) bool {
+	for _, rank := range ranks {
+		if rank != 0 {
+			return false
+		}
+	}
+	return true
 }
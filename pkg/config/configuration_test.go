@@ -8,6 +8,7 @@ package config
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/dapr/dapr/pkg/proto/common/v1"
 	"github.com/stretchr/testify/assert"
@@ -28,11 +29,13 @@ func TestLoadStandaloneConfiguration(t *testing.T) {
 		name          string
 		path          string
 		errorExpected bool
+		configName    string
 	}{
 		{
 			name:          "Valid config file",
 			path:          "./testdata/config.yaml",
 			errorExpected: false,
+			configName:    "secretappconfig",
 		},
 		{
 			name:          "Invalid file path",
@@ -44,6 +47,11 @@ func TestLoadStandaloneConfiguration(t *testing.T) {
 			path:          "./testdata/invalid_secrets_config.yaml",
 			errorExpected: true,
 		},
+		{
+			name:          "Invalid bindings config file",
+			path:          "./testdata/invalid_bindings_config.yaml",
+			errorExpected: true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -54,6 +62,7 @@ func TestLoadStandaloneConfiguration(t *testing.T) {
 			} else {
 				assert.NoError(t, err, "Unexpected error")
 				assert.NotNil(t, config, "Config not loaded as expected")
+				assert.Equal(t, tc.configName, config.Name)
 			}
 		})
 	}
@@ -251,6 +260,402 @@ func TestIsSecretAllowed(t *testing.T) {
 	}
 }
 
+func TestSortAndValidateBindingsConfiguration(t *testing.T) {
+	testCases := []struct {
+		name          string
+		scopes        []BindingsScope
+		errorExpected bool
+	}{
+		{
+			name:          "empty configuration",
+			errorExpected: false,
+		},
+		{
+			name: "incorrect default access",
+			scopes: []BindingsScope{
+				{
+					BindingName:   "testBinding",
+					DefaultAccess: "incorrect",
+				},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "incorrect app default access",
+			scopes: []BindingsScope{
+				{
+					BindingName: "testBinding",
+					AppPolicies: []BindingAppPolicy{
+						{AppID: "app1", DefaultAccess: "incorrect"},
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "repeated binding name",
+			scopes: []BindingsScope{
+				{BindingName: "testBinding"},
+				{BindingName: "testBinding"},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "repeated app id",
+			scopes: []BindingsScope{
+				{
+					BindingName: "testBinding",
+					AppPolicies: []BindingAppPolicy{
+						{AppID: "app1"},
+						{AppID: "app1"},
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "operations are sorted",
+			scopes: []BindingsScope{
+				{
+					BindingName:   "testBinding",
+					DefaultAccess: DenyAccess,
+					AppPolicies: []BindingAppPolicy{
+						{
+							AppID:             "app1",
+							AllowedOperations: []string{"list", "get"},
+							DeniedOperations:  []string{"delete", "create"},
+						},
+					},
+				},
+			},
+			errorExpected: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := Configuration{Spec: ConfigurationSpec{Bindings: BindingsSpec{Scopes: tc.scopes}}}
+			err := sortAndValidateBindingsConfiguration(&config)
+			if tc.errorExpected {
+				assert.Error(t, err, "expected validation to fail")
+			} else {
+				assert.NoError(t, err)
+				for _, scope := range config.Spec.Bindings.Scopes {
+					for _, policy := range scope.AppPolicies {
+						assert.True(t, sort.StringsAreSorted(policy.AllowedOperations), "expected sorted slice")
+						assert.True(t, sort.StringsAreSorted(policy.DeniedOperations), "expected sorted slice")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValidateServiceInvocationConfiguration(t *testing.T) {
+	testCases := []struct {
+		name          string
+		spec          ServiceInvocationSpec
+		errorExpected bool
+	}{
+		{
+			name:          "empty configuration",
+			errorExpected: false,
+		},
+		{
+			name:          "invalid default timeout",
+			spec:          ServiceInvocationSpec{DefaultRequestTimeout: "incorrect"},
+			errorExpected: true,
+		},
+		{
+			name:          "negative default timeout",
+			spec:          ServiceInvocationSpec{DefaultRequestTimeout: "-1s"},
+			errorExpected: true,
+		},
+		{
+			name: "invalid app timeout",
+			spec: ServiceInvocationSpec{
+				Apps: []AppInvocationSpec{{AppID: "app1", RequestTimeout: "incorrect"}},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "repeated app id",
+			spec: ServiceInvocationSpec{
+				Apps: []AppInvocationSpec{
+					{AppID: "app1", RequestTimeout: "1s"},
+					{AppID: "app1", RequestTimeout: "2s"},
+				},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "valid timeouts",
+			spec: ServiceInvocationSpec{
+				DefaultRequestTimeout: "30s",
+				Apps:                  []AppInvocationSpec{{AppID: "app1", RequestTimeout: "5m"}},
+			},
+			errorExpected: false,
+		},
+		{
+			name:          "invalid load balancing policy",
+			spec:          ServiceInvocationSpec{LoadBalancing: LoadBalancingSpec{Policy: "random"}},
+			errorExpected: true,
+		},
+		{
+			name:          "invalid ejection duration",
+			spec:          ServiceInvocationSpec{LoadBalancing: LoadBalancingSpec{EjectionDuration: "0s"}},
+			errorExpected: true,
+		},
+		{
+			name: "valid load balancing",
+			spec: ServiceInvocationSpec{
+				LoadBalancing: LoadBalancingSpec{Policy: LeastRequestPolicy, EjectionDuration: "10s"},
+			},
+			errorExpected: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := Configuration{Spec: ConfigurationSpec{ServiceInvocation: tc.spec}}
+			err := validateServiceInvocationConfiguration(&config)
+			if tc.errorExpected {
+				assert.Error(t, err, "expected validation to fail")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAPITokensConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     APITokensSpec
+		errorExp bool
+	}{
+		{name: "no tokens source", spec: APITokensSpec{}},
+		{name: "file", spec: APITokensSpec{File: "tokens.yaml", RefreshInterval: "1m"}},
+		{name: "secret", spec: APITokensSpec{SecretStore: "kubernetes", SecretName: "tokens"}},
+		{name: "file and secret", spec: APITokensSpec{File: "tokens.yaml", SecretStore: "kubernetes", SecretName: "tokens"}, errorExp: true},
+		{name: "secret without name", spec: APITokensSpec{SecretStore: "kubernetes"}, errorExp: true},
+		{name: "invalid refresh interval", spec: APITokensSpec{File: "tokens.yaml", RefreshInterval: "-1s"}, errorExp: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &Configuration{Spec: ConfigurationSpec{APITokens: tc.spec}}
+			err := validateAPITokensConfiguration(conf)
+			assert.Equal(t, tc.errorExp, err != nil)
+		})
+	}
+}
+
+func TestValidateJWTAuthenticationConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     JWTAuthenticationSpec
+		errorExp bool
+	}{
+		{name: "disabled", spec: JWTAuthenticationSpec{}},
+		{name: "jwks file", spec: JWTAuthenticationSpec{Issuers: []string{"https://issuer"}, JWKSFile: "jwks.json"}},
+		{name: "jwks url", spec: JWTAuthenticationSpec{Issuers: []string{"https://issuer"}, JWKSURL: "https://issuer/jwks"}},
+		{name: "no jwks", spec: JWTAuthenticationSpec{Issuers: []string{"https://issuer"}}, errorExp: true},
+		{name: "jwks file and url", spec: JWTAuthenticationSpec{Issuers: []string{"https://issuer"}, JWKSFile: "jwks.json", JWKSURL: "https://issuer/jwks"}, errorExp: true},
+		{name: "no issuers", spec: JWTAuthenticationSpec{JWKSFile: "jwks.json"}, errorExp: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &Configuration{Spec: ConfigurationSpec{JWTAuthentication: tc.spec}}
+			err := validateJWTAuthenticationConfiguration(conf)
+			assert.Equal(t, tc.errorExp, err != nil)
+		})
+	}
+}
+
+func TestValidateTracingConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     TracingSpec
+		errorExp bool
+	}{
+		{name: "no collectors", spec: TracingSpec{SamplingRate: "1"}},
+		{name: "otel grpc", spec: TracingSpec{Otel: OtelSpec{EndpointAddress: "localhost:4317"}}},
+		{name: "otel http", spec: TracingSpec{Otel: OtelSpec{EndpointAddress: "http://localhost:4318/v1/traces", Protocol: HTTPProtocol}}},
+		{name: "otel http without url", spec: TracingSpec{Otel: OtelSpec{EndpointAddress: "localhost:4318", Protocol: HTTPProtocol}}, errorExp: true},
+		{name: "otel invalid protocol", spec: TracingSpec{Otel: OtelSpec{EndpointAddress: "localhost:4317", Protocol: "udp"}}, errorExp: true},
+		{name: "zipkin", spec: TracingSpec{Zipkin: ZipkinSpec{EndpointAddress: "http://localhost:9411/api/v2/spans"}}},
+		{name: "zipkin without url", spec: TracingSpec{Zipkin: ZipkinSpec{EndpointAddress: "localhost:9411"}}, errorExp: true},
+		{name: "invalid flush interval", spec: TracingSpec{Zipkin: ZipkinSpec{EndpointAddress: "http://localhost:9411/api/v2/spans", Batching: TraceExporterBatching{FlushInterval: "0s"}}}, errorExp: true},
+		{name: "negative batch size", spec: TracingSpec{Otel: OtelSpec{EndpointAddress: "localhost:4317", Batching: TraceExporterBatching{MaxBatchSize: -1}}}, errorExp: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &Configuration{Spec: ConfigurationSpec{TracingSpec: tc.spec}}
+			err := validateTracingConfiguration(conf)
+			assert.Equal(t, tc.errorExp, err != nil)
+		})
+	}
+}
+
+func TestValidateMetricConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     MetricSpec
+		errorExp bool
+	}{
+		{name: "no rules", spec: MetricSpec{Enabled: true}},
+		{name: "valid rules", spec: MetricSpec{Rules: []MetricRule{
+			{Name: "*", DropTags: []string{"actor_id"}},
+			{Name: "http/server/latency", Tags: []MetricTagRule{{
+				Name:         "path",
+				Replacements: []MetricTagReplacement{{Regex: "^/orders/[^/]+", Replacement: "/orders/{id}"}},
+				MaxValues:    100,
+			}}},
+		}}},
+		{name: "rule without name", spec: MetricSpec{Rules: []MetricRule{{DropTags: []string{"path"}}}}, errorExp: true},
+		{name: "tag rule without name", spec: MetricSpec{Rules: []MetricRule{{Name: "*", Tags: []MetricTagRule{{MaxValues: 10}}}}}, errorExp: true},
+		{name: "negative max values", spec: MetricSpec{Rules: []MetricRule{{Name: "*", Tags: []MetricTagRule{{Name: "path", MaxValues: -1}}}}}, errorExp: true},
+		{name: "invalid regex", spec: MetricSpec{Rules: []MetricRule{{Name: "*", Tags: []MetricTagRule{{
+			Name:         "path",
+			Replacements: []MetricTagReplacement{{Regex: "[", Replacement: ""}},
+		}}}}}, errorExp: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &Configuration{Spec: ConfigurationSpec{MetricSpec: tc.spec}}
+			err := validateMetricConfiguration(conf)
+			assert.Equal(t, tc.errorExp, err != nil)
+		})
+	}
+}
+
+func TestValidateComponentHealthConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     ComponentHealthSpec
+		errorExp bool
+	}{
+		{name: "defaults", spec: ComponentHealthSpec{}},
+		{name: "valid", spec: ComponentHealthSpec{ProbeInterval: "10s", ProbeTimeout: "2s", RequiredComponents: []string{"statestore"}}},
+		{name: "invalid interval", spec: ComponentHealthSpec{ProbeInterval: "often"}, errorExp: true},
+		{name: "zero timeout", spec: ComponentHealthSpec{ProbeTimeout: "0s"}, errorExp: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &Configuration{Spec: ConfigurationSpec{ComponentHealth: tc.spec}}
+			err := validateComponentHealthConfiguration(conf)
+			assert.Equal(t, tc.errorExp, err != nil)
+		})
+	}
+
+	spec := ComponentHealthSpec{ProbeInterval: "10s"}
+	assert.Equal(t, 10*time.Second, spec.GetProbeInterval(time.Minute))
+	assert.Equal(t, time.Second, spec.GetProbeTimeout(time.Second))
+}
+
+func TestServiceInvocationRequestTimeout(t *testing.T) {
+	spec := ServiceInvocationSpec{
+		DefaultRequestTimeout: "30s",
+		Apps: []AppInvocationSpec{
+			{AppID: "reports", RequestTimeout: "10m"},
+			{AppID: "health", RequestTimeout: "500ms"},
+		},
+	}
+
+	assert.Equal(t, 10*time.Minute, spec.RequestTimeout("reports"))
+	assert.Equal(t, 500*time.Millisecond, spec.RequestTimeout("health"))
+	assert.Equal(t, 30*time.Second, spec.RequestTimeout("other"))
+	assert.Equal(t, time.Duration(0), ServiceInvocationSpec{}.RequestTimeout("other"))
+}
+
+func TestIsBindingOperationAllowed(t *testing.T) {
+	testCases := []struct {
+		name           string
+		scope          BindingsScope
+		appID          string
+		operation      string
+		expectedResult bool
+	}{
+		{
+			name:           "empty scope default allow all",
+			appID:          "app1",
+			operation:      "delete",
+			expectedResult: true,
+		},
+		{
+			name: "default deny for unlisted app",
+			scope: BindingsScope{
+				BindingName:   "testBinding",
+				DefaultAccess: "DeNy", // check case-insensitivity
+				AppPolicies: []BindingAppPolicy{
+					{AppID: "app1", AllowedOperations: []string{"get"}},
+				},
+			},
+			appID:          "app2",
+			operation:      "get",
+			expectedResult: false,
+		},
+		{
+			name: "app allowed operation",
+			scope: BindingsScope{
+				BindingName:   "testBinding",
+				DefaultAccess: DenyAccess,
+				AppPolicies: []BindingAppPolicy{
+					{AppID: "app1", AllowedOperations: []string{"get"}},
+				},
+			},
+			appID:          "app1",
+			operation:      "get",
+			expectedResult: true,
+		},
+		{
+			name: "app operation not in allow list",
+			scope: BindingsScope{
+				BindingName: "testBinding",
+				AppPolicies: []BindingAppPolicy{
+					{AppID: "app1", AllowedOperations: []string{"get"}},
+				},
+			},
+			appID:          "app1",
+			operation:      "delete",
+			expectedResult: false,
+		},
+		{
+			name: "app denied operation",
+			scope: BindingsScope{
+				BindingName: "testBinding",
+				AppPolicies: []BindingAppPolicy{
+					{AppID: "app1", DeniedOperations: []string{"delete"}},
+				},
+			},
+			appID:          "app1",
+			operation:      "delete",
+			expectedResult: false,
+		},
+		{
+			name: "app default access overrides binding default access",
+			scope: BindingsScope{
+				BindingName:   "testBinding",
+				DefaultAccess: DenyAccess,
+				AppPolicies: []BindingAppPolicy{
+					{AppID: "app1", DefaultAccess: AllowAccess, DeniedOperations: []string{"delete"}},
+				},
+			},
+			appID:          "app1",
+			operation:      "create",
+			expectedResult: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedResult, tc.scope.IsOperationAllowed(tc.appID, tc.operation), "incorrect access")
+		})
+	}
+}
+
 func TestContainsKey(t *testing.T) {
 	s := []string{"a", "b", "c", "z"}
 	assert.False(t, containsKey(s, "h"), "unexpected result")
@@ -346,16 +751,16 @@ func TestParseAccessControlSpec(t *testing.T) {
 		assert.Equal(t, "ns1", accessControlList.PolicySpec[app1Ns1].Namespace)
 
 		op1Actions := AccessControlListOperationAction{
-			OperationPostFix: "/",
-			VerbAction:       make(map[string]string),
+			OperationSegments: []string{"op1"},
+			VerbAction:        make(map[string]string),
 		}
 		op1Actions.VerbAction["POST"] = AllowAccess
 		op1Actions.VerbAction["GET"] = AllowAccess
 		op1Actions.OperationAction = AllowAccess
 
 		op2Actions := AccessControlListOperationAction{
-			OperationPostFix: "/",
-			VerbAction:       make(map[string]string),
+			OperationSegments: []string{"op2"},
+			VerbAction:        make(map[string]string),
 		}
 		op2Actions.VerbAction["*"] = DenyAccess
 		op2Actions.OperationAction = DenyAccess
@@ -372,22 +777,22 @@ func TestParseAccessControlSpec(t *testing.T) {
 		assert.Equal(t, "ns2", accessControlList.PolicySpec[app2Ns2].Namespace)
 
 		op3Actions := AccessControlListOperationAction{
-			OperationPostFix: "/a/*",
-			VerbAction:       make(map[string]string),
+			OperationSegments: []string{"op3", "a", "*"},
+			VerbAction:        make(map[string]string),
 		}
 		op3Actions.VerbAction["PUT"] = AllowAccess
 		op3Actions.VerbAction["GET"] = AllowAccess
 		op3Actions.OperationAction = AllowAccess
 
 		op4Actions := AccessControlListOperationAction{
-			OperationPostFix: "/",
-			VerbAction:       make(map[string]string),
+			OperationSegments: []string{"op4"},
+			VerbAction:        make(map[string]string),
 		}
 		op4Actions.VerbAction["POST"] = AllowAccess
 		op4Actions.OperationAction = AllowAccess
 
-		assert.Equal(t, 2, len(accessControlList.PolicySpec[app2Ns2].AppOperationActions["/op3"].VerbAction))
-		assert.Equal(t, op3Actions, accessControlList.PolicySpec[app2Ns2].AppOperationActions["/op3"])
+		assert.Equal(t, 2, len(accessControlList.PolicySpec[app2Ns2].AppOperationActions["/op3/a/*"].VerbAction))
+		assert.Equal(t, op3Actions, accessControlList.PolicySpec[app2Ns2].AppOperationActions["/op3/a/*"])
 		assert.Equal(t, 1, len(accessControlList.PolicySpec[app2Ns2].AppOperationActions["/op4"].VerbAction))
 		assert.Equal(t, op4Actions, accessControlList.PolicySpec[app2Ns2].AppOperationActions["/op4"])
 
@@ -398,8 +803,8 @@ func TestParseAccessControlSpec(t *testing.T) {
 		assert.Equal(t, "ns1", accessControlList.PolicySpec[app3Ns1].Namespace)
 
 		op5Actions := AccessControlListOperationAction{
-			OperationPostFix: "/",
-			VerbAction:       make(map[string]string),
+			OperationSegments: []string{"op5"},
+			VerbAction:        make(map[string]string),
 		}
 		op5Actions.VerbAction["POST"] = AllowAccess
 		op5Actions.OperationAction = AllowAccess
@@ -414,8 +819,8 @@ func TestParseAccessControlSpec(t *testing.T) {
 		assert.Equal(t, "ns4", accessControlList.PolicySpec[app1Ns4].Namespace)
 
 		op6Actions := AccessControlListOperationAction{
-			OperationPostFix: "/",
-			VerbAction:       make(map[string]string),
+			OperationSegments: []string{"op6"},
+			VerbAction:        make(map[string]string),
 		}
 		op6Actions.VerbAction["*"] = AllowAccess
 		op6Actions.OperationAction = AllowAccess
@@ -595,7 +1000,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns1",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil, nil)
 		// Action = Allow the operation since no ACL is defined
 		assert.True(t, isAllowed)
 	})
@@ -608,7 +1013,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns1",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Default global action
 		assert.False(t, isAllowed)
 	})
@@ -621,7 +1026,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns1",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Ignore policy and apply global default action
 		assert.False(t, isAllowed)
 	})
@@ -634,7 +1039,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "abcd",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Ignore policy and apply global default action
 		assert.False(t, isAllowed)
 	})
@@ -642,7 +1047,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 	t.Run("test when spiffe id is nil", func(t *testing.T) {
 		srcAppID := app1
 		accessControlList, _ := initializeAccessControlList()
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(nil, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(nil, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Default global action
 		assert.False(t, isAllowed)
 	})
@@ -650,7 +1055,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 	t.Run("test when src app id is empty", func(t *testing.T) {
 		srcAppID := ""
 		accessControlList, _ := initializeAccessControlList()
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(nil, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(nil, srcAppID, "op1", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Default global action
 		assert.False(t, isAllowed)
 	})
@@ -663,7 +1068,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns1",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "opX", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "opX", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Ignore policy and apply default action for app
 		assert.True(t, isAllowed)
 	})
@@ -676,7 +1081,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_PUT, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the specific app
 		assert.False(t, isAllowed)
 	})
@@ -689,7 +1094,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns1",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op5", common.HTTPExtension_PUT, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op5", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
 		// Action = Global Default action
 		assert.False(t, isAllowed)
 	})
@@ -702,7 +1107,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns1",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op2", common.HTTPExtension_PUT, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op2", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the specific verb
 		assert.False(t, isAllowed)
 	})
@@ -715,7 +1120,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the specific verb
 		assert.True(t, isAllowed)
 	})
@@ -728,7 +1133,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "/op4", common.HTTPExtension_POST, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "/op4", common.HTTPExtension_POST, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the specific verb
 		assert.True(t, isAllowed)
 	})
@@ -741,7 +1146,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_NONE, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_NONE, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the app
 		assert.False(t, isAllowed)
 	})
@@ -754,7 +1159,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "/op3/a", common.HTTPExtension_PUT, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "/op3/a", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the specific verb
 		assert.True(t, isAllowed)
 	})
@@ -767,7 +1172,7 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "/op3/a/b", common.HTTPExtension_PUT, HTTPProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "/op3/a/b", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
 		// Action = Default action for the app
 		assert.False(t, isAllowed)
 	})
@@ -780,31 +1185,235 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 			Namespace:   "ns2",
 			AppID:       srcAppID,
 		}
-		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_NONE, GRPCProtocol, accessControlList)
+		isAllowed, _ := IsOperationAllowedByAccessControlPolicy(&spiffeID, srcAppID, "op4", common.HTTPExtension_NONE, GRPCProtocol, nil, accessControlList)
 		// Action = Default action for the app
 		assert.True(t, isAllowed)
 	})
 }
 
-func TestGetOperationPrefixAndPostfix(t *testing.T) {
-	t.Run("test when operation single post fix exists", func(t *testing.T) {
-		operation := "/invoke/*"
-		prefix, postfix := getOperationPrefixAndPostfix(operation)
-		assert.Equal(t, "/invoke", prefix)
-		assert.Equal(t, "/*", postfix)
+func TestIsBuildingBlockOperationAllowedByAccessControlPolicy(t *testing.T) {
+	accessControlList, _ := ParseAccessControlSpec(AccessControlSpec{
+		DefaultAction: DenyAccess,
+		TrustDomain:   "public",
+		AppPolicies: []AppPolicySpec{
+			{
+				AppName:       app1,
+				DefaultAction: DenyAccess,
+				TrustDomain:   "public",
+				Namespace:     "ns1",
+				ActorOperations: []AppOperation{
+					{Action: AllowAccess, Operation: "myactor/*"},
+				},
+				PubSubOperations: []AppOperation{
+					{Action: AllowAccess, Operation: "pubsub1/orders"},
+				},
+				StateOperations: []AppOperation{
+					{Action: AllowAccess, Operation: "store1/get"},
+				},
+			},
+			{
+				AppName:       app2,
+				DefaultAction: AllowAccess,
+				TrustDomain:   "public",
+				Namespace:     "ns1",
+				StateOperations: []AppOperation{
+					{Action: DenyAccess, Operation: "store1/delete"},
+				},
+			},
+		},
+	})
+	app1ID := accessControlList.LocalSpiffeID(app1, "ns1")
+	app2ID := accessControlList.LocalSpiffeID(app2, "ns1")
+
+	t.Run("test when no acl is specified", func(t *testing.T) {
+		isAllowed, _ := IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, StateBuildingBlock, "store1/save", nil)
+		assert.True(t, isAllowed)
+	})
+
+	t.Run("test operations matched by app policies", func(t *testing.T) {
+		isAllowed, actionPolicy := IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, ActorsBuildingBlock, "myactor/method1", accessControlList)
+		assert.True(t, isAllowed)
+		assert.Equal(t, ActionPolicyApp, actionPolicy)
+
+		isAllowed, _ = IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, PubSubBuildingBlock, "pubsub1/orders", accessControlList)
+		assert.True(t, isAllowed)
+
+		isAllowed, _ = IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, StateBuildingBlock, "store1/get", accessControlList)
+		assert.True(t, isAllowed)
+
+		isAllowed, _ = IsBuildingBlockOperationAllowedByAccessControlPolicy(app2ID, StateBuildingBlock, "store1/delete", accessControlList)
+		assert.False(t, isAllowed)
+	})
+
+	t.Run("test operations not matched apply the app default action", func(t *testing.T) {
+		isAllowed, _ := IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, ActorsBuildingBlock, "otheractor/method1", accessControlList)
+		assert.False(t, isAllowed)
+
+		isAllowed, _ = IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, PubSubBuildingBlock, "pubsub1/payments", accessControlList)
+		assert.False(t, isAllowed)
+
+		isAllowed, _ = IsBuildingBlockOperationAllowedByAccessControlPolicy(app1ID, StateBuildingBlock, "store1/save", accessControlList)
+		assert.False(t, isAllowed)
+
+		isAllowed, _ = IsBuildingBlockOperationAllowedByAccessControlPolicy(app2ID, StateBuildingBlock, "store1/save", accessControlList)
+		assert.True(t, isAllowed)
+	})
+
+	t.Run("test unknown app applies the global default action", func(t *testing.T) {
+		isAllowed, actionPolicy := IsBuildingBlockOperationAllowedByAccessControlPolicy(accessControlList.LocalSpiffeID(app3, "ns1"), StateBuildingBlock, "store1/get", accessControlList)
+		assert.False(t, isAllowed)
+		assert.Equal(t, ActionPolicyGlobal, actionPolicy)
+	})
+
+	t.Run("test building blocks without operation policies are not guarded", func(t *testing.T) {
+		accessControlList, _ := initializeAccessControlList()
+		isAllowed, _ := IsBuildingBlockOperationAllowedByAccessControlPolicy(accessControlList.LocalSpiffeID(app3, "ns1"), StateBuildingBlock, "store1/get", accessControlList)
+		assert.True(t, isAllowed)
+	})
+}
+
+func TestGetOperationSegments(t *testing.T) {
+	t.Run("test when operation has a glob", func(t *testing.T) {
+		assert.Equal(t, []string{"invoke", "*"}, getOperationSegments("/invoke/*"))
+	})
+
+	t.Run("test when operation has a path parameter", func(t *testing.T) {
+		assert.Equal(t, []string{"orders", "{id}", "items"}, getOperationSegments("orders/{id}/items/"))
+	})
+
+	t.Run("test when operation has a single segment", func(t *testing.T) {
+		assert.Equal(t, []string{"invoke"}, getOperationSegments("/invoke"))
+	})
+}
+
+func TestMatchOperationAction(t *testing.T) {
+	operationActions := parseOperationActions([]AppOperation{
+		{Operation: "/orders/{id}", Action: AllowAccess},
+		{Operation: "/orders/{id}/items", Action: AllowAccess},
+		{Operation: "/orders/{id}/items/secret", Action: DenyAccess},
+		{Operation: "/orders/{id}/items/**", Action: AllowAccess},
+		{Operation: "/orders/**", Action: DenyAccess},
+		{Operation: "/**/health", Action: AllowAccess},
+		{Operation: "/orders/new", Action: DenyAccess},
+		{Operation: "/*", Action: AllowAccess},
+	})
+
+	testCases := []struct {
+		operation string
+		matched   string
+		params    map[string]string
+	}{
+		{operation: "/orders/1", matched: "/orders/{id}", params: map[string]string{"id": "1"}},
+		{operation: "orders/1/items", matched: "/orders/{id}/items", params: map[string]string{"id": "1"}},
+		{operation: "/orders/1/items/secret", matched: "/orders/{id}/items/secret", params: map[string]string{"id": "1"}},
+		{operation: "/orders/1/items/2", matched: "/orders/{id}/items/**", params: map[string]string{"id": "1"}},
+		{operation: "/orders/1/notes", matched: "/orders/**"},
+		{operation: "/orders", matched: "/orders/**"},
+		{operation: "/orders/health", matched: "/orders/{id}", params: map[string]string{"id": "health"}},
+		{operation: "/orders/new", matched: "/orders/new"},
+		{operation: "/a/b/health", matched: "/**/health"},
+		{operation: "/payments", matched: "/*"},
+		{operation: "/payments/1", matched: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.operation, func(t *testing.T) {
+			_, matched, params, found := matchOperationAction(operationActions, tc.operation, nil)
+			assert.Equal(t, tc.matched != "", found)
+			assert.Equal(t, tc.matched, matched)
+			assert.Equal(t, tc.params, params)
+		})
+	}
+}
+
+func TestMatchOperationActionLegacy(t *testing.T) {
+	operationActions := parseOperationActions([]AppOperation{
+		{Operation: "/invoke/*", Action: AllowAccess},
+		{Operation: "/op3/a/*", Action: AllowAccess},
+		{Operation: "/op4", Action: AllowAccess},
+	})
+
+	testCases := []struct {
+		operation string
+		matched   string
+	}{
+		{operation: "/invoke", matched: "/invoke/*"},
+		{operation: "/invoke/x", matched: "/invoke/*"},
+		{operation: "/invoke/x/y", matched: "/invoke/*"},
+		{operation: "/op3", matched: "/op3/a/*"},
+		{operation: "/op3/a", matched: "/op3/a/*"},
+		{operation: "/op3/a/b", matched: ""},
+		{operation: "/op4", matched: "/op4"},
+		{operation: "/op4/a", matched: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.operation, func(t *testing.T) {
+			_, matched, _, found := matchOperationAction(operationActions, tc.operation, nil)
+			assert.Equal(t, tc.matched != "", found)
+			assert.Equal(t, tc.matched, matched)
+		})
+	}
+}
+
+func TestMatchOperationActionClaims(t *testing.T) {
+	operationActions := parseOperationActions([]AppOperation{
+		{Operation: "/orders/*", Action: DenyAccess},
+		{Operation: "/orders/*", Action: AllowAccess, Claims: map[string]string{"role": "admin"}},
+		{Operation: "/orders/*", Action: AllowAccess, Claims: map[string]string{"role": "admin", "tenant": "t1"}},
+	})
+
+	testCases := []struct {
+		name    string
+		claims  map[string]string
+		matched string
+	}{
+		{name: "no claims", matched: "/orders/*"},
+		{name: "other claims", claims: map[string]string{"role": "reader"}, matched: "/orders/*"},
+		{name: "required claims", claims: map[string]string{"role": "admin"}, matched: "/orders/*[role=admin]"},
+		{name: "more required claims", claims: map[string]string{"role": "admin", "tenant": "t1"}, matched: "/orders/*[role=admin,tenant=t1]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, matched, _, found := matchOperationAction(operationActions, "/orders/1", tc.claims)
+			assert.True(t, found)
+			assert.Equal(t, tc.matched, matched)
+		})
+	}
+}
+
+func TestExplainOperationAccessControlPolicy(t *testing.T) {
+	accessControlList, _ := initializeAccessControlList()
+	spiffeID := SpiffeID{
+		TrustDomain: "domain1",
+		Namespace:   "ns2",
+		AppID:       app2,
+	}
+
+	t.Run("test when operation matches", func(t *testing.T) {
+		decision := ExplainOperationAccessControlPolicy(&spiffeID, app2, "/op3/a", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
+		assert.Equal(t, &AccessControlDecision{
+			Allowed:      true,
+			Action:       AllowAccess,
+			ActionPolicy: ActionPolicyApp,
+			AppID:        app2,
+			Namespace:    "ns2",
+			Operation:    "/op3/a/*",
+			HTTPVerb:     "PUT",
+		}, decision)
 	})
 
-	t.Run("test when operation longer post fix exists", func(t *testing.T) {
-		operation := "/invoke/a/*"
-		prefix, postfix := getOperationPrefixAndPostfix(operation)
-		assert.Equal(t, "/invoke", prefix)
-		assert.Equal(t, "/a/*", postfix)
+	t.Run("test when no operation matches", func(t *testing.T) {
+		decision := ExplainOperationAccessControlPolicy(&spiffeID, app2, "/op7", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
+		assert.False(t, decision.Allowed)
+		assert.Equal(t, app2, decision.AppID)
+		assert.Empty(t, decision.Operation)
 	})
 
-	t.Run("test when operation no post fix exists", func(t *testing.T) {
-		operation := "/invoke"
-		prefix, postfix := getOperationPrefixAndPostfix(operation)
-		assert.Equal(t, "/invoke", prefix)
-		assert.Equal(t, "/", postfix)
+	t.Run("test when no app policy matches", func(t *testing.T) {
+		decision := ExplainOperationAccessControlPolicy(&spiffeID, "appX", "/op3/a/b", common.HTTPExtension_PUT, HTTPProtocol, nil, accessControlList)
+		assert.Equal(t, &AccessControlDecision{
+			Allowed:      false,
+			Action:       DenyAccess,
+			ActionPolicy: ActionPolicyGlobal,
+		}, decision)
 	})
 }
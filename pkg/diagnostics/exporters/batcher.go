@@ -0,0 +1,136 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package exporters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	"go.opencensus.io/trace"
+)
+
+const (
+	defaultMaxBatchSize  = 512
+	defaultMaxQueueSize  = 2048
+	defaultFlushInterval = time.Second * 5
+)
+
+// spanBatcher queues the ended spans and exports them in batches, when a batch is full or the flush interval
+// has passed. Spans are dropped when the queue is full so that a slow collector doesn't block the app calls.
+type spanBatcher struct {
+	name          string
+	export        func(spans []*trace.SpanData) error
+	maxBatchSize  int
+	flushInterval time.Duration
+
+	queue     chan *trace.SpanData
+	flushCh   chan chan struct{}
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	closeOnce sync.Once
+}
+
+func newSpanBatcher(name string, batching config.TraceExporterBatching, export func(spans []*trace.SpanData) error) *spanBatcher {
+	maxBatchSize := batching.MaxBatchSize
+	if maxBatchSize == 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	maxQueueSize := batching.MaxQueueSize
+	if maxQueueSize == 0 {
+		maxQueueSize = defaultMaxQueueSize
+	}
+
+	b := &spanBatcher{
+		name:          name,
+		export:        export,
+		maxBatchSize:  maxBatchSize,
+		flushInterval: batching.GetFlushInterval(defaultFlushInterval),
+		queue:         make(chan *trace.SpanData, maxQueueSize),
+		flushCh:       make(chan chan struct{}),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// ExportSpan implements the opencensus exporter interface
+func (b *spanBatcher) ExportSpan(sd *trace.SpanData) {
+	select {
+	case b.queue <- sd:
+	default:
+		log.Debugf("%s trace exporter queue is full, dropping span %s", b.name, sd.SpanID)
+	}
+}
+
+// Flush exports the queued spans and waits for the export to complete
+func (b *spanBatcher) Flush() {
+	done := make(chan struct{})
+	select {
+	case b.flushCh <- done:
+		<-done
+	case <-b.doneCh:
+	}
+}
+
+// Close exports the queued spans and stops the batcher
+func (b *spanBatcher) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.stopCh)
+	})
+	<-b.doneCh
+	return nil
+}
+
+func (b *spanBatcher) run() {
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*trace.SpanData, 0, b.maxBatchSize)
+	send := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := b.export(batch); err != nil {
+			log.Warnf("error exporting %d spans to %s: %s", len(batch), b.name, err)
+		}
+		batch = make([]*trace.SpanData, 0, b.maxBatchSize)
+	}
+	add := func(sd *trace.SpanData) {
+		batch = append(batch, sd)
+		if len(batch) >= b.maxBatchSize {
+			send()
+		}
+	}
+	drain := func() {
+		for {
+			select {
+			case sd := <-b.queue:
+				add(sd)
+			default:
+				send()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case sd := <-b.queue:
+			add(sd)
+		case <-ticker.C:
+			send()
+		case done := <-b.flushCh:
+			drain()
+			close(done)
+		case <-b.stopCh:
+			drain()
+			close(b.doneCh)
+			return
+		}
+	}
+}
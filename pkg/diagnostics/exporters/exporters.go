@@ -0,0 +1,90 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package exporters
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// exportTimeout is the timeout of each export call to a collector
+const exportTimeout = time.Second * 10
+
+var log = logger.NewLogger("dapr.runtime.trace")
+
+// TraceExporter is an opencensus trace exporter that exports the spans to a collector in batches.
+// Close flushes the pending spans.
+type TraceExporter interface {
+	trace.Exporter
+	io.Closer
+}
+
+// NewTraceExporters returns the exporters of the collectors declared in spec, which export the spans of the app
+// with appID running at hostAddress.
+func NewTraceExporters(appID, hostAddress string, spec config.TracingSpec) ([]TraceExporter, error) {
+	var exporters []TraceExporter
+	if spec.Otel.EndpointAddress != "" {
+		exporter, err := newOTLPExporter(appID, spec.Otel)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating otel exporter")
+		}
+		exporters = append(exporters, exporter)
+	}
+	if spec.Zipkin.EndpointAddress != "" {
+		exporter, err := newZipkinExporter(appID, hostAddress, spec.Zipkin)
+		if err != nil {
+			closeExporters(exporters)
+			return nil, errors.Wrap(err, "error creating zipkin exporter")
+		}
+		exporters = append(exporters, exporter)
+	}
+	return exporters, nil
+}
+
+func closeExporters(exporters []TraceExporter) {
+	for _, e := range exporters {
+		if err := e.Close(); err != nil {
+			log.Warnf("error closing trace exporter: %s", err)
+		}
+	}
+}
+
+// newTLSConfig returns the TLS configuration of the connections to a collector
+func newTLSConfig(spec config.TraceExporterTLSSpec) (*tls.Config, error) {
+	/* #nosec */
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: spec.InsecureSkipVerify,
+	}
+
+	if spec.CAFile != "" {
+		ca, err := ioutil.ReadFile(spec.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading ca file")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.Errorf("no certificates found in %s", spec.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if spec.CertFile != "" || spec.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(spec.CertFile, spec.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
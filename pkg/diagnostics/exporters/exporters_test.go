@@ -0,0 +1,273 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/otlp"
+	collectortracepb "github.com/dapr/dapr/pkg/otlp/proto/collector/trace/v1"
+	tracepb "github.com/dapr/dapr/pkg/otlp/proto/trace/v1"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// collectorStub records the requests received by an in-process trace collector
+type collectorStub struct {
+	lock     sync.Mutex
+	requests [][]byte
+	headers  []map[string][]string
+}
+
+func (c *collectorStub) record(body []byte, headers map[string][]string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.requests = append(c.requests, body)
+	c.headers = append(c.headers, headers)
+}
+
+func (c *collectorStub) received() ([][]byte, []map[string][]string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.requests, c.headers
+}
+
+func (c *collectorStub) httpHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		c.record(body, r.Header)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// rawServerCodec lets the gRPC collector stub receive the raw requests
+type rawServerCodec struct {
+	otlp.RawCodec
+}
+
+func (rawServerCodec) String() string {
+	return "raw"
+}
+
+func (c *collectorStub) startGRPC(t *testing.T) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.CustomCodec(rawServerCodec{}))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "opentelemetry.proto.collector.trace.v1.TraceService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Export",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				var req []byte
+				if err := dec(&req); err != nil {
+					return nil, err
+				}
+				md, _ := metadata.FromIncomingContext(ctx)
+				c.record(req, md)
+				resp := []byte{}
+				return &resp, nil
+			},
+		}},
+	}, struct{}{})
+	go server.Serve(lis)
+	return lis.Addr().String(), server.Stop
+}
+
+func testSpan(name string) *trace.SpanData {
+	return &trace.SpanData{
+		SpanContext: trace.SpanContext{
+			TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		ParentSpanID: trace.SpanID{8, 7, 6, 5, 4, 3, 2, 1},
+		SpanKind:     trace.SpanKindServer,
+		Name:         name,
+		StartTime:    time.Unix(100, 0),
+		EndTime:      time.Unix(101, 0),
+		Attributes:   map[string]interface{}{"db.type": "state", "http.status_code": int64(500)},
+		Status:       trace.Status{Code: 2, Message: "unknown"},
+	}
+}
+
+// assertOTLPRequest checks the spans of an ExportTraceServiceRequest
+func assertOTLPRequest(t *testing.T, body []byte, spanNames ...string) {
+	var req collectortracepb.ExportTraceServiceRequest
+	require.NoError(t, proto.Unmarshal(body, &req))
+	require.Len(t, req.ResourceSpans, 1)
+	resourceSpans := req.ResourceSpans[0]
+
+	require.Len(t, resourceSpans.Resource.Attributes, 1)
+	attribute := resourceSpans.Resource.Attributes[0]
+	assert.Equal(t, otlp.ServiceNameAttribute, attribute.Key)
+	assert.Equal(t, "app1", attribute.Value.GetStringValue())
+
+	require.Len(t, resourceSpans.ScopeSpans, 1)
+	assert.Equal(t, otlp.ScopeName, resourceSpans.ScopeSpans[0].Scope.Name)
+	var names []string
+	for _, span := range resourceSpans.ScopeSpans[0].Spans {
+		names = append(names, span.Name)
+		assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, span.TraceId)
+		assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, span.SpanId)
+		assert.Equal(t, []byte{8, 7, 6, 5, 4, 3, 2, 1}, span.ParentSpanId)
+		assert.Equal(t, tracepb.Span_SPAN_KIND_SERVER, span.Kind)
+		assert.Equal(t, uint64(time.Unix(100, 0).UnixNano()), span.StartTimeUnixNano)
+		assert.Equal(t, uint64(time.Unix(101, 0).UnixNano()), span.EndTimeUnixNano)
+		require.Len(t, span.Attributes, 2)
+		assert.Equal(t, "db.type", span.Attributes[0].Key)
+		assert.Equal(t, "state", span.Attributes[0].Value.GetStringValue())
+		assert.Equal(t, "http.status_code", span.Attributes[1].Key)
+		assert.Equal(t, int64(500), span.Attributes[1].Value.GetIntValue())
+
+		assert.Equal(t, "unknown", span.Status.Message)
+		assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, span.Status.Code)
+	}
+	assert.Equal(t, spanNames, names)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	t.Run("grpc", func(t *testing.T) {
+		collector := &collectorStub{}
+		address, stop := collector.startGRPC(t)
+		defer stop()
+
+		exporters, err := NewTraceExporters("app1", "", config.TracingSpec{
+			Otel: config.OtelSpec{
+				EndpointAddress: address,
+				Protocol:        config.GRPCProtocol,
+				Insecure:        true,
+				Headers:         map[string]string{"x-api-key": "secret"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, exporters, 1)
+
+		exporters[0].ExportSpan(testSpan("span1"))
+		exporters[0].ExportSpan(testSpan("span2"))
+		assert.NoError(t, exporters[0].Close())
+
+		requests, headers := collector.received()
+		require.Len(t, requests, 1)
+		assertOTLPRequest(t, requests[0], "span1", "span2")
+		assert.Equal(t, []string{"secret"}, headers[0]["x-api-key"])
+	})
+
+	t.Run("http", func(t *testing.T) {
+		collector := &collectorStub{}
+		server := httptest.NewServer(collector.httpHandler())
+		defer server.Close()
+
+		exporters, err := NewTraceExporters("app1", "", config.TracingSpec{
+			Otel: config.OtelSpec{
+				EndpointAddress: server.URL + "/v1/traces",
+				Protocol:        config.HTTPProtocol,
+				Headers:         map[string]string{"x-api-key": "secret"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, exporters, 1)
+
+		exporters[0].ExportSpan(testSpan("span1"))
+		assert.NoError(t, exporters[0].Close())
+
+		requests, headers := collector.received()
+		require.Len(t, requests, 1)
+		assertOTLPRequest(t, requests[0], "span1")
+		assert.Equal(t, []string{"secret"}, headers[0]["X-Api-Key"])
+		assert.Equal(t, []string{otlp.ContentType}, headers[0]["Content-Type"])
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		collector := &collectorStub{}
+		server := httptest.NewServer(collector.httpHandler())
+		defer server.Close()
+
+		exporter, err := newOTLPExporter("app1", config.OtelSpec{
+			EndpointAddress: server.URL,
+			Protocol:        config.HTTPProtocol,
+			Batching:        config.TraceExporterBatching{MaxBatchSize: 2},
+		})
+		require.NoError(t, err)
+		defer exporter.Close()
+
+		for _, name := range []string{"span1", "span2", "span3"} {
+			exporter.ExportSpan(testSpan(name))
+		}
+		exporter.Flush()
+
+		requests, _ := collector.received()
+		require.Len(t, requests, 2)
+		assertOTLPRequest(t, requests[0], "span1", "span2")
+		assertOTLPRequest(t, requests[1], "span3")
+	})
+}
+
+func TestZipkinExporter(t *testing.T) {
+	collector := &collectorStub{}
+	server := httptest.NewServer(collector.httpHandler())
+	defer server.Close()
+
+	exporters, err := NewTraceExporters("app1", "", config.TracingSpec{
+		Zipkin: config.ZipkinSpec{
+			EndpointAddress: server.URL + "/api/v2/spans",
+			Headers:         map[string]string{"x-api-key": "secret"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, exporters, 1)
+
+	exporters[0].ExportSpan(testSpan("span1"))
+	assert.NoError(t, exporters[0].Close())
+
+	requests, headers := collector.received()
+	require.Len(t, requests, 1)
+	assert.Equal(t, []string{"secret"}, headers[0]["X-Api-Key"])
+
+	var spans []struct {
+		Name          string `json:"name"`
+		TraceID       string `json:"traceId"`
+		LocalEndpoint struct {
+			ServiceName string `json:"serviceName"`
+		} `json:"localEndpoint"`
+	}
+	require.NoError(t, json.Unmarshal(requests[0], &spans))
+	require.Len(t, spans, 1)
+	assert.Equal(t, "span1", spans[0].Name)
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", spans[0].TraceID)
+	assert.Equal(t, "app1", spans[0].LocalEndpoint.ServiceName)
+}
+
+func TestNewTraceExporters(t *testing.T) {
+	t.Run("no collectors", func(t *testing.T) {
+		exporters, err := NewTraceExporters("app1", "", config.TracingSpec{SamplingRate: "1"})
+		assert.NoError(t, err)
+		assert.Empty(t, exporters)
+	})
+
+	t.Run("invalid ca file", func(t *testing.T) {
+		_, err := NewTraceExporters("app1", "", config.TracingSpec{
+			Otel: config.OtelSpec{
+				EndpointAddress: "localhost:4317",
+				TLS:             config.TraceExporterTLSSpec{CAFile: "missing.pem"},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,160 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package exporters
+
+import (
+	"strings"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/otlp"
+	collectortracepb "github.com/dapr/dapr/pkg/otlp/proto/collector/trace/v1"
+	resourcepb "github.com/dapr/dapr/pkg/otlp/proto/resource/v1"
+	tracepb "github.com/dapr/dapr/pkg/otlp/proto/trace/v1"
+	"go.opencensus.io/trace"
+	"go.opencensus.io/trace/tracestate"
+)
+
+// otlpExporter exports the spans to an OpenTelemetry collector with OTLP over gRPC or HTTP.
+type otlpExporter struct {
+	*spanBatcher
+	resource *resourcepb.Resource
+	client   *otlp.Client
+}
+
+func newOTLPExporter(appID string, spec config.OtelSpec) (*otlpExporter, error) {
+	tlsConfig, err := newTLSConfig(spec.TLS)
+	if err != nil {
+		return nil, err
+	}
+	client, err := otlp.NewClient(otlp.ClientOptions{
+		EndpointAddress: spec.EndpointAddress,
+		Protocol:        spec.Protocol,
+		Insecure:        spec.Insecure,
+		Headers:         spec.Headers,
+		TLSConfig:       tlsConfig,
+		Timeout:         exportTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e := &otlpExporter{
+		resource: otlp.NewResource(appID),
+		client:   client,
+	}
+	e.spanBatcher = newSpanBatcher("otel", spec.Batching, e.export)
+	return e, nil
+}
+
+// Close exports the queued spans and closes the connection to the collector
+func (e *otlpExporter) Close() error {
+	e.spanBatcher.Close()
+	return e.client.Close()
+}
+
+func (e *otlpExporter) export(spans []*trace.SpanData) error {
+	return e.client.Export(otlp.TraceExportMethod, newOTLPRequest(e.resource, spans))
+}
+
+// newOTLPRequest returns an ExportTraceServiceRequest with the spans of a resource.
+func newOTLPRequest(resource *resourcepb.Resource, spans []*trace.SpanData) *collectortracepb.ExportTraceServiceRequest {
+	otlpSpans := make([]*tracepb.Span, 0, len(spans))
+	for _, sd := range spans {
+		otlpSpans = append(otlpSpans, newOTLPSpan(sd))
+	}
+
+	return &collectortracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: resource,
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Scope: otlp.NewScope(),
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+func newOTLPSpan(sd *trace.SpanData) *tracepb.Span {
+	span := &tracepb.Span{
+		TraceId:           sd.TraceID[:],
+		SpanId:            sd.SpanID[:],
+		TraceState:        tracestateString(sd.Tracestate),
+		Name:              sd.Name,
+		Kind:              otlpSpanKind(sd.SpanKind),
+		StartTimeUnixNano: uint64(sd.StartTime.UnixNano()),
+		EndTimeUnixNano:   uint64(sd.EndTime.UnixNano()),
+		Attributes:        otlp.NewAttributes(sd.Attributes),
+		Status:            &tracepb.Status{Message: sd.Message},
+	}
+	if sd.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanId = sd.ParentSpanID[:]
+	}
+	if sd.Code != trace.StatusCodeOK {
+		span.Status.Code = tracepb.Status_STATUS_CODE_ERROR
+	}
+
+	for _, a := range sd.Annotations {
+		span.Events = append(span.Events, &tracepb.Span_Event{
+			TimeUnixNano: uint64(a.Time.UnixNano()),
+			Name:         a.Message,
+			Attributes:   otlp.NewAttributes(a.Attributes),
+		})
+	}
+	for _, m := range sd.MessageEvents {
+		span.Events = append(span.Events, &tracepb.Span_Event{
+			TimeUnixNano: uint64(m.Time.UnixNano()),
+			Name:         "message",
+			Attributes: otlp.NewAttributes(map[string]interface{}{
+				"message.type":              messageEventType(m.EventType),
+				"message.id":                m.MessageID,
+				"message.uncompressed_size": m.UncompressedByteSize,
+				"message.compressed_size":   m.CompressedByteSize,
+			}),
+		})
+	}
+	for _, l := range sd.Links {
+		span.Links = append(span.Links, &tracepb.Span_Link{
+			TraceId:    l.TraceID[:],
+			SpanId:     l.SpanID[:],
+			Attributes: otlp.NewAttributes(l.Attributes),
+		})
+	}
+	return span
+}
+
+func otlpSpanKind(kind int) tracepb.Span_SpanKind {
+	switch kind {
+	case trace.SpanKindServer:
+		return tracepb.Span_SPAN_KIND_SERVER
+	case trace.SpanKindClient:
+		return tracepb.Span_SPAN_KIND_CLIENT
+	default:
+		return tracepb.Span_SPAN_KIND_INTERNAL
+	}
+}
+
+func messageEventType(t trace.MessageEventType) string {
+	switch t {
+	case trace.MessageEventTypeSent:
+		return "SENT"
+	case trace.MessageEventTypeRecv:
+		return "RECEIVED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func tracestateString(ts *tracestate.Tracestate) string {
+	if ts == nil {
+		return ""
+	}
+	entries := ts.Entries()
+	pairs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		pairs = append(pairs, e.Key+"="+e.Value)
+	}
+	return strings.Join(pairs, ",")
+}
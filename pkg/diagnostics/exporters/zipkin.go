@@ -0,0 +1,70 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package exporters
+
+import (
+	"net/http"
+
+	"contrib.go.opencensus.io/exporter/zipkin"
+	"github.com/dapr/dapr/pkg/config"
+	openzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/openzipkin/zipkin-go/reporter"
+	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
+)
+
+// zipkinExporter exports the spans to the v2 API of Zipkin. The reporter batches the spans.
+type zipkinExporter struct {
+	*zipkin.Exporter
+	reporter reporter.Reporter
+}
+
+func newZipkinExporter(appID, hostAddress string, spec config.ZipkinSpec) (*zipkinExporter, error) {
+	tlsConfig, err := newTLSConfig(spec.TLS)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Timeout:   exportTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+
+	maxBatchSize := spec.Batching.MaxBatchSize
+	if maxBatchSize == 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	maxQueueSize := spec.Batching.MaxQueueSize
+	if maxQueueSize == 0 {
+		maxQueueSize = defaultMaxQueueSize
+	}
+	opts := []zipkinhttp.ReporterOption{
+		zipkinhttp.Client(client),
+		zipkinhttp.BatchSize(maxBatchSize),
+		zipkinhttp.MaxBacklog(maxQueueSize),
+		zipkinhttp.BatchInterval(spec.Batching.GetFlushInterval(defaultFlushInterval)),
+	}
+	if len(spec.Headers) > 0 {
+		opts = append(opts, zipkinhttp.RequestCallback(func(req *http.Request) {
+			for k, v := range spec.Headers {
+				req.Header.Set(k, v)
+			}
+		}))
+	}
+
+	endpoint, err := openzipkin.NewEndpoint(appID, hostAddress)
+	if err != nil {
+		return nil, err
+	}
+	r := zipkinhttp.NewReporter(spec.EndpointAddress, opts...)
+	return &zipkinExporter{
+		Exporter: zipkin.NewExporter(r, endpoint),
+		reporter: r,
+	}, nil
+}
+
+// Close exports the pending spans and stops the reporter
+func (e *zipkinExporter) Close() error {
+	return e.reporter.Close()
+}
@@ -132,7 +132,7 @@ func (g *grpcMetrics) Init(appID string) error {
 		},
 	}
 
-	return view.Register(views...)
+	return registerViews(views...)
 }
 
 func (g *grpcMetrics) IsEnabled() bool {
@@ -141,7 +141,7 @@ func (g *grpcMetrics) IsEnabled() bool {
 
 func (g *grpcMetrics) ServerRequestReceived(ctx context.Context, method string, contentSize int64) time.Time {
 	if g.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, g.appID, KeyServerMethod, method),
 			g.serverReceivedBytes.M(contentSize))
@@ -153,11 +153,11 @@ func (g *grpcMetrics) ServerRequestReceived(ctx context.Context, method string,
 func (g *grpcMetrics) ServerRequestSent(ctx context.Context, method, status string, contentSize int64, start time.Time) {
 	if g.enabled {
 		elapsed := float64(time.Since(start) / time.Millisecond)
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, g.appID, KeyServerMethod, method),
 			g.serverSentBytes.M(contentSize))
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, g.appID, KeyServerMethod, method, KeyServerStatus, status),
 			g.serverLatency.M(elapsed))
@@ -166,7 +166,7 @@ func (g *grpcMetrics) ServerRequestSent(ctx context.Context, method, status stri
 
 func (g *grpcMetrics) ClientRequestSent(ctx context.Context, method string, contentSize int64) time.Time {
 	if g.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, g.appID, KeyClientMethod, method),
 			g.clientSentBytes.M(contentSize))
@@ -178,11 +178,11 @@ func (g *grpcMetrics) ClientRequestSent(ctx context.Context, method string, cont
 func (g *grpcMetrics) ClientRequestRecieved(ctx context.Context, method, status string, contentSize int64, start time.Time) {
 	if g.enabled {
 		elapsed := float64(time.Since(start) / time.Millisecond)
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, g.appID, KeyClientMethod, method, KeyClientStatus, status),
 			g.clientRoundtripLatency.M(elapsed))
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, g.appID),
 			g.clientReceivedBytes.M(contentSize))
 	}
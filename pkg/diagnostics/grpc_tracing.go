@@ -203,6 +203,10 @@ func spanAttributesMapFromGRPC(appID string, req interface{}, rpcMethod string)
 	case *runtimev1pb.GetSecretRequest:
 		dbType = secretBuildingBlockType
 		m[dbInstanceSpanAttributeKey] = s.GetStoreName()
+
+	case *runtimev1pb.GetBulkSecretRequest:
+		dbType = secretBuildingBlockType
+		m[dbInstanceSpanAttributeKey] = s.GetStoreName()
 	}
 
 	if _, ok := m[dbInstanceSpanAttributeKey]; ok {
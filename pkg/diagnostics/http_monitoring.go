@@ -90,11 +90,11 @@ func (h *httpMetrics) IsEnabled() bool {
 
 func (h *httpMetrics) ServerRequestReceived(ctx context.Context, method, path string, contentSize int64) {
 	if h.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, path, httpMethodKey, method),
 			h.serverRequestCount.M(1))
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, h.appID),
 			h.serverRequestBytes.M(contentSize))
 	}
@@ -102,11 +102,11 @@ func (h *httpMetrics) ServerRequestReceived(ctx context.Context, method, path st
 
 func (h *httpMetrics) ServerRequestCompleted(ctx context.Context, method, path, status string, contentSize int64, elapsed float64) {
 	if h.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, path, httpMethodKey, method, httpStatusCodeKey, status),
 			h.serverLatency.M(elapsed))
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, h.appID),
 			h.serverResponseBytes.M(contentSize))
 	}
@@ -114,7 +114,7 @@ func (h *httpMetrics) ServerRequestCompleted(ctx context.Context, method, path,
 
 func (h *httpMetrics) ClientRequestStarted(ctx context.Context, method, path string, contentSize int64) {
 	if h.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.convertPathToMetricLabel(path), httpMethodKey, method),
 			h.clientSentBytes.M(contentSize))
@@ -123,11 +123,11 @@ func (h *httpMetrics) ClientRequestStarted(ctx context.Context, method, path str
 
 func (h *httpMetrics) ClientRequestCompleted(ctx context.Context, method, path, status string, contentSize int64, elapsed float64) {
 	if h.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx,
 			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.convertPathToMetricLabel(path), httpMethodKey, method, httpStatusCodeKey, status),
 			h.clientRoundtripLatency.M(elapsed))
-		stats.RecordWithTags(
+		recordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, h.appID),
 			h.clientReceivedBytes.M(contentSize))
 	}
@@ -204,7 +204,7 @@ func (h *httpMetrics) Init(appID string) error {
 		},
 	}
 
-	return view.Register(views...)
+	return registerViews(views...)
 }
 
 // FastHTTPMiddleware is the middleware to track http server-side requests
@@ -226,7 +226,16 @@ func (h *httpMetrics) FastHTTPMiddleware(next fasthttp.RequestHandler) fasthttp.
 
 		status := strconv.Itoa(ctx.Response.StatusCode())
 		elapsed := float64(time.Since(start) / time.Millisecond)
-		respSize := int64(len(ctx.Response.Body()))
+		// reading the body of a streamed response would buffer the whole stream in memory
+		var respSize int64
+		if ctx.Response.IsBodyStream() {
+			respSize = int64(ctx.Response.Header.ContentLength())
+			if respSize < 0 {
+				respSize = 0
+			}
+		} else {
+			respSize = int64(len(ctx.Response.Body()))
+		}
 		h.ServerRequestCompleted(ctx, method, path, status, respSize, elapsed)
 	}
 }
@@ -1,7 +1,9 @@
 package diagnostics
 
 import (
+	"io"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -152,3 +154,32 @@ func fakeFastHTTPRequestCtx(expectedBody string) *fasthttp.RequestCtx {
 
 	return &ctx
 }
+
+func TestFastHTTPMiddlewareWithStreamedResponse(t *testing.T) {
+	testRequestCtx := fakeFastHTTPRequestCtx("fake_requestDaprBody")
+
+	body := &countingReader{Reader: strings.NewReader("fake_responseDaprBody")}
+	fakeHandler := func(ctx *fasthttp.RequestCtx) {
+		ctx.Response.SetBodyStream(body, -1)
+	}
+
+	testHTTP := newHTTPMetrics()
+	handler := testHTTP.FastHTTPMiddleware(fakeHandler)
+
+	// act
+	handler(testRequestCtx)
+
+	// assert
+	assert.True(t, testRequestCtx.Response.IsBodyStream())
+	assert.Equal(t, 0, body.reads, "the streamed response must not be read by the middleware")
+}
+
+type countingReader struct {
+	io.Reader
+	reads int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	r.reads++
+	return r.Reader.Read(p)
+}
@@ -0,0 +1,223 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package diagnostics
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	"github.com/dapr/dapr/pkg/config"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	// allMetrics is the name of the metric rules applied to all the metrics
+	allMetrics = "*"
+	// overflowTagValue replaces the tag values which are not allowed or above the maximum of distinct values
+	overflowTagValue = "_other"
+)
+
+// metricRules drops the tags of the views and rewrites the values of the tags of the measurements
+// as configured by the metric rules, to bound the cardinality of the metrics.
+type metricRules struct {
+	rules []config.MetricRule
+	// replacements are the compiled regexes of the tag rules, by rule and tag rule index
+	replacements [][][]tagReplacement
+
+	// measures holds the tag rewriters of the measures of the registered views, by measure name
+	lock     sync.RWMutex
+	measures map[string]*measureTagRules
+}
+
+type tagReplacement struct {
+	regex       *regexp.Regexp
+	replacement string
+}
+
+// measureTagRules holds the tag rewriters of a measure, and the rules they come from
+type measureTagRules struct {
+	rules     map[int]bool
+	rewriters []*tagRewriter
+}
+
+// tagRewriter rewrites the values of a tag of a measure and counts its distinct values
+type tagRewriter struct {
+	key           tag.Key
+	replacements  []tagReplacement
+	allowedValues map[string]bool
+	maxValues     int
+
+	lock   sync.Mutex
+	values map[string]bool
+}
+
+var defaultMetricRules *metricRules
+
+func newMetricRules(rules []config.MetricRule) (*metricRules, error) {
+	r := &metricRules{
+		rules:        rules,
+		replacements: make([][][]tagReplacement, len(rules)),
+		measures:     map[string]*measureTagRules{},
+	}
+	for i, rule := range rules {
+		r.replacements[i] = make([][]tagReplacement, len(rule.Tags))
+		for j, tagRule := range rule.Tags {
+			for _, replacement := range tagRule.Replacements {
+				regex, err := regexp.Compile(replacement.Regex)
+				if err != nil {
+					return nil, err
+				}
+				r.replacements[i][j] = append(r.replacements[i][j], tagReplacement{regex: regex, replacement: replacement.Replacement})
+			}
+		}
+	}
+	return r, nil
+}
+
+// registerViews registers the views with the tags kept by the metric rules
+func registerViews(views ...*view.View) error {
+	for _, v := range views {
+		v.TagKeys = defaultMetricRules.viewTagKeys(v)
+	}
+	return view.Register(views...)
+}
+
+// recordWithTags records the measurements with the tag values rewritten by the metric rules
+func recordWithTags(ctx context.Context, mutators []tag.Mutator, ms ...stats.Measurement) error {
+	if len(ms) > 0 {
+		mutators = defaultMetricRules.rewriteTags(ctx, ms[0].Measure().Name(), mutators)
+	}
+	return stats.RecordWithTags(ctx, mutators, ms...)
+}
+
+// viewTagKeys returns the tag keys of the view kept by the matching rules, and sets up the tag
+// rewriters of its measure.
+func (r *metricRules) viewTagKeys(v *view.View) []tag.Key {
+	if r == nil {
+		return v.TagKeys
+	}
+
+	keys := v.TagKeys
+	for i, rule := range r.rules {
+		if rule.Name != allMetrics && rule.Name != v.Name {
+			continue
+		}
+		keys = filterTagKeys(keys, rule.AllowTags, rule.DropTags)
+		if len(rule.Tags) > 0 {
+			r.addTagRewriters(v.Measure.Name(), i)
+		}
+	}
+	return keys
+}
+
+func (r *metricRules) addTagRewriters(measureName string, ruleIndex int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	m, ok := r.measures[measureName]
+	if !ok {
+		m = &measureTagRules{rules: map[int]bool{}}
+		r.measures[measureName] = m
+	}
+	// the rules matching several views of the measure are applied once
+	if m.rules[ruleIndex] {
+		return
+	}
+	m.rules[ruleIndex] = true
+
+	for j, tagRule := range r.rules[ruleIndex].Tags {
+		rewriter := &tagRewriter{
+			key:          tag.MustNewKey(tagRule.Name),
+			replacements: r.replacements[ruleIndex][j],
+			maxValues:    tagRule.MaxValues,
+			values:       map[string]bool{},
+		}
+		if len(tagRule.AllowedValues) > 0 {
+			rewriter.allowedValues = map[string]bool{}
+			for _, value := range tagRule.AllowedValues {
+				rewriter.allowedValues[value] = true
+			}
+		}
+		m.rewriters = append(m.rewriters, rewriter)
+	}
+}
+
+// rewriteTags appends the mutators of the tag values rewritten by the tag rules of the measure
+func (r *metricRules) rewriteTags(ctx context.Context, measureName string, mutators []tag.Mutator) []tag.Mutator {
+	if r == nil {
+		return mutators
+	}
+
+	r.lock.RLock()
+	m, ok := r.measures[measureName]
+	r.lock.RUnlock()
+	if !ok {
+		return mutators
+	}
+
+	tagCtx, err := tag.New(ctx, mutators...)
+	if err != nil {
+		return mutators
+	}
+	tags := tag.FromContext(tagCtx)
+	for _, rewriter := range m.rewriters {
+		value, ok := tags.Value(rewriter.key)
+		if !ok {
+			continue
+		}
+		if rewritten := rewriter.rewrite(value); rewritten != value {
+			mutators = append(mutators, tag.Upsert(rewriter.key, rewritten))
+		}
+	}
+	return mutators
+}
+
+func (t *tagRewriter) rewrite(value string) string {
+	for _, r := range t.replacements {
+		value = r.regex.ReplaceAllString(value, r.replacement)
+	}
+	if t.allowedValues != nil && !t.allowedValues[value] {
+		return overflowTagValue
+	}
+	if t.maxValues > 0 {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		if !t.values[value] {
+			if len(t.values) >= t.maxValues {
+				return overflowTagValue
+			}
+			t.values[value] = true
+		}
+	}
+	return value
+}
+
+// filterTagKeys returns the keys which are allowed, if any allowed key is set, and not dropped
+func filterTagKeys(keys []tag.Key, allow, drop []string) []tag.Key {
+	filtered := make([]tag.Key, 0, len(keys))
+	for _, k := range keys {
+		if len(allow) > 0 && !containsString(allow, k.Name()) {
+			continue
+		}
+		if containsString(drop, k.Name()) {
+			continue
+		}
+		filtered = append(filtered, k)
+	}
+	return filtered
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
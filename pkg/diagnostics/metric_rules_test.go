@@ -0,0 +1,114 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dapr/dapr/pkg/config"
+	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+func withMetricRules(t *testing.T, rules []config.MetricRule) func() {
+	r, err := newMetricRules(rules)
+	require.NoError(t, err)
+	defaultMetricRules = r
+	return func() {
+		defaultMetricRules = nil
+	}
+}
+
+// rowValues returns the values of a tag in the rows of a view, with the count of each row
+func rowValues(t *testing.T, viewName string, key tag.Key) map[string]int64 {
+	rows, err := view.RetrieveData(viewName)
+	require.NoError(t, err)
+	values := map[string]int64{}
+	for _, row := range rows {
+		for _, tg := range row.Tags {
+			if tg.Key == key {
+				values[tg.Value] += row.Data.(*view.CountData).Value
+			}
+		}
+	}
+	return values
+}
+
+func TestMetricRules(t *testing.T) {
+	t.Run("drop and allow tags", func(t *testing.T) {
+		defer withMetricRules(t, []config.MetricRule{
+			{Name: "*", DropTags: []string{"actor_id"}},
+			{Name: "test/rules/allow", AllowTags: []string{"app_id", "actor_type"}},
+		})()
+
+		measure := stats.Int64("test/rules/allow", "", stats.UnitDimensionless)
+		allowView := diag_utils.NewMeasureView(measure, []tag.Key{appIDKey, actorTypeKey, actorIDKey, operationKey}, view.Count())
+		otherView := &view.View{Name: "test/rules/other", Measure: measure, TagKeys: []tag.Key{appIDKey, actorIDKey, operationKey}, Aggregation: view.Count()}
+		require.NoError(t, registerViews(allowView, otherView))
+		defer view.Unregister(allowView, otherView)
+
+		assert.ElementsMatch(t, []tag.Key{appIDKey, actorTypeKey}, allowView.TagKeys)
+		assert.ElementsMatch(t, []tag.Key{appIDKey, operationKey}, otherView.TagKeys)
+	})
+
+	t.Run("rewrite tag values", func(t *testing.T) {
+		defer withMetricRules(t, []config.MetricRule{
+			{Name: "test/rules/rewrite", Tags: []config.MetricTagRule{{
+				Name:         "path",
+				Replacements: []config.MetricTagReplacement{{Regex: "^/orders/[^/]+", Replacement: "/orders/{id}"}},
+				MaxValues:    2,
+			}}},
+			{Name: "test/rules/rewrite_count", Tags: []config.MetricTagRule{{
+				Name:          "method",
+				AllowedValues: []string{"GET", "POST"},
+			}}},
+		})()
+
+		measure := stats.Int64("test/rules/rewrite", "", stats.UnitDimensionless)
+		rewriteView := diag_utils.NewMeasureView(measure, []tag.Key{httpPathKey, httpMethodKey}, view.Count())
+		countView := &view.View{Name: "test/rules/rewrite_count", Measure: measure, TagKeys: []tag.Key{httpPathKey, httpMethodKey}, Aggregation: view.Count()}
+		require.NoError(t, registerViews(rewriteView, countView))
+		defer view.Unregister(rewriteView, countView)
+
+		for _, r := range []struct{ path, method string }{
+			{"/orders/1", "GET"},
+			{"/orders/2/items", "POST"},
+			{"/invoices/1", "GET"},
+			{"/customers/1", "PATCH"},
+			{"/orders/3", "GET"},
+		} {
+			recordWithTags(context.Background(), diag_utils.WithTags(httpPathKey, r.path, httpMethodKey, r.method), measure.M(1))
+		}
+
+		// the rewriters of the rules of all the views of the measure apply to the measurements
+		assert.Equal(t, map[string]int64{
+			"/orders/{id}":       2,
+			"/orders/{id}/items": 1,
+			"_other":             2,
+		}, rowValues(t, "test/rules/rewrite", httpPathKey))
+		assert.Equal(t, map[string]int64{
+			"GET":    3,
+			"POST":   1,
+			"_other": 1,
+		}, rowValues(t, "test/rules/rewrite_count", httpMethodKey))
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		measure := stats.Int64("test/rules/none", "", stats.UnitDimensionless)
+		v := diag_utils.NewMeasureView(measure, []tag.Key{httpPathKey}, view.Count())
+		require.NoError(t, registerViews(v))
+		defer view.Unregister(v)
+
+		recordWithTags(context.Background(), diag_utils.WithTags(httpPathKey, "/orders/1"), measure.M(1))
+		assert.Equal(t, []tag.Key{httpPathKey}, v.TagKeys)
+		assert.Equal(t, map[string]int64{"/orders/1": 1}, rowValues(t, "test/rules/none", httpPathKey))
+	})
+}
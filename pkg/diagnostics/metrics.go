@@ -8,6 +8,7 @@ package diagnostics
 import (
 	"time"
 
+	"github.com/dapr/dapr/pkg/config"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
 )
@@ -29,8 +30,14 @@ var (
 	DefaultHTTPMonitoring = newHTTPMetrics()
 )
 
-// InitMetrics initializes metrics
-func InitMetrics(appID string) error {
+// InitMetrics initializes metrics. The metric rules of spec bound the cardinality of the tags.
+func InitMetrics(appID string, spec config.MetricSpec) error {
+	rules, err := newMetricRules(spec.Rules)
+	if err != nil {
+		return err
+	}
+	defaultMetricRules = rules
+
 	if err := DefaultMonitoring.Init(appID); err != nil {
 		return err
 	}
@@ -147,7 +147,7 @@ func newServiceMetrics() *serviceMetrics {
 func (s *serviceMetrics) Init(appID string) error {
 	s.appID = appID
 	s.enabled = true
-	return view.Register(
+	return registerViews(
 		diag_utils.NewMeasureView(s.componentLoaded, []tag.Key{appIDKey}, view.Count()),
 		diag_utils.NewMeasureView(s.componentInitCompleted, []tag.Key{appIDKey, componentKey}, view.Count()),
 		diag_utils.NewMeasureView(s.componentInitFailed, []tag.Key{appIDKey, componentKey, failReasonKey}, view.Count()),
@@ -175,14 +175,14 @@ func (s *serviceMetrics) Init(appID string) error {
 // ComponentLoaded records metric when component is loaded successfully
 func (s *serviceMetrics) ComponentLoaded() {
 	if s.enabled {
-		stats.RecordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.componentLoaded.M(1))
+		recordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.componentLoaded.M(1))
 	}
 }
 
 // ComponentInitialized records metric when component is initialized
 func (s *serviceMetrics) ComponentInitialized(component string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(appIDKey, s.appID, componentKey, component),
 			s.componentInitCompleted.M(1))
@@ -192,7 +192,7 @@ func (s *serviceMetrics) ComponentInitialized(component string) {
 // ComponentInitFailed records metric when component initialization is failed
 func (s *serviceMetrics) ComponentInitFailed(component string, reason string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(appIDKey, s.appID, componentKey, component, failReasonKey, reason),
 			s.componentInitFailed.M(1))
@@ -202,14 +202,14 @@ func (s *serviceMetrics) ComponentInitFailed(component string, reason string) {
 // MTLSInitCompleted records metric when component is initialized
 func (s *serviceMetrics) MTLSInitCompleted() {
 	if s.enabled {
-		stats.RecordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.mtlsInitCompleted.M(1))
+		recordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.mtlsInitCompleted.M(1))
 	}
 }
 
 // MTLSInitFailed records metric when component initialization is failed
 func (s *serviceMetrics) MTLSInitFailed(reason string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx, diag_utils.WithTags(appIDKey, s.appID, failReasonKey, reason),
 			s.mtlsInitFailed.M(1))
 	}
@@ -218,14 +218,14 @@ func (s *serviceMetrics) MTLSInitFailed(reason string) {
 // MTLSWorkLoadCertRotationCompleted records metric when workload certificate rotation is succeeded
 func (s *serviceMetrics) MTLSWorkLoadCertRotationCompleted() {
 	if s.enabled {
-		stats.RecordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.mtlsWorkloadCertRotated.M(1))
+		recordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.mtlsWorkloadCertRotated.M(1))
 	}
 }
 
 // MTLSWorkLoadCertRotationFailed records metric when workload certificate rotation is failed
 func (s *serviceMetrics) MTLSWorkLoadCertRotationFailed(reason string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx, diag_utils.WithTags(appIDKey, s.appID, failReasonKey, reason),
 			s.mtlsWorkloadCertRotatedFailed.M(1))
 	}
@@ -234,7 +234,7 @@ func (s *serviceMetrics) MTLSWorkLoadCertRotationFailed(reason string) {
 // ActorStatusReported records metrics when status is reported to placement service.
 func (s *serviceMetrics) ActorStatusReported(operation string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx, diag_utils.WithTags(appIDKey, s.appID, operationKey, operation),
 			s.actorStatusReportTotal.M(1))
 	}
@@ -243,7 +243,7 @@ func (s *serviceMetrics) ActorStatusReported(operation string) {
 // ActorStatusReportFailed records metrics when status report to placement service is failed.
 func (s *serviceMetrics) ActorStatusReportFailed(operation string, reason string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx, diag_utils.WithTags(appIDKey, s.appID, operationKey, operation, failReasonKey, reason),
 			s.actorStatusReportFailedTotal.M(1))
 	}
@@ -252,7 +252,7 @@ func (s *serviceMetrics) ActorStatusReportFailed(operation string, reason string
 // ActorPlacementTableOperationReceived records metric when runtime receives table operation.
 func (s *serviceMetrics) ActorPlacementTableOperationReceived(operation string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx, diag_utils.WithTags(appIDKey, s.appID, operationKey, operation),
 			s.actorTableOperationRecvTotal.M(1))
 	}
@@ -261,7 +261,7 @@ func (s *serviceMetrics) ActorPlacementTableOperationReceived(operation string)
 // ActorRebalanced records metric when actors are drained.
 func (s *serviceMetrics) ActorRebalanced(actorType string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType),
 			s.actorRebalancedTotal.M(1))
@@ -271,7 +271,7 @@ func (s *serviceMetrics) ActorRebalanced(actorType string) {
 // ActorDeactivated records metric when actor is deactivated.
 func (s *serviceMetrics) ActorDeactivated(actorType string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType),
 			s.actorDeactivationTotal.M(1))
@@ -281,7 +281,7 @@ func (s *serviceMetrics) ActorDeactivated(actorType string) {
 // ActorDeactivationFailed records metric when actor deactivation is failed.
 func (s *serviceMetrics) ActorDeactivationFailed(actorType, reason string) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType, failReasonKey, reason),
 			s.actorDeactivationFailedTotal.M(1))
@@ -291,7 +291,7 @@ func (s *serviceMetrics) ActorDeactivationFailed(actorType, reason string) {
 // ReportCurrentPendingLocks records the current pending actor locks.
 func (s *serviceMetrics) ReportCurrentPendingLocks(actorType, actorID string, pendingLocks int32) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType, actorIDKey, actorID),
 			s.actorPendingCalls.M(int64(pendingLocks)))
@@ -301,7 +301,7 @@ func (s *serviceMetrics) ReportCurrentPendingLocks(actorType, actorID string, pe
 // RequestAllowedByAppAction records the requests allowed due to a match with the action specified in the access control policy for the app
 func (s *serviceMetrics) RequestAllowedByAppAction(appID, trustDomain, namespace, operation, httpverb string, policyAction bool) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(
 				appIDKey, appID,
@@ -317,7 +317,7 @@ func (s *serviceMetrics) RequestAllowedByAppAction(appID, trustDomain, namespace
 // RequestBlockedByAppAction records the requests blocked due to a match with the action specified in the access control policy for the app
 func (s *serviceMetrics) RequestBlockedByAppAction(appID, trustDomain, namespace, operation, httpverb string, policyAction bool) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(
 				appIDKey, appID,
@@ -333,7 +333,7 @@ func (s *serviceMetrics) RequestBlockedByAppAction(appID, trustDomain, namespace
 // RequestAllowedByGlobalAction records the requests allowed due to a match with the global action in the access control policy
 func (s *serviceMetrics) RequestAllowedByGlobalAction(appID, trustDomain, namespace, operation, httpverb string, policyAction bool) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(
 				appIDKey, appID,
@@ -349,7 +349,7 @@ func (s *serviceMetrics) RequestAllowedByGlobalAction(appID, trustDomain, namesp
 // RequestBlockedByGlobalAction records the requests blocked due to a match with the global action in the access control policy
 func (s *serviceMetrics) RequestBlockedByGlobalAction(appID, trustDomain, namespace, operation, httpverb string, policyAction bool) {
 	if s.enabled {
-		stats.RecordWithTags(
+		recordWithTags(
 			s.ctx,
 			diag_utils.WithTags(
 				appIDKey, appID,
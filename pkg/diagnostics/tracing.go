@@ -48,6 +48,8 @@ const (
 	daprAPIProtocolSpanAttributeKey   = "dapr.protocol"
 	daprAPIInvokeMethod               = "dapr.invoke_method"
 	daprAPIActorTypeID                = "dapr.actor"
+	daprAPIActorReminderName          = "dapr.actor_reminder"
+	daprAPIActorTimerName             = "dapr.actor_timer"
 
 	daprAPIHTTPSpanAttrValue = "http"
 	daprAPIGRPCSpanAttrValue = "grpc"
@@ -218,3 +220,106 @@ func StartInternalCallbackSpan(spanName string, parent trace.SpanContext, spec c
 	sampler := diag_utils.TraceSampler(spec.SamplingRate)
 	return trace.StartSpanWithRemoteParent(ctx, spanName, parent, sampler, trace.WithSpanKind(trace.SpanKindServer))
 }
+
+// SpanContextFromBindingMetadata extracts the W3C trace context carried in the metadata of a binding event.
+// Binding metadata often comes from message or HTTP headers, so the keys are matched case-insensitively.
+func SpanContextFromBindingMetadata(metadata map[string]string) (trace.SpanContext, bool) {
+	var traceparent, tracestate string
+	for k, v := range metadata {
+		switch strings.ToLower(k) {
+		case traceparentHeader:
+			traceparent = v
+		case tracestateHeader:
+			tracestate = v
+		}
+	}
+
+	sc, ok := SpanContextFromW3CString(traceparent)
+	if ok {
+		sc.Tracestate = TraceStateFromW3CString(tracestate)
+	}
+	return sc, ok
+}
+
+// SpanContextToBindingMetadata adds the W3C trace context of the given span context to the metadata of an
+// output binding request, so that it reaches the downstream system. It returns the updated metadata.
+func SpanContextToBindingMetadata(sc trace.SpanContext, metadata map[string]string) map[string]string {
+	if sc.TraceID == (trace.TraceID{}) {
+		return metadata
+	}
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadata[traceparentHeader] = SpanContextToW3CString(sc)
+	if sc.Tracestate != nil {
+		metadata[tracestateHeader] = TraceStateToW3CString(sc)
+	}
+	return metadata
+}
+
+// ConstructActorSpanAttributes creates span attributes for actor method calls.
+func ConstructActorSpanAttributes(actorType, actorID, method string) map[string]string {
+	return map[string]string{
+		daprAPIActorTypeID:  fmt.Sprintf("%s.%s", actorType, actorID),
+		daprAPIInvokeMethod: method,
+	}
+}
+
+// ConstructActorReminderSpanAttributes creates span attributes for actor reminder firings.
+func ConstructActorReminderSpanAttributes(actorType, actorID, reminderName string) map[string]string {
+	return map[string]string{
+		daprAPIActorTypeID:       fmt.Sprintf("%s.%s", actorType, actorID),
+		daprAPIActorReminderName: reminderName,
+	}
+}
+
+// ConstructActorTimerSpanAttributes creates span attributes for actor timer firings.
+func ConstructActorTimerSpanAttributes(actorType, actorID, timerName string) map[string]string {
+	return map[string]string{
+		daprAPIActorTypeID:    fmt.Sprintf("%s.%s", actorType, actorID),
+		daprAPIActorTimerName: timerName,
+	}
+}
+
+// ConstructActorStateSpanAttributes creates span attributes for actor state operations.
+func ConstructActorStateSpanAttributes(actorType, actorID, operation string) map[string]string {
+	return map[string]string{
+		daprAPIActorTypeID:          fmt.Sprintf("%s.%s", actorType, actorID),
+		dbTypeSpanAttributeKey:      stateBuildingBlockType,
+		dbStatementSpanAttributeKey: operation,
+	}
+}
+
+// StartChildSpan starts a trace span of the given kind as a child of the span of ctx, or as a root span
+// if ctx has none. ctx may be a fasthttp.RequestCtx holding the span of the API call.
+func StartChildSpan(ctx context.Context, spanName string, kind int, spec config.TracingSpec) (context.Context, *trace.Span) {
+	if !diag_utils.IsTracingEnabled(spec.SamplingRate) {
+		return ctx, nil
+	}
+
+	if parent := diag_utils.SpanFromContext(ctx); parent != nil {
+		ctx = trace.NewContext(ctx, parent)
+	}
+	sampler := diag_utils.TraceSampler(spec.SamplingRate)
+	return trace.StartSpan(ctx, spanName, sampler, trace.WithSpanKind(kind))
+}
+
+// StartLinkedRootSpan starts a root trace span for a scheduled operation such as an actor reminder,
+// linked to the span which scheduled it if link is valid.
+func StartLinkedRootSpan(spanName string, link trace.SpanContext, spec config.TracingSpec) (context.Context, *trace.Span) {
+	ctx := context.Background()
+	if !diag_utils.IsTracingEnabled(spec.SamplingRate) {
+		return ctx, nil
+	}
+
+	sampler := diag_utils.TraceSampler(spec.SamplingRate)
+	ctx, span := trace.StartSpan(ctx, spanName, sampler, trace.WithSpanKind(trace.SpanKindServer))
+	if link != (trace.SpanContext{}) {
+		span.AddLink(trace.Link{
+			TraceID: link.TraceID,
+			SpanID:  link.SpanID,
+			Type:    trace.LinkTypeParent,
+		})
+	}
+	return ctx, span
+}
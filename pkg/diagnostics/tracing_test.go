@@ -6,9 +6,13 @@
 package diagnostics
 
 import (
+	"context"
 	"testing"
 
+	"github.com/dapr/dapr/pkg/config"
+	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
 	"go.opencensus.io/trace"
 	"go.opencensus.io/trace/tracestate"
 )
@@ -46,3 +50,107 @@ func TestTraceStateToW3CString(t *testing.T) {
 		assert.Equal(t, "key=value", got)
 	})
 }
+
+func TestSpanContextFromBindingMetadata(t *testing.T) {
+	t.Run("no trace context", func(t *testing.T) {
+		_, ok := SpanContextFromBindingMetadata(map[string]string{"key": "value"})
+		assert.False(t, ok)
+	})
+	t.Run("invalid traceparent", func(t *testing.T) {
+		_, ok := SpanContextFromBindingMetadata(map[string]string{"traceparent": "invalid"})
+		assert.False(t, ok)
+	})
+	t.Run("keys are case-insensitive", func(t *testing.T) {
+		sc, ok := SpanContextFromBindingMetadata(map[string]string{
+			"Traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"TRACESTATE":  "key=value",
+		})
+		assert.True(t, ok)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+		assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+		assert.Equal(t, "key=value", TraceStateToW3CString(sc))
+	})
+}
+
+func TestSpanContextToBindingMetadata(t *testing.T) {
+	t.Run("empty SpanContext", func(t *testing.T) {
+		got := SpanContextToBindingMetadata(trace.SpanContext{}, nil)
+		assert.Nil(t, got)
+	})
+	t.Run("valid SpanContext", func(t *testing.T) {
+		ts, _ := tracestate.New(nil, tracestate.Entry{Key: "key", Value: "value"})
+		sc := trace.SpanContext{
+			TraceID:      trace.TraceID{75, 249, 47, 53, 119, 179, 77, 166, 163, 206, 146, 157, 14, 14, 71, 54},
+			SpanID:       trace.SpanID{0, 240, 103, 170, 11, 169, 2, 183},
+			TraceOptions: trace.TraceOptions(1),
+			Tracestate:   ts,
+		}
+		got := SpanContextToBindingMetadata(sc, map[string]string{"key": "value"})
+		assert.Equal(t, map[string]string{
+			"key":         "value",
+			"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"tracestate":  "key=value",
+		}, got)
+
+		parsed, ok := SpanContextFromBindingMetadata(got)
+		assert.True(t, ok)
+		assert.Equal(t, sc.TraceID, parsed.TraceID)
+		assert.Equal(t, sc.SpanID, parsed.SpanID)
+	})
+}
+
+// spanRecorder records the ended spans
+type spanRecorder struct {
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(sd *trace.SpanData) {
+	r.spans = append(r.spans, sd)
+}
+
+func TestStartChildSpan(t *testing.T) {
+	spec := config.TracingSpec{SamplingRate: "1"}
+
+	t.Run("tracing disabled", func(t *testing.T) {
+		ctx, span := StartChildSpan(context.Background(), "child", trace.SpanKindClient, config.TracingSpec{SamplingRate: "0"})
+		assert.Nil(t, span)
+		assert.Equal(t, context.Background(), ctx)
+	})
+
+	t.Run("child of the span of a fasthttp request", func(t *testing.T) {
+		_, parent := trace.StartSpan(context.Background(), "parent", trace.WithSampler(trace.AlwaysSample()))
+		reqCtx := &fasthttp.RequestCtx{}
+		diag_utils.SpanToFastHTTPContext(reqCtx, parent)
+
+		ctx, span := StartChildSpan(reqCtx, "child", trace.SpanKindClient, spec)
+		assert.Equal(t, span, trace.FromContext(ctx))
+		assert.Equal(t, parent.SpanContext().TraceID, span.SpanContext().TraceID)
+		assert.NotEqual(t, parent.SpanContext().SpanID, span.SpanContext().SpanID)
+		assert.True(t, span.SpanContext().IsSampled())
+	})
+
+	t.Run("root span without parent", func(t *testing.T) {
+		_, span := StartChildSpan(context.Background(), "child", trace.SpanKindClient, spec)
+		assert.NotNil(t, span)
+	})
+}
+
+func TestStartLinkedRootSpan(t *testing.T) {
+	recorder := &spanRecorder{}
+	trace.RegisterExporter(recorder)
+	defer trace.UnregisterExporter(recorder)
+
+	link := trace.SpanContext{
+		TraceID:      trace.TraceID{75, 249, 47, 53, 119, 179, 77, 166, 163, 206, 146, 157, 14, 14, 71, 54},
+		SpanID:       trace.SpanID{0, 240, 103, 170, 11, 169, 2, 183},
+		TraceOptions: trace.TraceOptions(1),
+	}
+	_, span := StartLinkedRootSpan("reminder", link, config.TracingSpec{SamplingRate: "1"})
+	span.End()
+
+	assert.Len(t, recorder.spans, 1)
+	sd := recorder.spans[0]
+	assert.NotEqual(t, link.TraceID, sd.TraceID)
+	assert.Equal(t, trace.SpanID{}, sd.ParentSpanID)
+	assert.Equal(t, []trace.Link{{TraceID: link.TraceID, SpanID: link.SpanID, Type: trace.LinkTypeParent}}, sd.Links)
+}
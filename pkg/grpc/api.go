@@ -8,7 +8,9 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/pubsub"
@@ -16,6 +18,9 @@ import (
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/components"
+	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
+	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
 	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -25,10 +30,14 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
+	"github.com/dapr/dapr/pkg/version"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -38,6 +47,10 @@ import (
 const (
 	daprSeparator        = "||"
 	daprHTTPStatusHeader = "dapr-http-status"
+	daprAppIDHeader      = "dapr-app-id"
+
+	// bindingStreamChunkSize is the maximum size of the data chunks streamed back from output bindings
+	bindingStreamChunkSize = 32 * 1024
 )
 
 // API is the gRPC interface for the Dapr gRPC API. It implements both the internal and external proto definitions.
@@ -45,32 +58,44 @@ type API interface {
 	// DaprInternal Service methods
 	CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
+	CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error
+	ProxyCallLocal(srv interface{}, stream grpc.ServerStream) error
 
 	// Dapr Service methods
 	PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*empty.Empty, error)
 	InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error)
+	ProxyCall(srv interface{}, stream grpc.ServerStream) error
 	InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRequest) (*runtimev1pb.InvokeBindingResponse, error)
+	InvokeBindingStream(stream runtimev1pb.Dapr_InvokeBindingStreamServer) error
 	GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error)
 	GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequest) (*runtimev1pb.GetBulkStateResponse, error)
 	GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (*runtimev1pb.GetSecretResponse, error)
+	GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, error)
 	SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (*empty.Empty, error)
 	DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateRequest) (*empty.Empty, error)
 	ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteStateTransactionRequest) (*empty.Empty, error)
+	GetMetadata(ctx context.Context, in *empty.Empty) (*runtimev1pb.GetMetadataResponse, error)
 }
 
 type api struct {
-	actor                 actors.Actors
-	directMessaging       messaging.DirectMessaging
-	appChannel            channel.AppChannel
-	stateStores           map[string]state.Store
-	secretStores          map[string]secretstores.SecretStore
-	secretsConfiguration  map[string]config.SecretsScope
-	publishFn             func(req *pubsub.PublishRequest) error
-	id                    string
-	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
-	tracingSpec           config.TracingSpec
-	accessControlList     *config.AccessControlList
-	appProtocol           string
+	actor                       actors.Actors
+	directMessaging             messaging.DirectMessaging
+	appChannel                  channel.AppChannel
+	stateStores                 map[string]state.Store
+	secretStores                map[string]secretstores.SecretStore
+	secretsConfiguration        map[string]config.SecretsScope
+	bindingsConfiguration       map[string]config.BindingsScope
+	publishFn                   func(req *pubsub.PublishRequest) error
+	id                          string
+	sendToOutputBindingFn       func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	sendToOutputBindingStreamFn func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error)
+	tracingSpec                 config.TracingSpec
+	accessControlList           *config.AccessControlList
+	namespace                   string
+	appProtocol                 string
+	getComponentsFn             func() []components.RegisteredComponent
+	getSubscriptionsFn          func() []runtime_pubsub.Subscription
+	configurationName           string
 }
 
 // NewAPI returns a new gRPC API
@@ -79,26 +104,38 @@ func NewAPI(
 	stateStores map[string]state.Store,
 	secretStores map[string]secretstores.SecretStore,
 	secretsConfiguration map[string]config.SecretsScope,
+	bindingsConfiguration map[string]config.BindingsScope,
 	publishFn func(req *pubsub.PublishRequest) error,
 	directMessaging messaging.DirectMessaging,
 	actor actors.Actors,
 	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
+	sendToOutputBindingStreamFn func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error),
 	tracingSpec config.TracingSpec,
 	accessControlList *config.AccessControlList,
-	appProtocol string) API {
+	namespace string,
+	appProtocol string,
+	getComponentsFn func() []components.RegisteredComponent,
+	getSubscriptionsFn func() []runtime_pubsub.Subscription,
+	configurationName string) API {
 	return &api{
-		directMessaging:       directMessaging,
-		actor:                 actor,
-		id:                    appID,
-		appChannel:            appChannel,
-		publishFn:             publishFn,
-		stateStores:           stateStores,
-		secretStores:          secretStores,
-		secretsConfiguration:  secretsConfiguration,
-		sendToOutputBindingFn: sendToOutputBindingFn,
-		tracingSpec:           tracingSpec,
-		accessControlList:     accessControlList,
-		appProtocol:           appProtocol,
+		directMessaging:             directMessaging,
+		actor:                       actor,
+		id:                          appID,
+		appChannel:                  appChannel,
+		publishFn:                   publishFn,
+		stateStores:                 stateStores,
+		secretStores:                secretStores,
+		secretsConfiguration:        secretsConfiguration,
+		bindingsConfiguration:       bindingsConfiguration,
+		sendToOutputBindingFn:       sendToOutputBindingFn,
+		sendToOutputBindingStreamFn: sendToOutputBindingStreamFn,
+		tracingSpec:                 tracingSpec,
+		accessControlList:           accessControlList,
+		namespace:                   namespace,
+		appProtocol:                 appProtocol,
+		getComponentsFn:             getComponentsFn,
+		getSubscriptionsFn:          getSubscriptionsFn,
+		configurationName:           configurationName,
 	}
 }
 
@@ -113,22 +150,8 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 		return nil, status.Errorf(codes.InvalidArgument, "parsing InternalInvokeRequest error: %s", err.Error())
 	}
 
-	if a.accessControlList != nil {
-		// An access control policy has been specified for the app. Apply the policies.
-		operation := req.Message().Method
-		var httpVerb commonv1pb.HTTPExtension_Verb
-		// Get the http verb in case the application protocol is http
-		if a.appProtocol == config.HTTPProtocol && req.Metadata() != nil && len(req.Metadata()) > 0 {
-			httpExt := req.Message().GetHttpExtension()
-			if httpExt != nil {
-				httpVerb = httpExt.GetVerb()
-			}
-		}
-		callAllowed, errMsg := a.applyAccessControlPolicies(ctx, operation, httpVerb, a.appProtocol)
-
-		if !callAllowed {
-			return nil, status.Errorf(codes.PermissionDenied, errMsg)
-		}
+	if err := a.checkCallLocalAllowed(ctx, req); err != nil {
+		return nil, err
 	}
 
 	resp, err := a.appChannel.InvokeMethod(ctx, req)
@@ -139,12 +162,111 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 	return resp.Proto(), err
 }
 
-func (a *api) applyAccessControlPolicies(ctx context.Context, operation string, httpVerb commonv1pb.HTTPExtension_Verb, appProtocol string) (bool, string) {
+// CallLocalStream is used for internal dapr to dapr calls with streamed request and response bodies.
+// The first message of each stream carries the request or response, the following ones the body chunks.
+func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error {
+	if a.appChannel == nil {
+		return status.Error(codes.Internal, "app channel is not initialized")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.Request == nil {
+		return status.Error(codes.InvalidArgument, "missing request in the first message of the request stream")
+	}
+
+	req, err := invokev1.InternalInvokeRequest(first.Request)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "parsing InternalInvokeRequest error: %s", err.Error())
+	}
+
+	ctx := stream.Context()
+	if err := a.checkCallLocalAllowed(ctx, req); err != nil {
+		return err
+	}
+
+	body := invokev1.NewChunkReader(first.Data, func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.Data, nil
+	}, nil)
+	resp, respBody, err := channel.InvokeMethodStream(ctx, a.appChannel, req, body)
+	if err != nil {
+		return err
+	}
+	defer respBody.Close()
+
+	if err := stream.Send(&internalv1pb.InternalInvokeResponseStream{Response: resp.Proto()}); err != nil {
+		return err
+	}
+	return invokev1.SendChunks(respBody, func(chunk []byte) error {
+		return stream.Send(&internalv1pb.InternalInvokeResponseStream{Data: chunk})
+	})
+}
+
+// ProxyCallLocal is used for internal dapr to dapr calls of gRPC services unknown to Dapr.
+// It transparently proxies the call proxied by another Dapr instance to the local app.
+func (a *api) ProxyCallLocal(srv interface{}, stream grpc.ServerStream) error {
+	fullMethod, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "failed to get the method of the proxied call")
+	}
+
+	proxyChannel, ok := a.appChannel.(channel.ProxyAppChannel)
+	if !ok {
+		return status.Error(codes.Internal, "app channel is not initialized or does not support proxying gRPC calls")
+	}
+
+	ctx := stream.Context()
+	if a.accessControlList != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+		claims := auth.CallerClaimsFromMetadata(md)
+		callAllowed, errMsg := a.applyAccessControlPolicies(ctx, fullMethod, commonv1pb.HTTPExtension_NONE, config.GRPCProtocol, claims)
+		if !callAllowed {
+			return status.Errorf(codes.PermissionDenied, errMsg)
+		}
+	}
+
+	ctx, span := a.startProxySpan(ctx, a.id, fullMethod, trace.SpanKindServer)
+	err := proxyChannel.ProxyStream(ctx, fullMethod, stream)
+	endProxySpan(span, err)
+	return err
+}
+
+// checkCallLocalAllowed applies the access control policies, if any, to a service invocation from another app.
+func (a *api) checkCallLocalAllowed(ctx context.Context, req *invokev1.InvokeMethodRequest) error {
+	if a.accessControlList == nil {
+		return nil
+	}
+
+	// An access control policy has been specified for the app. Apply the policies.
+	operation := req.Message().Method
+	var httpVerb commonv1pb.HTTPExtension_Verb
+	// Get the http verb in case the application protocol is http
+	if a.appProtocol == config.HTTPProtocol && req.Metadata() != nil && len(req.Metadata()) > 0 {
+		httpExt := req.Message().GetHttpExtension()
+		if httpExt != nil {
+			httpVerb = httpExt.GetVerb()
+		}
+	}
+	callAllowed, errMsg := a.applyAccessControlPolicies(ctx, operation, httpVerb, a.appProtocol, callerClaims(req.Metadata()))
+
+	if !callAllowed {
+		return status.Errorf(codes.PermissionDenied, errMsg)
+	}
+	return nil
+}
+
+func (a *api) applyAccessControlPolicies(ctx context.Context, operation string, httpVerb commonv1pb.HTTPExtension_Verb, appProtocol string, claims map[string]string) (bool, string) {
 	// Apply access control list filter
 	spiffeID, err := config.GetAndParseSpiffeID(ctx)
 	if err != nil {
 		// Apply the default action
-		apiServerLogger.Debugf("error while reading spiffe id from client cert: %v. applying default global policy action", err.Error())
+		apiServerLogger.WithContext(ctx).Debugf("error while reading spiffe id from client cert: %v. applying default global policy action", err.Error())
 	}
 	var appID, trustDomain, namespace string
 	if spiffeID != nil {
@@ -152,18 +274,48 @@ func (a *api) applyAccessControlPolicies(ctx context.Context, operation string,
 		namespace = spiffeID.Namespace
 		trustDomain = spiffeID.TrustDomain
 	}
-	action, actionPolicy := config.IsOperationAllowedByAccessControlPolicy(spiffeID, appID, operation, httpVerb, appProtocol, a.accessControlList)
+	action, actionPolicy := config.IsOperationAllowedByAccessControlPolicy(spiffeID, appID, operation, httpVerb, appProtocol, claims, a.accessControlList)
 	emitACLMetrics(actionPolicy, appID, trustDomain, namespace, operation, httpVerb.String(), action)
 
 	var errMessage string
 	if !action {
 		errMessage = fmt.Sprintf("access control policy has denied access to appid: %s operation: %s verb: %s", appID, operation, httpVerb)
-		apiServerLogger.Debugf(errMessage)
+		apiServerLogger.WithContext(ctx).Debugf(errMessage)
 	}
 
 	return action, errMessage
 }
 
+// callerClaims returns the verified claims of the original caller forwarded in the metadata of an invocation.
+func callerClaims(md invokev1.DaprInternalMetadata) map[string]string {
+	values := make(map[string][]string, len(md))
+	for k, v := range md {
+		values[k] = v.GetValues()
+	}
+	return auth.CallerClaimsFromMetadata(values)
+}
+
+// checkBuildingBlockAllowed applies the access control policies, if any, to an operation on a building block
+// performed by the app identified by spiffeID.
+func (a *api) checkBuildingBlockAllowed(spiffeID *config.SpiffeID, buildingBlock, operation string) error {
+	action, actionPolicy := config.IsBuildingBlockOperationAllowedByAccessControlPolicy(spiffeID, buildingBlock, operation, a.accessControlList)
+
+	var appID, trustDomain, namespace string
+	if spiffeID != nil {
+		appID = spiffeID.AppID
+		namespace = spiffeID.Namespace
+		trustDomain = spiffeID.TrustDomain
+	}
+	emitACLMetrics(actionPolicy, appID, trustDomain, namespace, operation, "", action)
+
+	if !action {
+		errMessage := fmt.Sprintf("access control policy has denied access to appid: %s %s operation: %s", appID, buildingBlock, operation)
+		apiServerLogger.Debugf(errMessage)
+		return status.Errorf(codes.PermissionDenied, errMessage)
+	}
+	return nil
+}
+
 // CallActor invokes a virtual actor
 func (a *api) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	req, err := invokev1.InternalInvokeRequest(in)
@@ -171,6 +323,17 @@ func (a *api) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 		return nil, status.Errorf(codes.InvalidArgument, "parsing InternalInvokeRequest error: %s", err.Error())
 	}
 
+	if a.accessControlList != nil {
+		spiffeID, err := config.GetAndParseSpiffeID(ctx)
+		if err != nil {
+			apiServerLogger.WithContext(ctx).Debugf("error while reading spiffe id from client cert: %v. applying default global policy action", err.Error())
+		}
+		operation := req.Actor().GetActorType() + "/" + req.Message().Method
+		if err := a.checkBuildingBlockAllowed(spiffeID, config.ActorsBuildingBlock, operation); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := a.actor.Call(ctx, req)
 	if err != nil {
 		return nil, err
@@ -181,21 +344,21 @@ func (a *api) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*empty.Empty, error) {
 	if a.publishFn == nil {
 		err := errors.New("ERR_PUBSUB_NOT_FOUND")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
 	pubsubName := in.PubsubName
 	if pubsubName == "" {
 		err := errors.New("ERR_PUBSUB_NAME_EMPTY")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
 	topic := in.Topic
 	if topic == "" {
 		err := errors.New("ERR_TOPIC_EMPTY")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
@@ -211,7 +374,7 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 	b, err := jsoniter.ConfigFastest.Marshal(envelope)
 	if err != nil {
 		err = errors.Wrap(err, "ERR_PUBSUB_CLOUD_EVENTS_SER")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
@@ -222,9 +385,14 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 	}
 
 	err = a.publishFn(&req)
+	if _, ok := err.(*runtime_pubsub.NotAllowedError); ok {
+		err = status.Error(codes.PermissionDenied, err.Error())
+		apiServerLogger.WithContext(ctx).Debug(err)
+		return &empty.Empty{}, err
+	}
 	if err != nil {
 		err = errors.Wrap(err, "ERR_PUBSUB_PUBLISH_MESSAGE")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 	return &empty.Empty{}, nil
@@ -264,20 +432,74 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	return resp.Message(), respError
 }
 
+// ProxyCall transparently proxies a call of a gRPC service unknown to Dapr to the app identified by
+// the dapr-app-id metadata, so that apps can invoke each other with their own gRPC stubs.
+func (a *api) ProxyCall(srv interface{}, stream grpc.ServerStream) error {
+	fullMethod, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "failed to get the method of the proxied call")
+	}
+
+	ctx := stream.Context()
+	md, _ := metadata.FromIncomingContext(ctx)
+	targetIDs := md.Get(daprAppIDHeader)
+	if len(targetIDs) == 0 || targetIDs[0] == "" {
+		return status.Errorf(codes.Unimplemented, "unknown method %s: set the %s metadata to invoke it on an app", fullMethod, daprAppIDHeader)
+	}
+	if a.directMessaging == nil {
+		return status.Error(codes.Internal, "direct messaging is not initialized")
+	}
+
+	ctx, span := a.startProxySpan(ctx, targetIDs[0], fullMethod, trace.SpanKindClient)
+	err := a.directMessaging.ProxyStream(ctx, targetIDs[0], fullMethod, stream)
+	if err != nil {
+		apiServerLogger.WithContext(ctx).Debugf("error proxying call %s to app %s: %s", fullMethod, targetIDs[0], err)
+	}
+	endProxySpan(span, err)
+	return err
+}
+
+// startProxySpan starts the span of a proxied call, as the tracing interceptors only handle unary calls
+func (a *api) startProxySpan(ctx context.Context, appID, fullMethod string, spanKind int) (context.Context, *trace.Span) {
+	if !diag_utils.IsTracingEnabled(a.tracingSpec.SamplingRate) {
+		return ctx, nil
+	}
+
+	sc, _ := diag.SpanContextFromIncomingGRPCMetadata(ctx)
+	spanName := fmt.Sprintf("CallLocal/%s%s", appID, fullMethod)
+	return trace.StartSpanWithRemoteParent(ctx, spanName, sc, diag_utils.TraceSampler(a.tracingSpec.SamplingRate), trace.WithSpanKind(spanKind))
+}
+
+func endProxySpan(span *trace.Span, err error) {
+	if span == nil {
+		return
+	}
+	diag.UpdateSpanStatusFromGRPCError(span, err)
+	span.End()
+}
+
 func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRequest) (*runtimev1pb.InvokeBindingResponse, error) {
 	req := &bindings.InvokeRequest{
 		Metadata:  in.Metadata,
 		Operation: bindings.OperationKind(in.Operation),
 	}
+	// pass the trace context to output binding in metadata
+	if span := diag_utils.SpanFromContext(ctx); span != nil {
+		req.Metadata = diag.SpanContextToBindingMetadata(span.SpanContext(), req.Metadata)
+	}
 	if in.Data != nil {
 		req.Data = in.Data
 	}
 
 	r := &runtimev1pb.InvokeBindingResponse{}
+	if err := a.checkBindingOperationAllowed(in.Name, in.Operation); err != nil {
+		return r, err
+	}
+
 	resp, err := a.sendToOutputBindingFn(in.Name, req)
 	if err != nil {
 		err = errors.Wrap(err, "ERR_INVOKE_OUTPUT_BINDING")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return r, err
 	}
 
@@ -288,10 +510,100 @@ func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRe
 	return r, nil
 }
 
+// InvokeBindingStream invokes an output binding with the data chunks received on the stream,
+// and streams the binding response back in chunks.
+func (a *api) InvokeBindingStream(stream runtimev1pb.Dapr_InvokeBindingStreamServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			err = status.Error(codes.InvalidArgument, "ERR_INVOKE_OUTPUT_BINDING: empty request stream")
+		}
+		apiServerLogger.WithContext(stream.Context()).Debug(err)
+		return err
+	}
+	if err := a.checkBindingOperationAllowed(first.Name, first.Operation); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	// closing the reader once the binding returns unblocks any pending write from the receiving goroutine
+	defer pr.Close()
+	go func() {
+		in := first
+		for {
+			if len(in.Data) > 0 {
+				if _, err := pw.Write(in.Data); err != nil {
+					return
+				}
+			}
+			var err error
+			in, err = stream.Recv()
+			if err == io.EOF {
+				pw.Close()
+				return
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	metadata := first.Metadata
+	if span := diag_utils.SpanFromContext(stream.Context()); span != nil {
+		metadata = diag.SpanContextToBindingMetadata(span.SpanContext(), metadata)
+	}
+	resp, err := a.sendToOutputBindingStreamFn(first.Name, &bindings_loader.StreamInvokeRequest{
+		Data:      pr,
+		Metadata:  metadata,
+		Operation: bindings.OperationKind(first.Operation),
+	})
+	if err != nil {
+		err = errors.Wrap(err, "ERR_INVOKE_OUTPUT_BINDING")
+		apiServerLogger.WithContext(stream.Context()).Debug(err)
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+
+	out := &runtimev1pb.InvokeBindingStreamResponse{Metadata: resp.Metadata}
+	if resp.Data == nil {
+		return stream.Send(out)
+	}
+	defer resp.Data.Close()
+
+	buf := make([]byte, bindingStreamChunkSize)
+	sent := false
+	for {
+		n, readErr := resp.Data.Read(buf)
+		if n > 0 {
+			out.Data = buf[:n]
+			if err := stream.Send(out); err != nil {
+				return err
+			}
+			out = &runtimev1pb.InvokeBindingStreamResponse{}
+			sent = true
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			err = errors.Wrap(readErr, "ERR_INVOKE_OUTPUT_BINDING")
+			apiServerLogger.WithContext(stream.Context()).Debug(err)
+			return err
+		}
+	}
+	if !sent {
+		return stream.Send(out)
+	}
+	return nil
+}
+
 func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequest) (*runtimev1pb.GetBulkStateResponse, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(in.StoreName, config.StateGetOperation)
 	if err != nil {
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetBulkStateResponse{}, err
 	}
 
@@ -325,7 +637,7 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 	return resp, nil
 }
 
-func (a *api) getStateStore(name string) (state.Store, error) {
+func (a *api) getStateStore(name, operation string) (state.Store, error) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		return nil, errors.New("ERR_STATE_STORE_NOT_CONFIGURED")
 	}
@@ -333,13 +645,19 @@ func (a *api) getStateStore(name string) (state.Store, error) {
 	if a.stateStores[name] == nil {
 		return nil, errors.New("ERR_STATE_STORE_NOT_FOUND")
 	}
+
+	// State operations are performed by the local app, so the policies of its own app id apply
+	spiffeID := a.accessControlList.LocalSpiffeID(a.id, a.namespace)
+	if err := a.checkBuildingBlockAllowed(spiffeID, config.StateBuildingBlock, name+"/"+operation); err != nil {
+		return nil, err
+	}
 	return a.stateStores[name], nil
 }
 
 func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(in.StoreName, config.StateGetOperation)
 	if err != nil {
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetStateResponse{}, err
 	}
 
@@ -354,7 +672,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 	getResponse, err := store.Get(&req)
 	if err != nil {
 		err = errors.Wrap(err, "ERR_STATE_GET")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetStateResponse{}, err
 	}
 
@@ -367,9 +685,9 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 }
 
 func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (*empty.Empty, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(in.StoreName, config.StateSaveOperation)
 	if err != nil {
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
@@ -393,16 +711,16 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 	err = store.BulkSet(reqs)
 	if err != nil {
 		err = errors.Wrap(err, "ERR_STATE_SAVE")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 	return &empty.Empty{}, nil
 }
 
 func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateRequest) (*empty.Empty, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(in.StoreName, config.StateDeleteOperation)
 	if err != nil {
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
@@ -421,7 +739,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 	err = store.Delete(&req)
 	if err != nil {
 		err = errors.Wrapf(err, "ERR_STATE_DELETE: failed deleting state with key %s", in.Key)
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 	return &empty.Empty{}, nil
@@ -437,7 +755,7 @@ func (a *api) getModifiedStateKey(key string) string {
 func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (*runtimev1pb.GetSecretResponse, error) {
 	if a.secretStores == nil || len(a.secretStores) == 0 {
 		err := errors.New("ERR_SECRET_STORE_NOT_CONFIGURED")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetSecretResponse{}, err
 	}
 
@@ -445,13 +763,13 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 
 	if a.secretStores[secretStoreName] == nil {
 		err := errors.New("ERR_SECRET_STORE_NOT_FOUND")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetSecretResponse{}, err
 	}
 
 	if !a.isSecretAllowed(in.StoreName, in.Key) {
 		err := status.Errorf(codes.PermissionDenied, "Access denied by policy to get %q from %q", in.Key, in.StoreName)
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetSecretResponse{}, err
 	}
 
@@ -464,7 +782,7 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 
 	if err != nil {
 		err = errors.Wrap(err, "ERR_SECRET_GET")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &runtimev1pb.GetSecretResponse{}, err
 	}
 
@@ -475,10 +793,99 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 	return response, nil
 }
 
+func (a *api) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, error) {
+	if a.secretStores == nil || len(a.secretStores) == 0 {
+		err := errors.New("ERR_SECRET_STORE_NOT_CONFIGURED")
+		apiServerLogger.WithContext(ctx).Debug(err)
+		return &runtimev1pb.GetBulkSecretResponse{}, err
+	}
+
+	secretStoreName := in.StoreName
+
+	if a.secretStores[secretStoreName] == nil {
+		err := errors.New("ERR_SECRET_STORE_NOT_FOUND")
+		apiServerLogger.WithContext(ctx).Debug(err)
+		return &runtimev1pb.GetBulkSecretResponse{}, err
+	}
+
+	bulkStore, ok := a.secretStores[secretStoreName].(secretstores_loader.BulkSecretStore)
+	if !ok {
+		err := status.Errorf(codes.Unimplemented, "ERR_SECRET_STORE_NOT_SUPPORTED: secret store %q does not support bulk retrieval", secretStoreName)
+		apiServerLogger.WithContext(ctx).Debug(err)
+		return &runtimev1pb.GetBulkSecretResponse{}, err
+	}
+
+	getResponse, err := bulkStore.BulkGetSecret(secretstores_loader.BulkGetSecretRequest{
+		Metadata: in.Metadata,
+	})
+
+	if err != nil {
+		err = errors.Wrap(err, "ERR_SECRET_GET")
+		apiServerLogger.WithContext(ctx).Debug(err)
+		return &runtimev1pb.GetBulkSecretResponse{}, err
+	}
+
+	response := &runtimev1pb.GetBulkSecretResponse{
+		Data: map[string]*runtimev1pb.SecretResponse{},
+	}
+	for key, v := range getResponse.Data {
+		if !strings.HasPrefix(key, in.Prefix) || !a.isSecretAllowed(secretStoreName, key) {
+			continue
+		}
+		response.Data[key] = &runtimev1pb.SecretResponse{Secrets: v}
+	}
+	return response, nil
+}
+
+// GetMetadata returns the metadata of the sidecar. The metadata of the components isn't returned as it can hold secrets.
+func (a *api) GetMetadata(ctx context.Context, in *empty.Empty) (*runtimev1pb.GetMetadataResponse, error) {
+	response := &runtimev1pb.GetMetadataResponse{
+		Id:                a.id,
+		RuntimeVersion:    version.Version(),
+		ConfigurationName: a.configurationName,
+	}
+
+	if a.actor != nil {
+		for _, c := range a.actor.GetActiveActorsCount(ctx) {
+			response.ActiveActorsCount = append(response.ActiveActorsCount, &runtimev1pb.ActiveActorsCount{
+				Type:  c.Type,
+				Count: int32(c.Count),
+			})
+		}
+		actorRuntime := a.actor.GetActorRuntimeMetadata()
+		response.ActorRuntime = &runtimev1pb.ActorRuntime{
+			HostedActorTypes:      actorRuntime.HostedActorTypes,
+			PlacementTableVersion: actorRuntime.PlacementTableVersion,
+		}
+	}
+
+	if a.getComponentsFn != nil {
+		for _, c := range a.getComponentsFn() {
+			response.RegisteredComponents = append(response.RegisteredComponents, &runtimev1pb.RegisteredComponent{
+				Name:    c.Name,
+				Type:    c.Type,
+				Version: c.Version,
+				Status:  c.Status,
+			})
+		}
+	}
+
+	if a.getSubscriptionsFn != nil {
+		for _, s := range a.getSubscriptionsFn() {
+			response.Subscriptions = append(response.Subscriptions, &runtimev1pb.PubsubSubscription{
+				PubsubName: s.PubsubName,
+				Topic:      s.Topic,
+				Route:      s.Route,
+			})
+		}
+	}
+	return response, nil
+}
+
 func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteStateTransactionRequest) (*empty.Empty, error) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		err := errors.New("ERR_STATE_STORE_NOT_CONFIGURED")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
@@ -486,14 +893,19 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 
 	if a.stateStores[storeName] == nil {
 		err := errors.New("ERR_STATE_STORE_NOT_FOUND")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
+		return &empty.Empty{}, err
+	}
+
+	spiffeID := a.accessControlList.LocalSpiffeID(a.id, a.namespace)
+	if err := a.checkBuildingBlockAllowed(spiffeID, config.StateBuildingBlock, storeName+"/"+config.StateTransactionOperation); err != nil {
 		return &empty.Empty{}, err
 	}
 
 	transactionalStore, ok := a.stateStores[storeName].(state.TransactionalStore)
 	if !ok {
 		err := errors.New("ERR_STATE_STORE_NOT_SUPPORTED")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 
@@ -546,7 +958,7 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 
 		default:
 			err := errors.Errorf("ERR_OPERATION_NOT_SUPPORTED: operation type %s not supported", inputReq.OperationType)
-			apiServerLogger.Debug(err)
+			apiServerLogger.WithContext(ctx).Debug(err)
 			return &empty.Empty{}, err
 		}
 
@@ -560,12 +972,21 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 
 	if err != nil {
 		err = errors.Wrap(err, "ERR_STATE_TRANSACTION")
-		apiServerLogger.Debug(err)
+		apiServerLogger.WithContext(ctx).Debug(err)
 		return &empty.Empty{}, err
 	}
 	return &empty.Empty{}, nil
 }
 
+func (a *api) checkBindingOperationAllowed(name, operation string) error {
+	if config, ok := a.bindingsConfiguration[name]; ok && !config.IsOperationAllowed(a.id, operation) {
+		err := status.Errorf(codes.PermissionDenied, "Access denied by policy to invoke %q on binding %q", operation, name)
+		apiServerLogger.Debug(err)
+		return err
+	}
+	return nil
+}
+
 func (a *api) isSecretAllowed(storeName, key string) bool {
 	if config, ok := a.secretsConfiguration[storeName]; ok {
 		return config.IsSecretAllowed(key)
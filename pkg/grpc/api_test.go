@@ -6,34 +6,45 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/exporters"
 	"github.com/dapr/components-contrib/exporters/stringexporter"
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/components"
+	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/grpc/proxy"
 	"github.com/dapr/dapr/pkg/logger"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
 	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/dapr/pkg/version"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/empty"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/phayes/freeport"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.opencensus.io/trace"
@@ -55,6 +66,18 @@ func (m *mockGRPCAPI) CallLocal(ctx context.Context, in *internalv1pb.InternalIn
 	return resp.Proto(), nil
 }
 
+func (m *mockGRPCAPI) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error {
+	return nil
+}
+
+func (m *mockGRPCAPI) ProxyCallLocal(srv interface{}, stream grpc.ServerStream) error {
+	return nil
+}
+
+func (m *mockGRPCAPI) ProxyCall(srv interface{}, stream grpc.ServerStream) error {
+	return nil
+}
+
 func (m *mockGRPCAPI) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	var resp = invokev1.NewInvokeMethodResponse(0, "", nil)
 	resp.WithRawData(ExtractSpanContext(ctx), "text/plains")
@@ -73,6 +96,10 @@ func (m *mockGRPCAPI) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeB
 	return &runtimev1pb.InvokeBindingResponse{}, nil
 }
 
+func (m *mockGRPCAPI) InvokeBindingStream(stream runtimev1pb.Dapr_InvokeBindingStreamServer) error {
+	return nil
+}
+
 func (m *mockGRPCAPI) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
 	return &runtimev1pb.GetStateResponse{}, nil
 }
@@ -93,6 +120,14 @@ func (m *mockGRPCAPI) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRe
 	return &runtimev1pb.GetSecretResponse{}, nil
 }
 
+func (m *mockGRPCAPI) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, error) {
+	return &runtimev1pb.GetBulkSecretResponse{}, nil
+}
+
+func (m *mockGRPCAPI) GetMetadata(ctx context.Context, in *empty.Empty) (*runtimev1pb.GetMetadataResponse, error) {
+	return &runtimev1pb.GetMetadataResponse{}, nil
+}
+
 func (m *mockGRPCAPI) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteStateTransactionRequest) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
@@ -185,7 +220,8 @@ func startDaprAPIServer(port int, testAPIServer *api, token string) *grpc.Server
 	opts := []grpc.ServerOption{}
 	if token != "" {
 		opts = append(opts,
-			grpc.UnaryInterceptor(setAPIAuthenticationMiddlewareUnary(token, "dapr-api-token")),
+			grpc.UnaryInterceptor(setAPIAuthenticationMiddlewareUnary(auth.NewAPITokenStore(token), "dapr-api-token")),
+			grpc.StreamInterceptor(setAPIAuthenticationMiddlewareStream(auth.NewAPITokenStore(token), "dapr-api-token")),
 		)
 	}
 
@@ -311,6 +347,225 @@ func TestCallLocal(t *testing.T) {
 	})
 }
 
+func TestCallLocalStream(t *testing.T) {
+	t.Run("appchannel is not ready", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		fakeAPI := &api{
+			id:         "fakeAPI",
+			appChannel: nil,
+		}
+		server := startInternalServer(port, fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		stream, err := client.CallLocalStream(context.Background())
+		assert.NoError(t, err)
+		stream.CloseSend()
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("missing request in the first message", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		mockAppChannel := new(channelt.MockAppChannel)
+		fakeAPI := &api{
+			id:         "fakeAPI",
+			appChannel: mockAppChannel,
+		}
+		server := startInternalServer(port, fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		stream, err := client.CallLocalStream(context.Background())
+		assert.NoError(t, err)
+		stream.Send(&internalv1pb.InternalInvokeRequestStream{Data: []byte("data")})
+		stream.CloseSend()
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("streams request and response bodies", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeResp.WithRawData([]byte("response data"), "text/plain")
+
+		mockAppChannel := new(channelt.MockAppChannel)
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.MatchedBy(func(req *invokev1.InvokeMethodRequest) bool {
+			_, data := req.RawData()
+			return string(data) == "request data"
+		})).Return(fakeResp, nil)
+		fakeAPI := &api{
+			id:         "fakeAPI",
+			appChannel: mockAppChannel,
+		}
+		server := startInternalServer(port, fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		stream, err := client.CallLocalStream(context.Background())
+		assert.NoError(t, err)
+
+		req := invokev1.NewInvokeMethodRequest("method")
+		req.WithRawData(nil, "text/plain")
+		stream.Send(&internalv1pb.InternalInvokeRequestStream{Request: req.Proto(), Data: []byte("request ")})
+		stream.Send(&internalv1pb.InternalInvokeRequestStream{Data: []byte("data")})
+		stream.CloseSend()
+
+		first, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), first.Response.GetStatus().Code)
+
+		var data []byte
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			data = append(data, msg.Data...)
+		}
+		assert.Equal(t, "response data", string(data))
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	})
+}
+
+// mockProxyAppChannel is an app channel which can proxy gRPC calls
+type mockProxyAppChannel struct {
+	channelt.MockAppChannel
+}
+
+func (m *mockProxyAppChannel) ProxyStream(ctx context.Context, fullMethod string, stream grpc.ServerStream) error {
+	ret := m.Called(ctx, fullMethod, stream)
+	return ret.Error(0)
+}
+
+func startProxyServer(port int, handler grpc.StreamHandler) *grpc.Server {
+	lis, _ := net.Listen("tcp", fmt.Sprintf(":%d", port))
+
+	server := grpc.NewServer(grpc.CustomCodec(proxy.Codec()), grpc.UnknownServiceHandler(handler))
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			panic(err)
+		}
+	}()
+
+	// wait until server starts
+	time.Sleep(maxGRPCServerUptime)
+
+	return server
+}
+
+func TestProxyCall(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	mockDirectMessaging := new(daprt.MockDirectMessaging)
+	fakeAPI := &api{
+		id:              "fakeAPI",
+		directMessaging: mockDirectMessaging,
+	}
+	server := startProxyServer(port, fakeAPI.ProxyCall)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewAppCallbackClient(clientConn)
+
+	t.Run("missing app id", func(t *testing.T) {
+		_, err := client.OnInvoke(context.Background(), &commonv1pb.InvokeRequest{Method: "method"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		mockDirectMessaging.AssertNotCalled(t, "ProxyStream", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("proxies call to target app", func(t *testing.T) {
+		mockDirectMessaging.On("ProxyStream",
+			mock.Anything,
+			"targetApp",
+			"/dapr.proto.runtime.v1.AppCallback/OnInvoke",
+			mock.Anything).Return(status.Error(codes.NotFound, "not found")).Once()
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "dapr-app-id", "targetApp")
+		_, err := client.OnInvoke(ctx, &commonv1pb.InvokeRequest{Method: "method"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		mockDirectMessaging.AssertNumberOfCalls(t, "ProxyStream", 1)
+	})
+}
+
+func TestProxyCallLocal(t *testing.T) {
+	t.Run("appchannel does not support proxying", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		fakeAPI := &api{
+			id:         "fakeAPI",
+			appChannel: new(channelt.MockAppChannel),
+		}
+		server := startProxyServer(port, fakeAPI.ProxyCallLocal)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := runtimev1pb.NewAppCallbackClient(clientConn)
+		_, err := client.OnInvoke(context.Background(), &commonv1pb.InvokeRequest{Method: "method"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("access control policy denies call", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		mockAppChannel := new(mockProxyAppChannel)
+		fakeAPI := &api{
+			id:         "fakeAPI",
+			appChannel: mockAppChannel,
+			accessControlList: &config.AccessControlList{
+				DefaultAction: config.DenyAccess,
+				TrustDomain:   "public",
+			},
+		}
+		server := startProxyServer(port, fakeAPI.ProxyCallLocal)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := runtimev1pb.NewAppCallbackClient(clientConn)
+		_, err := client.OnInvoke(context.Background(), &commonv1pb.InvokeRequest{Method: "method"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockAppChannel.AssertNotCalled(t, "ProxyStream", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("proxies call to app channel", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		mockAppChannel := new(mockProxyAppChannel)
+		mockAppChannel.On("ProxyStream",
+			mock.Anything,
+			"/dapr.proto.runtime.v1.AppCallback/OnInvoke",
+			mock.Anything).Return(status.Error(codes.NotFound, "not found"))
+		fakeAPI := &api{
+			id:         "fakeAPI",
+			appChannel: mockAppChannel,
+		}
+		server := startProxyServer(port, fakeAPI.ProxyCallLocal)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := runtimev1pb.NewAppCallbackClient(clientConn)
+		_, err := client.OnInvoke(context.Background(), &commonv1pb.InvokeRequest{Method: "method"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		mockAppChannel.AssertNumberOfCalls(t, "ProxyStream", 1)
+	})
+}
+
 func mustMarshalAny(msg proto.Message) *any.Any {
 	any, err := ptypes.MarshalAny(msg)
 	if err != nil {
@@ -459,6 +714,30 @@ func TestAPIToken(t *testing.T) {
 	})
 }
 
+func TestAuthenticateCaller(t *testing.T) {
+	t.Run("caller metadata set by the client is removed", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"dapr-caller-sub", "spoofed",
+			"dapr-app-id", "fakeAppID"))
+
+		ctx, err := authenticateCaller(ctx, nil)
+		assert.NoError(t, err)
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		assert.Empty(t, md.Get("dapr-caller-sub"))
+		assert.Equal(t, []string{"fakeAppID"}, md.Get("dapr-app-id"))
+	})
+
+	t.Run("missing bearer token", func(t *testing.T) {
+		authenticator := &auth.JWTAuthenticator{}
+		_, err := authenticateCaller(context.Background(), authenticator)
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+}
+
 func TestInvokeServiceFromHTTPResponse(t *testing.T) {
 	mockDirectMessaging := new(daprt.MockDirectMessaging)
 
@@ -745,6 +1024,133 @@ func TestGetSecret(t *testing.T) {
 	}
 }
 
+func TestGetBulkSecret(t *testing.T) {
+	fakeStore := daprt.FakeSecretStore{}
+	fakeStores := map[string]secretstores.SecretStore{
+		"store1": fakeStore,
+		"store2": fakeStore,
+		"store3": fakeStore,
+	}
+	secretsConfiguration := map[string]config.SecretsScope{
+		"store1": {
+			DefaultAccess: config.AllowAccess,
+			DeniedSecrets: []string{"not-allowed"},
+		},
+		"store2": {
+			DefaultAccess:  config.DenyAccess,
+			AllowedSecrets: []string{"good-key"},
+		},
+	}
+
+	testCases := []struct {
+		testName     string
+		storeName    string
+		prefix       string
+		expectedKeys []string
+	}{
+		{
+			testName:     "Denied secrets are filtered",
+			storeName:    "store1",
+			expectedKeys: []string{"good-key", "good-key-2"},
+		},
+		{
+			testName:     "Only allowed secrets are returned",
+			storeName:    "store2",
+			expectedKeys: []string{"good-key"},
+		},
+		{
+			testName:     "Unrestricted store with prefix",
+			storeName:    "store3",
+			prefix:       "good-key-",
+			expectedKeys: []string{"good-key-2"},
+		},
+	}
+	// Setup Dapr API server
+	fakeAPI := &api{
+		id:                   "fakeAPI",
+		secretStores:         fakeStores,
+		secretsConfiguration: secretsConfiguration,
+	}
+	// Run test server
+	port, _ := freeport.GetFreePort()
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	// Create gRPC test client
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	// act
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := &runtimev1pb.GetBulkSecretRequest{
+				StoreName: tt.storeName,
+				Prefix:    tt.prefix,
+			}
+			resp, err := client.GetBulkSecret(context.Background(), req)
+
+			assert.NoError(t, err, "Expected no error")
+			assert.Equal(t, len(tt.expectedKeys), len(resp.Data))
+			for _, k := range tt.expectedKeys {
+				assert.Contains(t, resp.Data, k)
+				assert.NotEmpty(t, resp.Data[k].Secrets[k])
+			}
+		})
+	}
+
+	t.Run("Store not found", func(t *testing.T) {
+		_, err := client.GetBulkSecret(context.Background(), &runtimev1pb.GetBulkSecretRequest{StoreName: "notexist"})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetMetadata(t *testing.T) {
+	mockActors := new(daprt.MockActors)
+	mockActors.On("GetActiveActorsCount")
+	mockActors.On("GetActorRuntimeMetadata")
+	fakeAPI := &api{
+		id:    "fakeAPI",
+		actor: mockActors,
+		getComponentsFn: func() []components.RegisteredComponent {
+			return []components.RegisteredComponent{
+				{Name: "statestore", Type: "state.redis", Version: "v1", Status: components.StatusLoaded},
+				{Name: "secrets", Type: "secretstores.local.file", Status: components.StatusFailed},
+			}
+		},
+		getSubscriptionsFn: func() []runtime_pubsub.Subscription {
+			return []runtime_pubsub.Subscription{
+				{PubsubName: "pubsub", Topic: "orders", Route: "/orders", Metadata: map[string]string{"password": "p4ssw0rd"}},
+			}
+		},
+		configurationName: "appconfig",
+	}
+	port, _ := freeport.GetFreePort()
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+	resp, err := client.GetMetadata(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, "fakeAPI", resp.Id)
+	assert.Equal(t, version.Version(), resp.RuntimeVersion)
+	assert.Equal(t, "appconfig", resp.ConfigurationName)
+	assert.Len(t, resp.ActiveActorsCount, 2)
+	assert.Equal(t, []string{"abcd", "xyz"}, resp.ActorRuntime.HostedActorTypes)
+	assert.Equal(t, "1", resp.ActorRuntime.PlacementTableVersion)
+	assert.Len(t, resp.RegisteredComponents, 2)
+	assert.Equal(t, "statestore", resp.RegisteredComponents[0].Name)
+	assert.Equal(t, "v1", resp.RegisteredComponents[0].Version)
+	assert.Equal(t, components.StatusFailed, resp.RegisteredComponents[1].Status)
+	assert.Len(t, resp.Subscriptions, 1)
+	assert.Equal(t, "/orders", resp.Subscriptions[0].Route)
+	assert.NotContains(t, resp.String(), "p4ssw0rd")
+}
+
 func TestSaveState(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 
@@ -823,6 +1229,15 @@ func TestPublishTopic(t *testing.T) {
 		Topic:      "topic",
 	})
 	assert.Nil(t, err)
+
+	srv.publishFn = func(req *pubsub.PublishRequest) error {
+		return runtime_pubsub.NewNotAllowedError("topic %s is not allowed for app id %s", req.Topic, "app1")
+	}
+	_, err = client.PublishEvent(context.Background(), &runtimev1pb.PublishEventRequest{
+		PubsubName: "pubsub",
+		Topic:      "topic",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
 }
 
 func TestInvokeBinding(t *testing.T) {
@@ -839,6 +1254,151 @@ func TestInvokeBinding(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInvokeBindingTraceContext(t *testing.T) {
+	var metadata map[string]string
+	fakeAPI := &api{
+		id: "fakeAPI",
+		sendToOutputBindingFn: func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+			metadata = req.Metadata
+			return nil, nil
+		},
+	}
+
+	ctx, span := trace.StartSpan(context.Background(), "test", trace.WithSampler(trace.AlwaysSample()))
+	defer span.End()
+	_, err := fakeAPI.InvokeBinding(ctx, &runtimev1pb.InvokeBindingRequest{
+		Name:      "testbinding",
+		Operation: "create",
+		Metadata:  map[string]string{"key": "value"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "value", metadata["key"])
+	assert.Equal(t, diag.SpanContextToW3CString(span.SpanContext()), metadata["traceparent"])
+}
+
+func TestInvokeBindingPermissionDenied(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	fakeAPI := &api{
+		id: "app1",
+		bindingsConfiguration: map[string]config.BindingsScope{
+			"testbinding": {
+				BindingName:   "testbinding",
+				DefaultAccess: config.DenyAccess,
+				AppPolicies: []config.BindingAppPolicy{
+					{AppID: "app1", AllowedOperations: []string{"get"}},
+				},
+			},
+		},
+		sendToOutputBindingFn: func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+			return &bindings.InvokeResponse{}, nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+	_, err := client.InvokeBinding(context.Background(), &runtimev1pb.InvokeBindingRequest{Name: "testbinding", Operation: "delete"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.InvokeBinding(context.Background(), &runtimev1pb.InvokeBindingRequest{Name: "testbinding", Operation: "get"})
+	assert.NoError(t, err)
+
+	stream, err := client.InvokeBindingStream(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&runtimev1pb.InvokeBindingStreamRequest{Name: "testbinding", Operation: "delete"}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestInvokeBindingStream(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	fakeAPI := &api{
+		id: "fakeAPI",
+		sendToOutputBindingStreamFn: func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error) {
+			if name != "testbinding" {
+				return nil, errors.Errorf("couldn't find output binding %s", name)
+			}
+			data, err := ioutil.ReadAll(req.Data)
+			if err != nil {
+				return nil, err
+			}
+			return &bindings_loader.StreamInvokeResponse{
+				Data:     ioutil.NopCloser(bytes.NewReader(bytes.ToUpper(data))),
+				Metadata: map[string]string{"operation": string(req.Operation)},
+			}, nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("stream request and response", func(t *testing.T) {
+		stream, err := client.InvokeBindingStream(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&runtimev1pb.InvokeBindingStreamRequest{
+			Name:      "testbinding",
+			Operation: "create",
+			Data:      []byte("hello "),
+		}))
+		assert.NoError(t, stream.Send(&runtimev1pb.InvokeBindingStreamRequest{Data: []byte("world")}))
+		assert.NoError(t, stream.CloseSend())
+
+		var data []byte
+		var md map[string]string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			if md == nil {
+				md = resp.Metadata
+			}
+			data = append(data, resp.Data...)
+		}
+		assert.Equal(t, "HELLO WORLD", string(data))
+		assert.Equal(t, "create", md["operation"])
+	})
+
+	t.Run("binding error", func(t *testing.T) {
+		stream, err := client.InvokeBindingStream(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&runtimev1pb.InvokeBindingStreamRequest{Name: "notexist", Operation: "create"}))
+		assert.NoError(t, stream.CloseSend())
+
+		_, err = stream.Recv()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "ERR_INVOKE_OUTPUT_BINDING")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+		server := startDaprAPIServer(port, fakeAPI, "1234")
+		defer server.Stop()
+
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := runtimev1pb.NewDaprClient(clientConn)
+		md := metadata.Pairs("dapr-api-token", "4567")
+		ctx := metadata.NewOutgoingContext(context.Background(), md)
+		stream, err := client.InvokeBindingStream(ctx)
+		assert.NoError(t, err)
+
+		_, err = stream.Recv()
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+}
+
 func TestExecuteStateTransaction(t *testing.T) {
 	stateOptions, _ := GenerateStateOptionsTestCase()
 	port, _ := freeport.GetFreePort()
@@ -893,3 +1453,40 @@ func GenerateStateOptionsTestCase() (*commonv1pb.StateOptions, state.SetStateOpt
 	}
 	return &testOptions, expected
 }
+
+func TestStateAccessControlPolicies(t *testing.T) {
+	accessControlList, _ := config.ParseAccessControlSpec(config.AccessControlSpec{
+		DefaultAction: config.AllowAccess,
+		TrustDomain:   "public",
+		AppPolicies: []config.AppPolicySpec{
+			{
+				AppName:       "fakeAPI",
+				DefaultAction: config.AllowAccess,
+				TrustDomain:   "public",
+				Namespace:     "default",
+				StateOperations: []config.AppOperation{
+					{Action: config.DenyAccess, Operation: "store1/save"},
+				},
+			},
+		},
+	})
+	fakeStore := &daprt.MockStateStore{}
+	fakeStore.On("Get", mock.Anything).Return(nil)
+	fakeAPI := &api{
+		id:                "fakeAPI",
+		stateStores:       map[string]state.Store{"store1": fakeStore},
+		accessControlList: accessControlList,
+	}
+
+	t.Run("allowed operation", func(t *testing.T) {
+		_, err := fakeAPI.GetState(context.Background(), &runtimev1pb.GetStateRequest{StoreName: "store1", Key: "key1"})
+		assert.NoError(t, err)
+		fakeStore.AssertCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("denied operation", func(t *testing.T) {
+		_, err := fakeAPI.SaveState(context.Background(), &runtimev1pb.SaveStateRequest{StoreName: "store1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		fakeStore.AssertNotCalled(t, "BulkSet", mock.Anything)
+	})
+}
@@ -5,28 +5,99 @@ import (
 	"net/http"
 
 	v1 "github.com/dapr/dapr/pkg/messaging/v1"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
-func setAPIAuthenticationMiddlewareUnary(apiToken, authHeader string) grpc.UnaryServerInterceptor {
+func setAPIAuthenticationMiddlewareUnary(apiTokens *auth.APITokenStore, authHeader string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			err := v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing metadata in request")
+		if err := checkAPIToken(ctx, apiTokens, authHeader, info.FullMethod); err != nil {
 			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		token := md.Get(authHeader)
-		if len(token) == 0 {
-			err := v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing api token in request metadata")
-			return nil, err
+func setAPIAuthenticationMiddlewareStream(apiTokens *auth.APITokenStore, authHeader string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkAPIToken(stream.Context(), apiTokens, authHeader, info.FullMethod); err != nil {
+			return err
 		}
+		return handler(srv, stream)
+	}
+}
+
+func checkAPIToken(ctx context.Context, apiTokens *auth.APITokenStore, authHeader, fullMethod string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing metadata in request")
+	}
 
-		if token[0] != apiToken {
-			err := v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "authentication error: api token mismatch")
+	token := md.Get(authHeader)
+	if len(token) == 0 {
+		return v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing api token in request metadata")
+	}
+
+	if err := apiTokens.Authorize(token[0], auth.GRPCMethodScope(fullMethod)); err != nil {
+		if err == auth.ErrAPITokenScope {
+			return v1.ErrorFromHTTPResponseCode(http.StatusForbidden, err.Error())
+		}
+		return v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, err.Error())
+	}
+	return nil
+}
+
+func setJWTAuthenticationMiddlewareUnary(authenticator *auth.JWTAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticateCaller(ctx, authenticator)
+		if err != nil {
 			return nil, err
 		}
 		return handler(ctx, req)
 	}
 }
+
+func setJWTAuthenticationMiddlewareStream(authenticator *auth.JWTAuthenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateCaller(stream.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// authenticateCaller verifies the bearer JWT of the caller, if authenticator is not nil, and returns a context
+// whose incoming metadata holds the claims of the caller as dapr-caller-<claim> keys, which are forwarded to the app.
+// Caller keys set by the client are always removed so they can't be spoofed.
+func authenticateCaller(ctx context.Context, authenticator *auth.JWTAuthenticator) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	md = md.Copy()
+	for k := range md {
+		if auth.IsCallerClaimHeader(k) {
+			delete(md, k)
+		}
+	}
+
+	if authenticator != nil {
+		var authorization string
+		if values := md.Get(auth.AuthorizationHeader); len(values) > 0 {
+			authorization = values[0]
+		}
+		claims, err := authenticator.Authenticate(authorization)
+		if err != nil {
+			return nil, v1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, err.Error())
+		}
+		for name, value := range claims {
+			md.Set(auth.CallerClaimHeader(name), value)
+		}
+	}
+	return metadata.NewIncomingContext(ctx, md), nil
+}
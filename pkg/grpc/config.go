@@ -12,6 +12,8 @@ type ServerConfig struct {
 	Port        int
 	NameSpace   string
 	TrustDomain string
+	// UnixDomainSocket is the path of the Unix domain socket to listen on instead of the port, if set
+	UnixDomainSocket string
 }
 
 // NewServerConfig returns a new grpc server config
@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -32,7 +33,7 @@ const (
 // Manager is a wrapper around gRPC connection pooling
 type Manager struct {
 	AppClient      *grpc.ClientConn
-	lock           *sync.Mutex
+	lock           *sync.RWMutex
 	connectionPool map[string]*grpc.ClientConn
 	auth           security.Authenticator
 	mode           modes.DaprMode
@@ -41,7 +42,7 @@ type Manager struct {
 // NewGRPCManager returns a new grpc manager
 func NewGRPCManager(mode modes.DaprMode) *Manager {
 	return &Manager{
-		lock:           &sync.Mutex{},
+		lock:           &sync.RWMutex{},
 		connectionPool: map[string]*grpc.ClientConn{},
 		mode:           mode,
 	}
@@ -53,26 +54,65 @@ func (g *Manager) SetAuthenticator(auth security.Authenticator) {
 }
 
 // CreateLocalChannel creates a new gRPC AppChannel
-func (g *Manager) CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec) (channel.AppChannel, error) {
-	conn, err := g.GetGRPCConnection(fmt.Sprintf("127.0.0.1:%v", port), "", "", true, false)
+func (g *Manager) CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec, requestTimeout time.Duration, appConn channel.AppConnection) (channel.AppChannel, error) {
+	conn, err := g.getAppConnection(port, appConn)
 	if err != nil {
 		return nil, errors.Errorf("error establishing connection to app grpc on port %v: %s", port, err)
 	}
 
 	g.AppClient = conn
-	ch := grpc_channel.CreateLocalChannel(port, maxConcurrency, conn, spec)
+	ch := grpc_channel.CreateLocalChannel(port, maxConcurrency, conn, spec, requestTimeout)
 	return ch, nil
 }
 
+// getAppConnection returns a connection to the app over TCP or its Unix domain socket, encrypted if the app
+// connection has a TLS config
+func (g *Manager) getAppConnection(port int, appConn channel.AppConnection) (*grpc.ClientConn, error) {
+	if appConn.UnixSocket == "" && appConn.TLSConfig == nil {
+		return g.GetGRPCConnection(fmt.Sprintf("127.0.0.1:%v", port), "", "", true, false)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithBlock(),
+	}
+
+	if diag.DefaultGRPCMonitoring.IsEnabled() {
+		opts = append(opts, grpc.WithUnaryInterceptor(diag.DefaultGRPCMonitoring.UnaryClientInterceptor()))
+	}
+
+	if appConn.TLSConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(appConn.TLSConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	address := fmt.Sprintf("127.0.0.1:%v", port)
+	if appConn.UnixSocket != "" {
+		address = "localhost"
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", appConn.UnixSocket)
+		}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+
+	return grpc.DialContext(ctx, address, opts...)
+}
+
 // GetGRPCConnection returns a new grpc connection for a given address and inits one if doesn't exist
 func (g *Manager) GetGRPCConnection(address, id string, namespace string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error) {
-	if val, ok := g.connectionPool[address]; ok && !recreateIfExists {
+	g.lock.RLock()
+	val, ok := g.connectionPool[address]
+	g.lock.RUnlock()
+	if ok && !recreateIfExists {
 		return val, nil
 	}
 
 	g.lock.Lock()
+	defer g.lock.Unlock()
 	if val, ok := g.connectionPool[address]; ok && !recreateIfExists {
-		g.lock.Unlock()
 		return val, nil
 	}
 
@@ -114,12 +154,24 @@ func (g *Manager) GetGRPCConnection(address, id string, namespace string, skipTL
 	dialPrefix := GetDialAddressPrefix(g.mode)
 	conn, err := grpc.DialContext(ctx, dialPrefix+address, opts...)
 	if err != nil {
-		g.lock.Unlock()
 		return nil, err
 	}
 
+	if existing, ok := g.connectionPool[address]; ok {
+		existing.Close()
+	}
 	g.connectionPool[address] = conn
-	g.lock.Unlock()
 
 	return conn, nil
 }
+
+// CloseGRPCConnection closes the pooled connection to address, if any, and removes it from the pool
+func (g *Manager) CloseGRPCConnection(address string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if conn, ok := g.connectionPool[address]; ok {
+		conn.Close()
+		delete(g.connectionPool, address)
+	}
+}
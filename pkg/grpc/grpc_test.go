@@ -7,11 +7,14 @@ package grpc
 
 import (
 	"crypto/x509"
+	"net"
 	"testing"
 
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/runtime/security"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
 type authenticatorMock struct {
@@ -48,3 +51,39 @@ func TestSetAuthenticator(t *testing.T) {
 
 	assert.Equal(t, a, m.auth)
 }
+
+func TestCloseGRPCConnection(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	go server.Serve(lis) // nolint: errcheck
+	defer server.Stop()
+
+	m := NewGRPCManager(modes.StandaloneMode)
+	address := lis.Addr().String()
+	conn, err := m.GetGRPCConnection(address, "id", "ns", true, false)
+	assert.NoError(t, err)
+
+	t.Run("pooled connection is reused", func(t *testing.T) {
+		pooled, err := m.GetGRPCConnection(address, "id", "ns", true, false)
+		assert.NoError(t, err)
+		assert.Equal(t, conn, pooled)
+	})
+
+	t.Run("recreated connection replaces the pooled one", func(t *testing.T) {
+		recreated, err := m.GetGRPCConnection(address, "id", "ns", true, true)
+		assert.NoError(t, err)
+		assert.NotEqual(t, conn, recreated)
+		assert.Equal(t, connectivity.Shutdown, conn.GetState())
+		conn = recreated
+	})
+
+	t.Run("closed connection is removed from the pool", func(t *testing.T) {
+		m.CloseGRPCConnection(address)
+		assert.Equal(t, connectivity.Shutdown, conn.GetState())
+		assert.NotContains(t, m.connectionPool, address)
+
+		// closing an address without a connection is a no-op
+		m.CloseGRPCConnection(address)
+	})
+}
@@ -0,0 +1,169 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// traceContextHeader is the gRPC trace context header, which is set by callers of Forward
+	// for the span of the proxied call instead of being copied from the incoming call
+	traceContextHeader = "grpc-trace-bin"
+	// apiTokenHeader is the Dapr API token header, which is not forwarded to the target
+	apiTokenHeader = "dapr-api-token"
+)
+
+var proxyStreamDesc = &grpc.StreamDesc{
+	ServerStreams: true,
+	ClientStreams: true,
+}
+
+// frame is a raw gRPC message, forwarded without being decoded
+type frame struct {
+	payload []byte
+}
+
+// codec passes frames through as is and delegates any other message to the proto codec,
+// so that a server using it can both proxy unknown services and serve its own services.
+type codec struct {
+	parent encoding.Codec
+}
+
+// Codec returns the codec to be used by gRPC servers proxying calls with Forward
+func Codec() grpc.Codec {
+	return newCodec()
+}
+
+func newCodec() *codec {
+	return &codec{parent: encoding.GetCodec(proto.Name)}
+}
+
+func (c *codec) Marshal(v interface{}) ([]byte, error) {
+	if f, ok := v.(*frame); ok {
+		return f.payload, nil
+	}
+	return c.parent.Marshal(v)
+}
+
+func (c *codec) Unmarshal(data []byte, v interface{}) error {
+	if f, ok := v.(*frame); ok {
+		f.payload = data
+		return nil
+	}
+	return c.parent.Unmarshal(data, v)
+}
+
+func (c *codec) Name() string {
+	return c.parent.Name()
+}
+
+func (c *codec) String() string {
+	return c.Name()
+}
+
+// Forward proxies the call received on serverStream to fullMethod on conn, forwarding the request
+// frames, and then the response headers, frames and trailers back, until the call completes.
+// The incoming metadata is forwarded, except for the pseudo headers, the Dapr API token and the
+// trace context. Callers add the trace context and any other metadata to the outgoing metadata of ctx.
+func Forward(ctx context.Context, conn *grpc.ClientConn, fullMethod string, serverStream grpc.ServerStream) error {
+	md, _ := metadata.FromIncomingContext(serverStream.Context())
+	outMD := metadata.MD{}
+	for k, v := range md {
+		if strings.HasPrefix(k, ":") || k == apiTokenHeader || k == traceContextHeader {
+			continue
+		}
+		outMD[k] = v
+	}
+	if extraMD, ok := metadata.FromOutgoingContext(ctx); ok {
+		outMD = metadata.Join(outMD, extraMD)
+	}
+
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(ctx, outMD))
+	defer cancel()
+
+	clientStream, err := grpc.NewClientStream(ctx, proxyStreamDesc, conn, fullMethod, grpc.ForceCodec(newCodec()))
+	if err != nil {
+		return err
+	}
+
+	reqErrCh := make(chan error, 1)
+	go func() {
+		reqErrCh <- forwardRequests(serverStream, clientStream)
+	}()
+	respErrCh := make(chan error, 1)
+	go func() {
+		respErrCh <- forwardResponses(clientStream, serverStream)
+	}()
+
+	for {
+		select {
+		case err := <-reqErrCh:
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed proxying request: %v", err)
+			}
+			// the request side is done, wait for the response side
+			reqErrCh = nil
+		case err := <-respErrCh:
+			// the trailers of the target have been set already, err carries its status
+			return err
+		}
+	}
+}
+
+// forwardRequests forwards the request frames until the caller closes its side of the stream
+func forwardRequests(src grpc.ServerStream, dst grpc.ClientStream) error {
+	f := &frame{}
+	for {
+		if err := src.RecvMsg(f); err != nil {
+			if err == io.EOF {
+				return dst.CloseSend()
+			}
+			return err
+		}
+		if err := dst.SendMsg(f); err != nil {
+			if err == io.EOF {
+				// the target ended the call, its status is returned on the response side
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// forwardResponses forwards the response headers, frames and trailers until the target ends the call
+func forwardResponses(src grpc.ClientStream, dst grpc.ServerStream) error {
+	header, err := src.Header()
+	if err != nil {
+		return err
+	}
+	if err := dst.SendHeader(header); err != nil {
+		return err
+	}
+
+	f := &frame{}
+	for {
+		if err := src.RecvMsg(f); err != nil {
+			dst.SetTrailer(src.Trailer())
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := dst.SendMsg(f); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The Implementation of fake user app server
+type mockServer struct {
+}
+
+func (m *mockServer) OnInvoke(ctx context.Context, in *commonv1pb.InvokeRequest) (*commonv1pb.InvokeResponse, error) {
+	if in.Method == "fail" {
+		grpc.SetTrailer(ctx, metadata.Pairs("failure", "true"))
+		return nil, status.Error(codes.NotFound, "method not found")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	dt := map[string]string{
+		"method": in.Method,
+	}
+	for k, v := range md {
+		dt[k] = v[0]
+	}
+
+	grpc.SetHeader(ctx, metadata.Pairs("app-header", "value"))
+	ds, _ := json.Marshal(dt)
+	return &commonv1pb.InvokeResponse{Data: &any.Any{Value: ds}, ContentType: "application/json"}, nil
+}
+
+func (m *mockServer) ListTopicSubscriptions(ctx context.Context, in *empty.Empty) (*runtimev1pb.ListTopicSubscriptionsResponse, error) {
+	return &runtimev1pb.ListTopicSubscriptionsResponse{}, nil
+}
+
+func (m *mockServer) ListInputBindings(ctx context.Context, in *empty.Empty) (*runtimev1pb.ListInputBindingsResponse, error) {
+	return &runtimev1pb.ListInputBindingsResponse{}, nil
+}
+
+func (m *mockServer) OnBindingEvent(ctx context.Context, in *runtimev1pb.BindingEventRequest) (*runtimev1pb.BindingEventResponse, error) {
+	return &runtimev1pb.BindingEventResponse{}, nil
+}
+
+func (m *mockServer) OnTopicEvent(ctx context.Context, in *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error) {
+	return &runtimev1pb.TopicEventResponse{}, nil
+}
+
+func startServer(t *testing.T, register func(*grpc.Server), opts ...grpc.ServerOption) (*grpc.Server, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	server := grpc.NewServer(opts...)
+	if register != nil {
+		register(server)
+	}
+	go server.Serve(lis)
+	return server, lis.Addr().String()
+}
+
+func TestForward(t *testing.T) {
+	appServer, appAddress := startServer(t, func(s *grpc.Server) {
+		runtimev1pb.RegisterAppCallbackServer(s, &mockServer{})
+	})
+	defer appServer.Stop()
+
+	appConn, err := grpc.Dial(appAddress, grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer appConn.Close()
+
+	proxyServer, proxyAddress := startServer(t, nil,
+		grpc.CustomCodec(Codec()),
+		grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+			fullMethod, _ := grpc.MethodFromServerStream(stream)
+			ctx := metadata.AppendToOutgoingContext(stream.Context(), "added-header", "added")
+			return Forward(ctx, appConn, fullMethod, stream)
+		}))
+	defer proxyServer.Stop()
+
+	proxyConn, err := grpc.Dial(proxyAddress, grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer proxyConn.Close()
+	client := runtimev1pb.NewAppCallbackClient(proxyConn)
+
+	t.Run("forwards request, response and metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(),
+			"user-header", "user",
+			"dapr-api-token", "token")
+
+		var header metadata.MD
+		resp, err := client.OnInvoke(ctx, &commonv1pb.InvokeRequest{Method: "method"}, grpc.Header(&header))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", resp.ContentType)
+		assert.Equal(t, []string{"value"}, header.Get("app-header"))
+
+		actual := map[string]string{}
+		json.Unmarshal(resp.Data.Value, &actual)
+		assert.Equal(t, "method", actual["method"])
+		assert.Equal(t, "user", actual["user-header"])
+		assert.Equal(t, "added", actual["added-header"])
+		assert.NotContains(t, actual, "dapr-api-token")
+	})
+
+	t.Run("forwards error status and trailers", func(t *testing.T) {
+		var trailer metadata.MD
+		_, err := client.OnInvoke(context.Background(), &commonv1pb.InvokeRequest{Method: "fail"}, grpc.Trailer(&trailer))
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "method not found", status.Convert(err).Message())
+		assert.Equal(t, []string{"true"}, trailer.Get("failure"))
+	})
+}
+
+func TestCodec(t *testing.T) {
+	c := Codec()
+
+	t.Run("frames are passed through", func(t *testing.T) {
+		f := &frame{}
+		err := c.Unmarshal([]byte("raw"), f)
+		assert.NoError(t, err)
+
+		data, err := c.Marshal(f)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("raw"), data)
+	})
+
+	t.Run("proto messages are encoded", func(t *testing.T) {
+		data, err := c.Marshal(&commonv1pb.InvokeRequest{Method: "method"})
+		assert.NoError(t, err)
+
+		var req commonv1pb.InvokeRequest
+		err = c.Unmarshal(data, &req)
+		assert.NoError(t, err)
+		assert.Equal(t, "method", req.Method)
+	})
+}
@@ -9,13 +9,16 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/grpc/proxy"
 	"github.com/dapr/dapr/pkg/logger"
+	middleware "github.com/dapr/dapr/pkg/middleware/grpc"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	auth "github.com/dapr/dapr/pkg/runtime/security"
@@ -32,6 +35,8 @@ const (
 	apiServer                      = "apiServer"
 	internalServer                 = "internalServer"
 	defaultMaxConnectionAgeSeconds = 30
+	// unixSocketMode restricts the Unix domain socket of the server to the user and group of daprd
+	unixSocketMode = 0660
 )
 
 // Server is an interface for the dapr gRPC server
@@ -54,14 +59,16 @@ type server struct {
 	kind               string
 	logger             logger.Logger
 	maxConnectionAge   *time.Duration
-	authToken          string
+	apiTokens          *auth.APITokenStore
+	jwtAuth            *auth.JWTAuthenticator
+	pipeline           middleware.Pipeline
 }
 
 var apiServerLogger = logger.NewLogger("dapr.runtime.grpc.api")
 var internalServerLogger = logger.NewLogger("dapr.runtime.grpc.internal")
 
 // NewAPIServer returns a new user facing gRPC API server
-func NewAPIServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec) Server {
+func NewAPIServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, pipeline middleware.Pipeline, apiTokens *auth.APITokenStore, jwtAuth *auth.JWTAuthenticator) Server {
 	return &server{
 		api:         api,
 		config:      config,
@@ -69,12 +76,14 @@ func NewAPIServer(api API, config ServerConfig, tracingSpec config.TracingSpec,
 		metricSpec:  metricSpec,
 		kind:        apiServer,
 		logger:      apiServerLogger,
-		authToken:   auth.GetAPIToken(),
+		apiTokens:   apiTokens,
+		jwtAuth:     jwtAuth,
+		pipeline:    pipeline,
 	}
 }
 
 // NewInternalServer returns a new gRPC server for Dapr to Dapr communications
-func NewInternalServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, authenticator auth.Authenticator) Server {
+func NewInternalServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, authenticator auth.Authenticator, pipeline middleware.Pipeline) Server {
 	return &server{
 		api:              api,
 		config:           config,
@@ -85,6 +94,7 @@ func NewInternalServer(api API, config ServerConfig, tracingSpec config.TracingS
 		kind:             internalServer,
 		logger:           internalServerLogger,
 		maxConnectionAge: getDefaultMaxAgeDuration(),
+		pipeline:         pipeline,
 	}
 }
 
@@ -95,7 +105,7 @@ func getDefaultMaxAgeDuration() *time.Duration {
 
 // StartNonBlocking starts a new server in a goroutine
 func (s *server) StartNonBlocking() error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", s.config.Port))
+	lis, err := s.listen()
 	if err != nil {
 		return err
 	}
@@ -120,6 +130,28 @@ func (s *server) StartNonBlocking() error {
 	return nil
 }
 
+// listen listens on the Unix domain socket of the server if it has one, or on its TCP port otherwise
+func (s *server) listen() (net.Listener, error) {
+	if s.config.UnixDomainSocket == "" {
+		return net.Listen("tcp", fmt.Sprintf(":%v", s.config.Port))
+	}
+
+	// remove the socket of a previous run, which would make the listener fail
+	if err := os.Remove(s.config.UnixDomainSocket); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	lis, err := net.Listen("unix", s.config.UnixDomainSocket)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(s.config.UnixDomainSocket, unixSocketMode); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	s.logger.Infof("gRPC server is listening on unix domain socket %s", s.config.UnixDomainSocket)
+	return lis, nil
+}
+
 func (s *server) generateWorkloadCert() error {
 	s.logger.Info("sending workload csr request to sentry")
 	signedCert, err := s.authenticator.CreateSignedWorkloadCert(s.config.AppID, s.config.NameSpace, s.config.TrustDomain)
@@ -142,6 +174,7 @@ func (s *server) generateWorkloadCert() error {
 func (s *server) getMiddlewareOptions() []grpc_go.ServerOption {
 	opts := []grpc_go.ServerOption{}
 	intr := []grpc_go.UnaryServerInterceptor{}
+	streamIntr := []grpc_go.StreamServerInterceptor{}
 
 	if diag_utils.IsTracingEnabled(s.tracingSpec.SamplingRate) {
 		s.logger.Info("enabled gRPC tracing middleware")
@@ -152,10 +185,20 @@ func (s *server) getMiddlewareOptions() []grpc_go.ServerOption {
 		s.logger.Info("enabled gRPC metrics middleware")
 		intr = append(intr, diag.DefaultGRPCMonitoring.UnaryServerInterceptor())
 	}
-	if s.authToken != "" {
+	if s.apiTokens.Enabled() {
 		s.logger.Info("enabled token authentication on gRPC server")
-		intr = append(intr, setAPIAuthenticationMiddlewareUnary(s.authToken, auth.APITokenHeader))
+		intr = append(intr, setAPIAuthenticationMiddlewareUnary(s.apiTokens, auth.APITokenHeader))
+		streamIntr = append(streamIntr, setAPIAuthenticationMiddlewareStream(s.apiTokens, auth.APITokenHeader))
 	}
+	if s.kind == apiServer {
+		if s.jwtAuth != nil {
+			s.logger.Info("enabled jwt authentication on gRPC server")
+		}
+		intr = append(intr, setJWTAuthenticationMiddlewareUnary(s.jwtAuth))
+		streamIntr = append(streamIntr, setJWTAuthenticationMiddlewareStream(s.jwtAuth))
+	}
+	intr = append(intr, s.pipeline.UnaryInterceptors()...)
+	streamIntr = append(streamIntr, s.pipeline.StreamInterceptors()...)
 
 	chain := grpc_middleware.ChainUnaryServer(
 		intr...,
@@ -164,11 +207,27 @@ func (s *server) getMiddlewareOptions() []grpc_go.ServerOption {
 		opts,
 		grpc_go.UnaryInterceptor(chain),
 	)
+	if len(streamIntr) > 0 {
+		opts = append(opts, grpc_go.StreamInterceptor(grpc_middleware.ChainStreamServer(streamIntr...)))
+	}
 	return opts
 }
 
+// getProxyOptions returns the options to transparently proxy the calls of gRPC services unknown to Dapr
+func (s *server) getProxyOptions() []grpc_go.ServerOption {
+	handler := s.api.ProxyCall
+	if s.kind == internalServer {
+		handler = s.api.ProxyCallLocal
+	}
+	return []grpc_go.ServerOption{
+		grpc_go.CustomCodec(proxy.Codec()),
+		grpc_go.UnknownServiceHandler(handler),
+	}
+}
+
 func (s *server) getGRPCServer() (*grpc_go.Server, error) {
 	opts := s.getMiddlewareOptions()
+	opts = append(opts, s.getProxyOptions()...)
 	if s.maxConnectionAge != nil {
 		opts = append(opts, grpc_go.KeepaliveParams(keepalive.ServerParameters{MaxConnectionAge: *s.maxConnectionAge}))
 	}
@@ -1,13 +1,16 @@
 package grpc
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/logger"
+	middleware "github.com/dapr/dapr/pkg/middleware/grpc"
 	"github.com/stretchr/testify/assert"
+	grpc_go "google.golang.org/grpc"
 )
 
 func TestCertRenewal(t *testing.T) {
@@ -59,4 +62,28 @@ func TestGetMiddlewareOptions(t *testing.T) {
 
 		assert.Equal(t, 1, len(serverOption))
 	})
+
+	t.Run("should add stream interceptor if the middleware pipeline has stream interceptors", func(t *testing.T) {
+		fakeServer := &server{
+			config:     ServerConfig{},
+			renewMutex: &sync.Mutex{},
+			logger:     logger.NewLogger("dapr.runtime.grpc.test"),
+			pipeline: middleware.Pipeline{
+				Handlers: []middleware.Middleware{
+					{
+						Unary: func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+							return handler(ctx, req)
+						},
+						Stream: func(srv interface{}, ss grpc_go.ServerStream, info *grpc_go.StreamServerInfo, handler grpc_go.StreamHandler) error {
+							return handler(srv, ss)
+						},
+					},
+				},
+			},
+		}
+
+		serverOption := fakeServer.getMiddlewareOptions()
+
+		assert.Equal(t, 2, len(serverOption))
+	})
 }
@@ -0,0 +1,135 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// AppHealthProbe probes the health of the app, returning an error if the app isn't healthy
+type AppHealthProbe func(ctx context.Context) error
+
+// AppHealth periodically probes the app. The app becomes unhealthy after failing the failure threshold
+// of consecutive probes, and healthy again as soon as a probe succeeds.
+type AppHealth struct {
+	probe     AppHealthProbe
+	options   *healthCheckOptions
+	lock      sync.RWMutex
+	healthy   bool
+	healthyCh chan struct{}
+	listeners []func(healthy bool)
+}
+
+// NewAppHealth returns an app health monitor probing the app with probe. The app is healthy until probed otherwise.
+func NewAppHealth(probe AppHealthProbe, opts ...Option) *AppHealth {
+	options := &healthCheckOptions{}
+	applyDefaults(options)
+	for _, o := range opts {
+		o(options)
+	}
+
+	// healthyCh is closed while the app is healthy, to release the callers of WaitUntilHealthy
+	healthyCh := make(chan struct{})
+	close(healthyCh)
+	return &AppHealth{
+		probe:     probe,
+		options:   options,
+		healthy:   true,
+		healthyCh: healthyCh,
+	}
+}
+
+// OnHealthChange adds a listener called with the health of the app every time it changes
+func (h *AppHealth) OnHealthChange(listener func(healthy bool)) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.listeners = append(h.listeners, listener)
+}
+
+// IsHealthy returns whether the app is healthy
+func (h *AppHealth) IsHealthy() bool {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	return h.healthy
+}
+
+// WaitUntilHealthy blocks while the app is unhealthy, or until ctx is done
+func (h *AppHealth) WaitUntilHealthy(ctx context.Context) error {
+	h.lock.RLock()
+	healthyCh := h.healthyCh
+	h.lock.RUnlock()
+
+	select {
+	case <-healthyCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Start probes the app every interval after the initial delay, until ctx is done
+func (h *AppHealth) Start(ctx context.Context) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.options.initialDelay):
+		}
+
+		ticker := time.NewTicker(h.options.interval)
+		defer ticker.Stop()
+		failureCount := 0
+		for {
+			probeCtx, cancel := context.WithTimeout(ctx, h.options.requestTimeout)
+			err := h.probe(probeCtx)
+			cancel()
+
+			if err != nil {
+				failureCount++
+				log.Debugf("app health probe failed (%d/%d): %s", failureCount, h.options.failureThreshold, err)
+				if failureCount >= h.options.failureThreshold && h.setHealthy(false) {
+					log.Warnf("app is unhealthy: %s", err)
+				}
+			} else {
+				failureCount = 0
+				if h.setHealthy(true) {
+					log.Info("app is healthy again")
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// setHealthy sets the health of the app, notifying the listeners and returning true if it changed
+func (h *AppHealth) setHealthy(healthy bool) bool {
+	h.lock.Lock()
+	if h.healthy == healthy {
+		h.lock.Unlock()
+		return false
+	}
+	h.healthy = healthy
+	if healthy {
+		close(h.healthyCh)
+	} else {
+		h.healthyCh = make(chan struct{})
+	}
+	listeners := make([]func(bool), len(h.listeners))
+	copy(listeners, h.listeners)
+	h.lock.Unlock()
+
+	for _, listener := range listeners {
+		listener(healthy)
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppHealth(t *testing.T) {
+	var failing int32
+	probe := func(ctx context.Context) error {
+		if atomic.LoadInt32(&failing) == 1 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	h := NewAppHealth(probe, WithInitialDelay(0), WithInterval(time.Millisecond*10), WithFailureThreshold(3))
+	changes := make(chan bool, 2)
+	h.OnHealthChange(func(healthy bool) {
+		changes <- healthy
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.Start(ctx)
+
+	assert.True(t, h.IsHealthy())
+	assert.NoError(t, h.WaitUntilHealthy(context.Background()))
+
+	atomic.StoreInt32(&failing, 1)
+	select {
+	case healthy := <-changes:
+		assert.False(t, healthy)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "app didn't become unhealthy")
+	}
+	assert.False(t, h.IsHealthy())
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer waitCancel()
+	assert.Equal(t, context.DeadlineExceeded, h.WaitUntilHealthy(waitCtx))
+
+	released := make(chan error, 1)
+	go func() {
+		released <- h.WaitUntilHealthy(context.Background())
+	}()
+	atomic.StoreInt32(&failing, 0)
+	select {
+	case err := <-released:
+		assert.NoError(t, err)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "waiter wasn't released when the app became healthy")
+	}
+	assert.True(t, <-changes)
+	assert.True(t, h.IsHealthy())
+}
+
+func TestAppHealthFailureThreshold(t *testing.T) {
+	var probes int32
+	h := NewAppHealth(func(ctx context.Context) error {
+		// fail every other probe, never reaching a threshold of 2 consecutive failures
+		if atomic.AddInt32(&probes, 1)%2 == 0 {
+			return errors.New("timeout")
+		}
+		return nil
+	}, WithInitialDelay(0), WithInterval(time.Millisecond*5), WithFailureThreshold(2))
+	ctx, cancel := context.WithCancel(context.Background())
+	h.Start(ctx)
+
+	for atomic.LoadInt32(&probes) < 10 {
+		time.Sleep(time.Millisecond * 5)
+	}
+	cancel()
+	assert.True(t, h.IsHealthy())
+}
@@ -0,0 +1,208 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dapr/dapr/pkg/logger"
+)
+
+const (
+	// StatusHealthy is the status of a component that answered its last probe
+	StatusHealthy = "healthy"
+	// StatusUnhealthy is the status of a component that failed its last probe or isn't loaded
+	StatusUnhealthy = "unhealthy"
+	// StatusUnknown is the status of a component that can't be probed or hasn't been probed yet
+	StatusUnknown = "unknown"
+)
+
+var log = logger.NewLogger("dapr.runtime.health")
+
+// ComponentStatus is the health of a component as reported by the healthz endpoint
+type ComponentStatus struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	Status   string `json:"status"`
+	Required bool   `json:"required,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type componentProbe struct {
+	status   ComponentStatus
+	ping     func() error
+	inFlight int32
+}
+
+// ComponentsHealth periodically probes the registered components and reports
+// whether all the required components are healthy
+type ComponentsHealth struct {
+	interval   time.Duration
+	timeout    time.Duration
+	required   map[string]bool
+	lock       sync.RWMutex
+	components map[string]*componentProbe
+}
+
+// NewComponentsHealth returns a components health checker probing every interval, giving each
+// component timeout to answer. The required components must be healthy for Report to return ready.
+func NewComponentsHealth(interval, timeout time.Duration, required []string) *ComponentsHealth {
+	c := &ComponentsHealth{
+		interval:   interval,
+		timeout:    timeout,
+		required:   map[string]bool{},
+		components: map[string]*componentProbe{},
+	}
+	for _, name := range required {
+		c.required[name] = true
+	}
+	return c
+}
+
+// Register adds a component to probe with ping, replacing any component registered with the same type and name.
+// A nil ping registers a component that can't be probed, its status is reported as unknown and it is never
+// ready when it is required.
+func (c *ComponentsHealth) Register(name, componentType string, ping func() error) {
+	p := &componentProbe{
+		status: ComponentStatus{
+			Name:     name,
+			Type:     componentType,
+			Status:   StatusUnknown,
+			Required: c.required[name],
+		},
+		ping: ping,
+	}
+	if ping == nil && p.status.Required {
+		p.status.Error = "component doesn't support health probes"
+		log.Warnf("required component %s (%s) doesn't support health probes and is never reported ready", name, componentType)
+	}
+
+	c.lock.Lock()
+	c.components[componentKey(componentType, name)] = p
+	c.lock.Unlock()
+
+	c.probe(p)
+}
+
+func componentKey(componentType, name string) string {
+	return componentType + "/" + name
+}
+
+// Start probes the registered components every interval until ctx is done
+func (c *ComponentsHealth) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.lock.RLock()
+				probes := make([]*componentProbe, 0, len(c.components))
+				for _, p := range c.components {
+					probes = append(probes, p)
+				}
+				c.lock.RUnlock()
+
+				for _, p := range probes {
+					c.probe(p)
+				}
+			}
+		}
+	}()
+}
+
+// Report returns whether all the required components are healthy, and the status of every component sorted by name
+// and type. A required name applies to all the components with that name.
+func (c *ComponentsHealth) Report() (bool, []ComponentStatus) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	ready := true
+	loaded := map[string]bool{}
+	statuses := make([]ComponentStatus, 0, len(c.components))
+	for _, p := range c.components {
+		status := p.status
+		if status.Required && status.Status != StatusHealthy {
+			ready = false
+		}
+		loaded[status.Name] = true
+		statuses = append(statuses, status)
+	}
+	for name := range c.required {
+		if !loaded[name] {
+			ready = false
+			statuses = append(statuses, ComponentStatus{
+				Name:     name,
+				Status:   StatusUnhealthy,
+				Required: true,
+				Error:    "component is not loaded",
+			})
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Name != statuses[j].Name {
+			return statuses[i].Name < statuses[j].Name
+		}
+		return statuses[i].Type < statuses[j].Type
+	})
+	return ready, statuses
+}
+
+// probe pings the component in the background, skipping it while a previous ping hasn't returned
+func (c *ComponentsHealth) probe(p *componentProbe) {
+	if p.ping == nil || !atomic.CompareAndSwapInt32(&p.inFlight, 0, 1) {
+		return
+	}
+
+	go func() {
+		done := make(chan error, 1)
+		go func() {
+			done <- p.ping()
+			atomic.StoreInt32(&p.inFlight, 0)
+		}()
+
+		var err error
+		timer := time.NewTimer(c.timeout)
+		select {
+		case err = <-done:
+			timer.Stop()
+		case <-timer.C:
+			err = fmt.Errorf("probe timed out after %s", c.timeout)
+		}
+		c.setStatus(p, err)
+	}()
+}
+
+func (c *ComponentsHealth) setStatus(p *componentProbe, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	previous := p.status.Status
+	if err != nil {
+		p.status.Status = StatusUnhealthy
+		p.status.Error = err.Error()
+	} else {
+		p.status.Status = StatusHealthy
+		p.status.Error = ""
+	}
+
+	if previous == p.status.Status {
+		return
+	}
+	if err != nil {
+		log.Warnf("component %s (%s) is unhealthy: %s", p.status.Name, p.status.Type, err)
+	} else if previous == StatusUnhealthy {
+		log.Infof("component %s (%s) is healthy again", p.status.Name, p.status.Type)
+	}
+}
@@ -0,0 +1,116 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForReport(t *testing.T, c *ComponentsHealth, cond func(bool, []ComponentStatus) bool) (bool, []ComponentStatus) {
+	deadline := time.Now().Add(time.Second * 2)
+	for {
+		ready, statuses := c.Report()
+		if cond(ready, statuses) || time.Now().After(deadline) {
+			return ready, statuses
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func TestComponentsHealth(t *testing.T) {
+	t.Run("required component not loaded", func(t *testing.T) {
+		c := NewComponentsHealth(time.Second, time.Second, []string{"statestore"})
+		ready, statuses := c.Report()
+		assert.False(t, ready)
+		assert.Equal(t, []ComponentStatus{{Name: "statestore", Status: StatusUnhealthy, Required: true, Error: "component is not loaded"}}, statuses)
+	})
+
+	t.Run("components sorted with their status", func(t *testing.T) {
+		c := NewComponentsHealth(time.Second, time.Second, []string{"statestore"})
+		c.Register("statestore", "state.redis", func() error { return nil })
+		c.Register("pubsub", "pubsub.redis", func() error { return errors.New("connection refused") })
+		c.Register("secrets", "secretstores.local.file", nil)
+
+		ready, statuses := waitForReport(t, c, func(_ bool, s []ComponentStatus) bool {
+			return s[0].Status != StatusUnknown && s[2].Status != StatusUnknown
+		})
+		assert.True(t, ready)
+		assert.Equal(t, []ComponentStatus{
+			{Name: "pubsub", Type: "pubsub.redis", Status: StatusUnhealthy, Error: "connection refused"},
+			{Name: "secrets", Type: "secretstores.local.file", Status: StatusUnknown},
+			{Name: "statestore", Type: "state.redis", Status: StatusHealthy, Required: true},
+		}, statuses)
+	})
+
+	t.Run("required component can't be probed", func(t *testing.T) {
+		c := NewComponentsHealth(time.Second, time.Second, []string{"secrets"})
+		c.Register("secrets", "secretstores.local.file", nil)
+
+		ready, statuses := c.Report()
+		assert.False(t, ready)
+		assert.Equal(t, []ComponentStatus{
+			{Name: "secrets", Type: "secretstores.local.file", Status: StatusUnknown, Required: true, Error: "component doesn't support health probes"},
+		}, statuses)
+	})
+
+	t.Run("components with the same name", func(t *testing.T) {
+		c := NewComponentsHealth(time.Second, time.Second, []string{"redis"})
+		c.Register("redis", "state.redis", func() error { return nil })
+		c.Register("redis", "pubsub.redis", func() error { return errors.New("connection refused") })
+
+		ready, statuses := waitForReport(t, c, func(_ bool, s []ComponentStatus) bool {
+			return s[0].Status != StatusUnknown && s[1].Status != StatusUnknown
+		})
+		assert.False(t, ready)
+		assert.Equal(t, []ComponentStatus{
+			{Name: "redis", Type: "pubsub.redis", Status: StatusUnhealthy, Required: true, Error: "connection refused"},
+			{Name: "redis", Type: "state.redis", Status: StatusHealthy, Required: true},
+		}, statuses)
+	})
+
+	t.Run("required component becomes unhealthy", func(t *testing.T) {
+		var healthy int32 = 1
+		c := NewComponentsHealth(time.Millisecond*20, time.Second, []string{"statestore"})
+		c.Register("statestore", "state.redis", func() error {
+			if atomic.LoadInt32(&healthy) == 1 {
+				return nil
+			}
+			return errors.New("connection refused")
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c.Start(ctx)
+
+		ready, _ := waitForReport(t, c, func(ready bool, _ []ComponentStatus) bool { return ready })
+		assert.True(t, ready)
+
+		atomic.StoreInt32(&healthy, 0)
+		ready, statuses := waitForReport(t, c, func(ready bool, _ []ComponentStatus) bool { return !ready })
+		assert.False(t, ready)
+		assert.Equal(t, "connection refused", statuses[0].Error)
+	})
+
+	t.Run("probe times out", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+		c := NewComponentsHealth(time.Second, time.Millisecond*20, []string{"statestore"})
+		c.Register("statestore", "state.redis", func() error {
+			<-block
+			return nil
+		})
+
+		ready, statuses := waitForReport(t, c, func(_ bool, s []ComponentStatus) bool { return s[0].Status != StatusUnknown })
+		assert.False(t, ready)
+		assert.Equal(t, StatusUnhealthy, statuses[0].Status)
+		assert.Contains(t, statuses[0].Error, "timed out")
+	})
+}
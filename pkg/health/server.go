@@ -7,13 +7,17 @@ package health
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dapr/dapr/pkg/logger"
 )
 
+const loggersPath = "/loggers"
+
 // Server is the interface for the healthz server
 type Server interface {
 	Run(context.Context, int) error
@@ -24,12 +28,15 @@ type Server interface {
 type server struct {
 	ready bool
 	log   logger.Logger
+	// levelUpdatesEnabled allows setting log levels with the loggers endpoint
+	levelUpdatesEnabled bool
 }
 
 // NewServer returns a new healthz server
 func NewServer(log logger.Logger) Server {
 	return &server{
-		log: log,
+		log:                 log,
+		levelUpdatesEnabled: logger.LevelUpdatesEnabled(),
 	}
 }
 
@@ -47,6 +54,8 @@ func (s *server) NotReady() {
 func (s *server) Run(ctx context.Context, port int) error {
 	router := http.NewServeMux()
 	router.Handle("/healthz", s.healthz())
+	router.Handle(loggersPath, s.loggers())
+	router.Handle(loggersPath+"/", s.loggers())
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
@@ -90,3 +99,41 @@ func (s *server) healthz() http.Handler {
 		w.WriteHeader(status)
 	})
 }
+
+// loggers is the admin endpoint handler to list the loggers with GET /loggers, and to set the
+// log level of a logger scope with PUT /loggers/{scope} and a {"level": "debug"} body.
+// The endpoint isn't authenticated, so setting log levels is forbidden unless --log-level-updates is set.
+func (s *server) loggers() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, loggersPath), "/")
+		switch {
+		case r.Method == http.MethodGet && scope == "":
+			b, err := json.Marshal(logger.ListScopeLevels())
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(b) // nolint: errcheck
+		case r.Method == http.MethodPut && scope != "":
+			if !s.levelUpdatesEnabled {
+				http.Error(w, "log level updates are disabled, set --log-level-updates to enable them", http.StatusForbidden)
+				return
+			}
+
+			var req logger.ScopeLevel
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, fmt.Sprintf("can't deserialize request: %s", err), http.StatusBadRequest)
+				return
+			}
+			if err := logger.SetScopeLevel(scope, string(req.Level)); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			s.log.Infof("log level of scope %s set to %s", scope, req.Level)
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggersEndpoint(t *testing.T) {
+	log := logger.NewLogger("dapr.test.health")
+	nested := logger.NewLogger("dapr.test.health.nested")
+	log.SetOutputLevel(logger.InfoLevel)
+	nested.SetOutputLevel(logger.InfoLevel)
+	handler := (&server{log: log, levelUpdatesEnabled: true}).loggers()
+
+	t.Run("list loggers", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/loggers", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var levels []logger.ScopeLevel
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &levels))
+		assert.Contains(t, levels, logger.ScopeLevel{Scope: "dapr.test.health", Level: logger.InfoLevel})
+	})
+
+	t.Run("set scope level", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/loggers/dapr.test.health", strings.NewReader(`{"level": "debug"}`)))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, logger.DebugLevel, log.OutputLevel())
+		assert.Equal(t, logger.DebugLevel, nested.OutputLevel())
+	})
+
+	t.Run("undefined level", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/loggers/dapr.test.health", strings.NewReader(`{"level": "verbose"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/loggers/dapr.unknown", strings.NewReader(`{"level": "debug"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("level updates disabled", func(t *testing.T) {
+		log.SetOutputLevel(logger.InfoLevel)
+		w := httptest.NewRecorder()
+		readOnly := NewServer(log).(*server).loggers()
+		readOnly.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/loggers/dapr.test.health", strings.NewReader(`{"level": "debug"}`)))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, logger.InfoLevel, log.OutputLevel())
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/loggers/dapr.test.health", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
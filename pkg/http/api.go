@@ -6,11 +6,15 @@
 package http
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	net_http "net/http"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/pubsub"
@@ -19,17 +23,26 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/channel/http"
+	"github.com/dapr/dapr/pkg/components"
+	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
+	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
 	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/health"
+	"github.com/dapr/dapr/pkg/logger"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/version"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"github.com/valyala/fasthttp"
+	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 )
 
@@ -40,26 +53,53 @@ type API interface {
 }
 
 type api struct {
-	endpoints             []Endpoint
-	directMessaging       messaging.DirectMessaging
-	appChannel            channel.AppChannel
-	stateStores           map[string]state.Store
-	secretStores          map[string]secretstores.SecretStore
-	secretsConfiguration  map[string]config.SecretsScope
-	json                  jsoniter.API
-	actor                 actors.Actors
-	publishFn             func(req *pubsub.PublishRequest) error
-	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
-	id                    string
-	extendedMetadata      sync.Map
-	readyStatus           bool
-	tracingSpec           config.TracingSpec
+	endpoints                   []Endpoint
+	directMessaging             messaging.DirectMessaging
+	appChannel                  channel.AppChannel
+	stateStores                 map[string]state.Store
+	secretStores                map[string]secretstores.SecretStore
+	secretsConfiguration        map[string]config.SecretsScope
+	bindingsConfiguration       map[string]config.BindingsScope
+	json                        jsoniter.API
+	actor                       actors.Actors
+	publishFn                   func(req *pubsub.PublishRequest) error
+	sendToOutputBindingFn       func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	sendToOutputBindingStreamFn func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error)
+	id                          string
+	extendedMetadata            sync.Map
+	readyStatus                 bool
+	tracingSpec                 config.TracingSpec
+	accessControlList           *config.AccessControlList
+	namespace                   string
+	componentsHealth            *health.ComponentsHealth
+	getComponentsFn             func() []components.RegisteredComponent
+	getSubscriptionsFn          func() []runtime_pubsub.Subscription
+	configurationName           string
+	// levelUpdatesEnabled allows setting log levels with the loggers endpoint
+	levelUpdatesEnabled bool
+}
+
+type healthzResponse struct {
+	ErrorCode  string                   `json:"errorCode,omitempty"`
+	Message    string                   `json:"message,omitempty"`
+	Components []health.ComponentStatus `json:"components"`
 }
 
 type metadata struct {
-	ID                string                      `json:"id"`
-	ActiveActorsCount []actors.ActiveActorsCount  `json:"actors"`
-	Extended          map[interface{}]interface{} `json:"extended"`
+	ID                   string                           `json:"id"`
+	RuntimeVersion       string                           `json:"runtimeVersion"`
+	ConfigurationName    string                           `json:"configurationName"`
+	ActiveActorsCount    []actors.ActiveActorsCount       `json:"actors"`
+	ActorRuntime         *actors.ActorRuntimeMetadata     `json:"actorRuntime,omitempty"`
+	RegisteredComponents []components.RegisteredComponent `json:"components"`
+	Subscriptions        []metadataSubscription           `json:"subscriptions"`
+	Extended             map[interface{}]interface{}      `json:"extended"`
+}
+
+type metadataSubscription struct {
+	PubsubName string `json:"pubsubname"`
+	Topic      string `json:"topic"`
+	Route      string `json:"route"`
 }
 
 const (
@@ -73,13 +113,24 @@ const (
 	stateKeyParam        = "key"
 	secretStoreNameParam = "secretStoreName"
 	secretNameParam      = "key"
+	secretPrefixParam    = "prefix"
+	operationParam       = "operation"
 	nameParam            = "name"
 	consistencyParam     = "consistency"
 	concurrencyParam     = "concurrency"
+	appIDParam           = "appId"
+	namespaceParam       = "namespace"
+	trustDomainParam     = "trustDomain"
+	verbParam            = "verb"
+	protocolParam        = "protocol"
+	claimParamPrefix     = "claim."
 	daprSeparator        = "||"
 	pubsubnameparam      = "pubsubname"
-	traceparentHeader    = "traceparent"
-	tracestateHeader     = "tracestate"
+	scopeParam           = "scope"
+	// streamHeader opts service invocation requests in to streaming the response body
+	streamHeader = "dapr-stream"
+	// requestTimeoutHeader sets the timeout of service invocation requests, as a duration string such as "30s"
+	requestTimeoutHeader = "dapr-request-timeout"
 )
 
 // NewAPI returns a new API
@@ -90,22 +141,39 @@ func NewAPI(
 	stateStores map[string]state.Store,
 	secretStores map[string]secretstores.SecretStore,
 	secretsConfiguration map[string]config.SecretsScope,
+	bindingsConfiguration map[string]config.BindingsScope,
 	publishFn func(*pubsub.PublishRequest) error,
 	actor actors.Actors,
 	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
-	tracingSpec config.TracingSpec) API {
+	sendToOutputBindingStreamFn func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error),
+	tracingSpec config.TracingSpec,
+	accessControlList *config.AccessControlList,
+	namespace string,
+	componentsHealth *health.ComponentsHealth,
+	getComponentsFn func() []components.RegisteredComponent,
+	getSubscriptionsFn func() []runtime_pubsub.Subscription,
+	configurationName string) API {
 	api := &api{
-		appChannel:            appChannel,
-		directMessaging:       directMessaging,
-		stateStores:           stateStores,
-		secretStores:          secretStores,
-		secretsConfiguration:  secretsConfiguration,
-		json:                  jsoniter.ConfigFastest,
-		actor:                 actor,
-		publishFn:             publishFn,
-		sendToOutputBindingFn: sendToOutputBindingFn,
-		id:                    appID,
-		tracingSpec:           tracingSpec,
+		appChannel:                  appChannel,
+		directMessaging:             directMessaging,
+		stateStores:                 stateStores,
+		secretStores:                secretStores,
+		secretsConfiguration:        secretsConfiguration,
+		bindingsConfiguration:       bindingsConfiguration,
+		json:                        jsoniter.ConfigFastest,
+		actor:                       actor,
+		publishFn:                   publishFn,
+		sendToOutputBindingFn:       sendToOutputBindingFn,
+		sendToOutputBindingStreamFn: sendToOutputBindingStreamFn,
+		id:                          appID,
+		tracingSpec:                 tracingSpec,
+		accessControlList:           accessControlList,
+		namespace:                   namespace,
+		componentsHealth:            componentsHealth,
+		getComponentsFn:             getComponentsFn,
+		getSubscriptionsFn:          getSubscriptionsFn,
+		configurationName:           configurationName,
+		levelUpdatesEnabled:         logger.LevelUpdatesEnabled(),
 	}
 	api.endpoints = append(api.endpoints, api.constructStateEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSecretEndpoints()...)
@@ -115,6 +183,8 @@ func NewAPI(
 	api.endpoints = append(api.endpoints, api.constructMetadataEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructHealthzEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructAccessControlEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructLoggersEndpoints()...)
 
 	return api
 }
@@ -166,6 +236,12 @@ func (a *api) constructStateEndpoints() []Endpoint {
 
 func (a *api) constructSecretEndpoints() []Endpoint {
 	return []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "secrets/{secretStoreName}",
+			Version: apiVersionV1,
+			Handler: a.onBulkGetSecret,
+		},
 		{
 			Methods: []string{fasthttp.MethodGet},
 			Route:   "secrets/{secretStoreName}/{key}",
@@ -194,16 +270,24 @@ func (a *api) constructBindingsEndpoints() []Endpoint {
 			Version: apiVersionV1,
 			Handler: a.onOutputBindingMessage,
 		},
+		{
+			Methods:   []string{fasthttp.MethodPost, fasthttp.MethodPut},
+			Route:     "bindings/{name}/stream",
+			Version:   apiVersionV1,
+			Handler:   a.onOutputBindingStream,
+			Streaming: true,
+		},
 	}
 }
 
 func (a *api) constructDirectMessagingEndpoints() []Endpoint {
 	return []Endpoint{
 		{
-			Methods: []string{fasthttp.MethodGet, fasthttp.MethodPost, fasthttp.MethodDelete, fasthttp.MethodPut},
-			Route:   "invoke/{id}/method/{method:*}",
-			Version: apiVersionV1,
-			Handler: a.onDirectMessage,
+			Methods:   []string{fasthttp.MethodGet, fasthttp.MethodPost, fasthttp.MethodDelete, fasthttp.MethodPut},
+			Route:     "invoke/{id}/method/{method:*}",
+			Version:   apiVersionV1,
+			Handler:   a.onDirectMessage,
+			Streaming: true,
 		},
 	}
 }
@@ -278,6 +362,34 @@ func (a *api) constructMetadataEndpoints() []Endpoint {
 	}
 }
 
+func (a *api) constructAccessControlEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "accesscontrol/explain",
+			Version: apiVersionV1,
+			Handler: a.onExplainAccessControl,
+		},
+	}
+}
+
+func (a *api) constructLoggersEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "loggers",
+			Version: apiVersionV1,
+			Handler: a.onGetLoggers,
+		},
+		{
+			Methods: []string{fasthttp.MethodPut},
+			Route:   "loggers/{scope}",
+			Version: apiVersionV1,
+			Handler: a.onPutLoggerLevel,
+		},
+	}
+}
+
 func (a *api) constructHealthzEndpoints() []Endpoint {
 	return []Endpoint{
 		{
@@ -298,7 +410,11 @@ func (a *api) onOutputBindingMessage(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_INVOKE_OUTPUT_BINDING", fmt.Sprintf("can't deserialize request: %s", err))
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	if !a.isBindingOperationAllowed(reqCtx, name, req.Operation) {
 		return
 	}
 
@@ -306,20 +422,13 @@ func (a *api) onOutputBindingMessage(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_INVOKE_OUTPUT_BINDING", fmt.Sprintf("can't deserialize request data field: %s", err))
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
 	// pass the trace context to output binding in metadata
 	if span := diag_utils.SpanFromContext(reqCtx); span != nil {
-		sc := span.SpanContext()
-		if req.Metadata == nil {
-			req.Metadata = map[string]string{}
-		}
-		req.Metadata[traceparentHeader] = diag.SpanContextToW3CString(sc)
-		if sc.Tracestate != nil {
-			req.Metadata[tracestateHeader] = diag.TraceStateToW3CString(sc)
-		}
+		req.Metadata = diag.SpanContextToBindingMetadata(span.SpanContext(), req.Metadata)
 	}
 
 	resp, err := a.sendToOutputBindingFn(name, &bindings.InvokeRequest{
@@ -331,7 +440,7 @@ func (a *api) onOutputBindingMessage(reqCtx *fasthttp.RequestCtx) {
 		errMsg := fmt.Sprintf("error invoking output binding %s: %s", name, err)
 		msg := NewErrorResponse("ERR_INVOKE_OUTPUT_BINDING", errMsg)
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 	if resp == nil {
@@ -341,10 +450,61 @@ func (a *api) onOutputBindingMessage(reqCtx *fasthttp.RequestCtx) {
 	}
 }
 
+// onOutputBindingStream invokes an output binding with the raw request body as payload, and writes the
+// binding response as a chunked body. The operation and the binding metadata are passed as query parameters.
+// Chunked request bodies are accepted, but are read in full by the server, within its request body size limit.
+func (a *api) onOutputBindingStream(reqCtx *fasthttp.RequestCtx) {
+	name := reqCtx.UserValue(nameParam).(string)
+	operation := string(reqCtx.QueryArgs().Peek(operationParam))
+	if !a.isBindingOperationAllowed(reqCtx, name, operation) {
+		return
+	}
+	metadata := getMetadataFromRequest(reqCtx)
+
+	// pass the trace context to output binding in metadata
+	if span := diag_utils.SpanFromContext(reqCtx); span != nil {
+		metadata = diag.SpanContextToBindingMetadata(span.SpanContext(), metadata)
+	}
+
+	resp, err := a.sendToOutputBindingStreamFn(name, &bindings_loader.StreamInvokeRequest{
+		Data:      requestBodyStream(reqCtx),
+		Metadata:  metadata,
+		Operation: bindings.OperationKind(operation),
+	})
+	if err != nil {
+		errMsg := fmt.Sprintf("error invoking output binding %s: %s", name, err)
+		msg := NewErrorResponse("ERR_INVOKE_OUTPUT_BINDING", errMsg)
+		respondWithError(reqCtx, 500, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+	if resp == nil || resp.Data == nil {
+		respondEmpty(reqCtx, 200)
+		return
+	}
+
+	for k, v := range resp.Metadata {
+		reqCtx.Response.Header.Set(k, v)
+	}
+	reqCtx.Response.Header.SetContentType("application/octet-stream")
+	reqCtx.Response.SetStatusCode(200)
+	// a negative size makes fasthttp write the body with chunked transfer encoding, and close the stream once done
+	reqCtx.Response.SetBodyStream(resp.Data, -1)
+}
+
+// requestBodyStream returns a reader of the request body, streaming it from the connection when it's
+// larger than the max request body size
+func requestBodyStream(reqCtx *fasthttp.RequestCtx) io.Reader {
+	if reqCtx.Request.IsBodyStream() {
+		return reqCtx.RequestBodyStream()
+	}
+	return bytes.NewReader(reqCtx.PostBody())
+}
+
 func (a *api) onBulkGetState(reqCtx *fasthttp.RequestCtx) {
-	store, err := a.getStateStoreWithRequestValidation(reqCtx)
+	store, err := a.getStateStoreWithRequestValidation(reqCtx, config.StateGetOperation)
 	if err != nil {
-		log.Debug(err)
+		log.WithContext(reqCtx).Debug(err)
 		return
 	}
 
@@ -353,7 +513,7 @@ func (a *api) onBulkGetState(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -375,7 +535,7 @@ func (a *api) onBulkGetState(reqCtx *fasthttp.RequestCtx) {
 
 			resp, err := store.Get(gr)
 			if err != nil {
-				log.Debugf("bulk get: error getting key %s: %s", param.(string), err)
+				log.WithContext(reqCtx).Debugf("bulk get: error getting key %s: %s", param.(string), err)
 				r.Error = err.Error()
 			} else if resp != nil {
 				r.Data = jsoniter.RawMessage(resp.Data)
@@ -392,11 +552,11 @@ func (a *api) onBulkGetState(reqCtx *fasthttp.RequestCtx) {
 	respondWithJSON(reqCtx, 200, b)
 }
 
-func (a *api) getStateStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (state.Store, error) {
+func (a *api) getStateStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx, operation string) (state.Store, error) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		msg := NewErrorResponse("ERR_STATE_STORE_NOT_CONFIGURED", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return nil, errors.New(msg.Message)
 	}
 
@@ -405,16 +565,34 @@ func (a *api) getStateStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (s
 	if a.stateStores[storeName] == nil {
 		msg := NewErrorResponse("ERR_STATE_STORE_NOT_FOUND", fmt.Sprintf("state store name: %s", storeName))
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return nil, errors.New(msg.Message)
 	}
+
+	if err := a.checkStateOperationAllowed(reqCtx, storeName, operation); err != nil {
+		return nil, err
+	}
 	return a.stateStores[storeName], nil
 }
 
+// checkStateOperationAllowed applies the access control policies of the local app, if any, to the operation on the state store.
+func (a *api) checkStateOperationAllowed(reqCtx *fasthttp.RequestCtx, storeName, operation string) error {
+	spiffeID := a.accessControlList.LocalSpiffeID(a.id, a.namespace)
+	if allowed, _ := config.IsBuildingBlockOperationAllowedByAccessControlPolicy(spiffeID, config.StateBuildingBlock, storeName+"/"+operation, a.accessControlList); !allowed {
+		msg := NewErrorResponse(
+			"ERR_PERMISSION_DENIED",
+			fmt.Sprintf("Access denied by policy to %s state in %s", operation, storeName))
+		respondWithError(reqCtx, net_http.StatusForbidden, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return errors.New(msg.Message)
+	}
+	return nil
+}
+
 func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
-	store, err := a.getStateStoreWithRequestValidation(reqCtx)
+	store, err := a.getStateStoreWithRequestValidation(reqCtx, config.StateGetOperation)
 	if err != nil {
-		log.Debug(err)
+		log.WithContext(reqCtx).Debug(err)
 		return
 	}
 
@@ -434,7 +612,7 @@ func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_GET", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 	if resp == nil || resp.Data == nil {
@@ -445,9 +623,9 @@ func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
 }
 
 func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
-	store, err := a.getStateStoreWithRequestValidation(reqCtx)
+	store, err := a.getStateStoreWithRequestValidation(reqCtx, config.StateDeleteOperation)
 	if err != nil {
-		log.Debug(err)
+		log.WithContext(reqCtx).Debug(err)
 		return
 	}
 
@@ -473,18 +651,18 @@ func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_DELETE", fmt.Sprintf("failed deleting state with key %s: %s", key, err))
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 	respondEmpty(reqCtx, 200)
 }
 
-func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
+func (a *api) getSecretStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (string, secretstores.SecretStore, error) {
 	if a.secretStores == nil || len(a.secretStores) == 0 {
 		msg := NewErrorResponse("ERR_SECRET_STORE_NOT_CONFIGURED", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
-		return
+		log.WithContext(reqCtx).Debug(msg)
+		return "", nil, errors.New(msg.Message)
 	}
 
 	secretStoreName := reqCtx.UserValue(secretStoreNameParam).(string)
@@ -492,7 +670,16 @@ func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
 	if a.secretStores[secretStoreName] == nil {
 		msg := NewErrorResponse("ERR_SECRET_STORE_NOT_FOUND", fmt.Sprintf("secret store name: %s", secretStoreName))
 		respondWithError(reqCtx, 401, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return "", nil, errors.New(msg.Message)
+	}
+	return secretStoreName, a.secretStores[secretStoreName], nil
+}
+
+func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
+	secretStoreName, store, err := a.getSecretStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.WithContext(reqCtx).Debug(err)
 		return
 	}
 
@@ -513,11 +700,11 @@ func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
 		Metadata: metadata,
 	}
 
-	resp, err := a.secretStores[secretStoreName].GetSecret(req)
+	resp, err := store.GetSecret(req)
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_GET", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -530,10 +717,52 @@ func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
 	respondWithJSON(reqCtx, 200, respBytes)
 }
 
+func (a *api) onBulkGetSecret(reqCtx *fasthttp.RequestCtx) {
+	// the request validation already logs and responds with the error
+	secretStoreName, store, err := a.getSecretStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		return
+	}
+
+	bulkStore, ok := store.(secretstores_loader.BulkSecretStore)
+	if !ok {
+		msg := NewErrorResponse(
+			"ERR_SECRET_STORE_NOT_SUPPORTED",
+			fmt.Sprintf("secret store %s does not support bulk retrieval", secretStoreName))
+		respondWithError(reqCtx, net_http.StatusNotImplemented, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	metadata := getMetadataFromRequest(reqCtx)
+	prefix := string(reqCtx.QueryArgs().Peek(secretPrefixParam))
+
+	resp, err := bulkStore.BulkGetSecret(secretstores_loader.BulkGetSecretRequest{
+		Metadata: metadata,
+	})
+	if err != nil {
+		msg := NewErrorResponse("ERR_SECRET_GET", err.Error())
+		respondWithError(reqCtx, 500, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	// Only return the secrets the app is allowed to access by the secrets scope policy.
+	filtered := map[string]map[string]string{}
+	for key, v := range resp.Data {
+		if strings.HasPrefix(key, prefix) && a.isSecretAllowed(secretStoreName, key) {
+			filtered[key] = v
+		}
+	}
+
+	respBytes, _ := a.json.Marshal(filtered)
+	respondWithJSON(reqCtx, 200, respBytes)
+}
+
 func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
-	store, err := a.getStateStoreWithRequestValidation(reqCtx)
+	store, err := a.getStateStoreWithRequestValidation(reqCtx, config.StateSaveOperation)
 	if err != nil {
-		log.Debug(err)
+		log.WithContext(reqCtx).Debug(err)
 		return
 	}
 
@@ -542,7 +771,7 @@ func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -554,7 +783,7 @@ func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_SAVE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -576,7 +805,33 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 	if invokeMethodName == "" {
 		msg := NewErrorResponse("ERR_DIRECT_INVOKE", "invalid method name")
 		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	timeout, err := getRequestTimeout(reqCtx)
+	if err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	// the deadline is honoured by the app channel and propagated to the Dapr instance of the target app
+	var ctx context.Context = reqCtx
+	cancel := func() {}
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(trace.NewContext(reqCtx, diag_utils.SpanFromContext(reqCtx)), timeout)
+	}
+
+	if strings.EqualFold(string(reqCtx.Request.Header.Peek(streamHeader)), "true") {
+		reqCtx.Request.Header.Del(streamHeader)
+		a.onDirectMessageStream(ctx, cancel, reqCtx, targetID, verb, invokeMethodName)
+		return
+	}
+	defer cancel()
+
+	if !bufferRequestBody(reqCtx) {
 		return
 	}
 
@@ -586,7 +841,7 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 	// Save headers to internal metadata
 	req.WithFastHTTPHeaders(&reqCtx.Request.Header)
 
-	resp, err := a.directMessaging.Invoke(reqCtx, targetID, req)
+	resp, err := a.directMessaging.Invoke(ctx, targetID, req)
 	// err does not represent user application response
 	if err != nil {
 		msg := NewErrorResponse("ERR_DIRECT_INVOKE", err.Error())
@@ -607,6 +862,94 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 	respond(reqCtx, statusCode, body)
 }
 
+// getRequestTimeout returns the timeout set by the dapr-request-timeout header, or 0 if it isn't set.
+// The header is removed from the request so it isn't forwarded to the app.
+func getRequestTimeout(reqCtx *fasthttp.RequestCtx) (time.Duration, error) {
+	timeoutHeader := reqCtx.Request.Header.Peek(requestTimeoutHeader)
+	if len(timeoutHeader) == 0 {
+		return 0, nil
+	}
+	reqCtx.Request.Header.Del(requestTimeoutHeader)
+
+	timeout, err := time.ParseDuration(string(timeoutHeader))
+	if err != nil || timeout <= 0 {
+		return 0, errors.Errorf("invalid %s header: %s", requestTimeoutHeader, timeoutHeader)
+	}
+	return timeout, nil
+}
+
+// onDirectMessageStream invokes the target app streaming the request body to it and writing its response
+// body as a chunked body as it is received, which allows for server-sent events and long-poll responses.
+// The response body is written after the handler returns, so the call, bound to ctx and canceled with cancel,
+// ends when fasthttp closes the response body, once it's written or when writing it to the caller fails.
+func (a *api) onDirectMessageStream(ctx context.Context, cancel context.CancelFunc, reqCtx *fasthttp.RequestCtx, targetID, verb, invokeMethodName string) {
+	req := invokev1.NewInvokeMethodRequest(invokeMethodName).WithHTTPExtension(verb, reqCtx.QueryArgs().String())
+	req.WithRawData(nil, string(reqCtx.Request.Header.ContentType()))
+	req.WithFastHTTPHeaders(&reqCtx.Request.Header)
+
+	ctx, cancelStream := context.WithCancel(trace.NewContext(ctx, diag_utils.SpanFromContext(reqCtx)))
+	reqBody := &detachableReader{r: requestBodyStream(reqCtx)}
+	done := func() {
+		reqBody.detach()
+		cancelStream()
+		cancel()
+	}
+
+	resp, body, err := a.directMessaging.InvokeStream(ctx, targetID, req, reqBody)
+	// err does not represent user application response
+	if err != nil {
+		done()
+		msg := NewErrorResponse("ERR_DIRECT_INVOKE", err.Error())
+		respondWithError(reqCtx, fasthttp.StatusInternalServerError, msg)
+		return
+	}
+
+	invokev1.InternalMetadataToHTTPHeader(reqCtx, resp.Headers(), reqCtx.Response.Header.Set)
+	reqCtx.Response.Header.SetContentType(resp.Message().GetContentType())
+
+	statusCode := int(resp.Status().Code)
+	if !resp.IsHTTPResponse() {
+		statusCode = invokev1.HTTPStatusFromCode(codes.Code(statusCode))
+	}
+	reqCtx.Response.SetStatusCode(statusCode)
+	// fasthttp writes the body with chunked transfer encoding and closes it once done
+	reqCtx.Response.SetBodyStream(&streamedResponseBody{ReadCloser: body, done: done}, -1)
+}
+
+// detachableReader reads the request body stream until it's detached from the request, which fasthttp
+// reuses for the next request of the connection once the response is written
+type detachableReader struct {
+	lock sync.Mutex
+	r    io.Reader
+}
+
+func (d *detachableReader) Read(p []byte) (int, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.r == nil {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return d.r.Read(p)
+}
+
+func (d *detachableReader) detach() {
+	d.lock.Lock()
+	d.r = nil
+	d.lock.Unlock()
+}
+
+// streamedResponseBody calls done once fasthttp closes the response body
+type streamedResponseBody struct {
+	io.ReadCloser
+	done func()
+}
+
+func (b *streamedResponseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+	return err
+}
+
 func (a *api) onCreateActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
@@ -623,7 +966,7 @@ func (a *api) onCreateActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -635,7 +978,7 @@ func (a *api) onCreateActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_REMINDER_CREATE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 200)
 	}
@@ -645,7 +988,7 @@ func (a *api) onCreateActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -658,7 +1001,7 @@ func (a *api) onCreateActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -670,7 +1013,7 @@ func (a *api) onCreateActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_TIMER_CREATE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 200)
 	}
@@ -680,7 +1023,7 @@ func (a *api) onDeleteActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -698,7 +1041,7 @@ func (a *api) onDeleteActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_REMINDER_DELETE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 200)
 	}
@@ -708,7 +1051,7 @@ func (a *api) onActorStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -724,7 +1067,7 @@ func (a *api) onActorStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if !hosted {
 		msg := NewErrorResponse("ERR_ACTOR_INSTANCE_MISSING", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -733,7 +1076,7 @@ func (a *api) onActorStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -747,7 +1090,7 @@ func (a *api) onActorStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_STATE_TRANSACTION_SAVE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 201)
 	}
@@ -757,7 +1100,7 @@ func (a *api) onGetActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -773,13 +1116,13 @@ func (a *api) onGetActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_REMINDER_GET", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	}
 	b, err := a.json.Marshal(resp)
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_REMINDER_GET", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondWithJSON(reqCtx, 200, b)
 	}
@@ -789,7 +1132,7 @@ func (a *api) onDeleteActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -806,7 +1149,7 @@ func (a *api) onDeleteActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_TIMER_DELETE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 200)
 	}
@@ -816,7 +1159,7 @@ func (a *api) onDirectActorMessage(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -842,7 +1185,7 @@ func (a *api) onDirectActorMessage(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_INVOKE_METHOD", err.Error())
 		respondWithError(reqCtx, fasthttp.StatusInternalServerError, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -862,7 +1205,7 @@ func (a *api) onGetActorState(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -878,7 +1221,7 @@ func (a *api) onGetActorState(reqCtx *fasthttp.RequestCtx) {
 	if !hosted {
 		msg := NewErrorResponse("ERR_ACTOR_INSTANCE_MISSING", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -892,7 +1235,7 @@ func (a *api) onGetActorState(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_ACTOR_STATE_GET", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		if resp == nil || resp.Data == nil {
 			respondEmpty(reqCtx, 204)
@@ -912,21 +1255,99 @@ func (a *api) onGetMetadata(reqCtx *fasthttp.RequestCtx) {
 	})
 
 	mtd := metadata{
-		ID:                a.id,
-		ActiveActorsCount: a.actor.GetActiveActorsCount(reqCtx),
-		Extended:          temp,
+		ID:                   a.id,
+		RuntimeVersion:       version.Version(),
+		ConfigurationName:    a.configurationName,
+		ActiveActorsCount:    []actors.ActiveActorsCount{},
+		RegisteredComponents: []components.RegisteredComponent{},
+		Subscriptions:        []metadataSubscription{},
+		Extended:             temp,
+	}
+	if a.actor != nil {
+		mtd.ActiveActorsCount = a.actor.GetActiveActorsCount(reqCtx)
+		actorRuntime := a.actor.GetActorRuntimeMetadata()
+		mtd.ActorRuntime = &actorRuntime
+	}
+	if a.getComponentsFn != nil {
+		mtd.RegisteredComponents = a.getComponentsFn()
+	}
+	if a.getSubscriptionsFn != nil {
+		for _, s := range a.getSubscriptionsFn() {
+			mtd.Subscriptions = append(mtd.Subscriptions, metadataSubscription{
+				PubsubName: s.PubsubName,
+				Topic:      s.Topic,
+				Route:      s.Route,
+			})
+		}
 	}
 
 	mtdBytes, err := a.json.Marshal(mtd)
 	if err != nil {
 		msg := NewErrorResponse("ERR_METADATA_GET", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondWithJSON(reqCtx, 200, mtdBytes)
 	}
 }
 
+// onExplainAccessControl returns which access control policy and operation apply to the invocation of an operation
+// of the local app by the caller identified by the appId, namespace and trustDomain query parameters.
+func (a *api) onExplainAccessControl(reqCtx *fasthttp.RequestCtx) {
+	args := reqCtx.QueryArgs()
+	appID := string(args.Peek(appIDParam))
+	operation := string(args.Peek(operationParam))
+	if appID == "" || operation == "" {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", "appId and operation query parameters are required")
+		respondWithError(reqCtx, 400, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	verb := commonv1pb.HTTPExtension_NONE
+	if v := string(args.Peek(verbParam)); v != "" {
+		value, ok := commonv1pb.HTTPExtension_Verb_value[strings.ToUpper(v)]
+		if !ok {
+			msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf("unknown http verb: %s", v))
+			respondWithError(reqCtx, 400, msg)
+			log.WithContext(reqCtx).Debug(msg)
+			return
+		}
+		verb = commonv1pb.HTTPExtension_Verb(value)
+	}
+
+	protocol := string(args.Peek(protocolParam))
+	if protocol == "" {
+		protocol = config.HTTPProtocol
+	}
+
+	spiffeID := a.accessControlList.LocalSpiffeID(appID, string(args.Peek(namespaceParam)))
+	if trustDomain := string(args.Peek(trustDomainParam)); spiffeID != nil && trustDomain != "" {
+		spiffeID.TrustDomain = trustDomain
+	}
+
+	// claims of the caller are passed as claim.<name> query parameters
+	var claims map[string]string
+	args.VisitAll(func(key []byte, value []byte) {
+		if k := string(key); strings.HasPrefix(k, claimParamPrefix) {
+			if claims == nil {
+				claims = map[string]string{}
+			}
+			claims[strings.TrimPrefix(k, claimParamPrefix)] = string(value)
+		}
+	})
+
+	decision := config.ExplainOperationAccessControlPolicy(spiffeID, appID, operation, verb, protocol, claims, a.accessControlList)
+	b, err := a.json.Marshal(decision)
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACCESS_CONTROL_EXPLAIN", err.Error())
+		respondWithError(reqCtx, 500, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+	respondWithJSON(reqCtx, 200, b)
+}
+
 func (a *api) onPutMetadata(reqCtx *fasthttp.RequestCtx) {
 	key := fmt.Sprintf("%v", reqCtx.UserValue("key"))
 	body := reqCtx.PostBody()
@@ -934,11 +1355,52 @@ func (a *api) onPutMetadata(reqCtx *fasthttp.RequestCtx) {
 	respondEmpty(reqCtx, 200)
 }
 
+// onGetLoggers lists the loggers of daprd with their log level
+func (a *api) onGetLoggers(reqCtx *fasthttp.RequestCtx) {
+	b, err := a.json.Marshal(logger.ListScopeLevels())
+	if err != nil {
+		msg := NewErrorResponse("ERR_LOGGERS_GET", err.Error())
+		respondWithError(reqCtx, 500, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+	respondWithJSON(reqCtx, 200, b)
+}
+
+// onPutLoggerLevel sets the log level of a logger scope and its nested scopes at runtime
+func (a *api) onPutLoggerLevel(reqCtx *fasthttp.RequestCtx) {
+	if !a.levelUpdatesEnabled {
+		msg := NewErrorResponse("ERR_LOGGER_LEVEL_UPDATES_DISABLED", "log level updates are disabled, set --log-level-updates to enable them")
+		respondWithError(reqCtx, fasthttp.StatusForbidden, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	scope := reqCtx.UserValue(scopeParam).(string)
+
+	var req logger.ScopeLevel
+	if err := a.json.Unmarshal(reqCtx.PostBody(), &req); err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf("can't deserialize request: %s", err))
+		respondWithError(reqCtx, 400, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	if err := logger.SetScopeLevel(scope, string(req.Level)); err != nil {
+		msg := NewErrorResponse("ERR_LOGGER_LEVEL_SET", err.Error())
+		respondWithError(reqCtx, 400, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+	log.WithContext(reqCtx).Infof("log level of scope %s set to %s", scope, req.Level)
+	respondEmpty(reqCtx, 200)
+}
+
 func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 	if a.publishFn == nil {
 		msg := NewErrorResponse("ERR_PUBSUB_NOT_FOUND", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -947,7 +1409,7 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 	if topic == "/" {
 		msg := NewErrorResponse("ERR_TOPIC_EMPTY", "")
 		respondWithError(reqCtx, 404, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -963,7 +1425,7 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_SER", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -974,10 +1436,14 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 	}
 
 	err = a.publishFn(&req)
-	if err != nil {
+	if _, ok := err.(*runtime_pubsub.NotAllowedError); ok {
+		msg := NewErrorResponse("ERR_PERMISSION_DENIED", err.Error())
+		respondWithError(reqCtx, net_http.StatusForbidden, msg)
+		log.WithContext(reqCtx).Debug(msg)
+	} else if err != nil {
 		msg := NewErrorResponse("ERR_PUBSUB_PUBLISH_MESSAGE", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 200)
 	}
@@ -999,10 +1465,25 @@ func (a *api) onGetHealthz(reqCtx *fasthttp.RequestCtx) {
 	if !a.readyStatus {
 		msg := NewErrorResponse("ERR_HEALTH_NOT_READY", "dapr is not ready")
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
-	} else {
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+	if a.componentsHealth == nil {
 		respondEmpty(reqCtx, 200)
+		return
+	}
+
+	ready, components := a.componentsHealth.Report()
+	res := healthzResponse{Components: components}
+	status := 200
+	if !ready {
+		res.ErrorCode = "ERR_HEALTH_NOT_READY"
+		res.Message = "required components are not healthy"
+		status = 500
+		log.WithContext(reqCtx).Debug(res.Message)
 	}
+	b, _ := a.json.Marshal(res)
+	respondWithJSON(reqCtx, status, b)
 }
 
 func getMetadataFromRequest(reqCtx *fasthttp.RequestCtx) map[string]string {
@@ -1023,7 +1504,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		msg := NewErrorResponse("ERR_STATE_STORES_NOT_CONFIGURED", "")
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -1032,7 +1513,11 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if !ok {
 		msg := NewErrorResponse("ERR_STATE_STORE_NOT_FOUND:", fmt.Sprintf("state store name: %s", storeName))
 		respondWithError(reqCtx, 401, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return
+	}
+
+	if err := a.checkStateOperationAllowed(reqCtx, storeName, config.StateTransactionOperation); err != nil {
 		return
 	}
 
@@ -1040,7 +1525,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if !ok {
 		msg := NewErrorResponse("ERR_STATE_STORE_NOT_SUPPORTED", fmt.Sprintf("state store name: %s", storeName))
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -1049,7 +1534,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if err := a.json.Unmarshal(body, &req); err != nil {
 		msg := NewErrorResponse("ERR_DESERIALIZE_HTTP_BODY", err.Error())
 		respondWithError(reqCtx, 400, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 		return
 	}
 
@@ -1061,7 +1546,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 			if err := mapstructure.Decode(o.Request, &upsertReq); err != nil {
 				msg := NewErrorResponse("ERR_DESERIALIZE_HTTP_BODY", err.Error())
 				respondWithError(reqCtx, 400, msg)
-				log.Debug(msg)
+				log.WithContext(reqCtx).Debug(msg)
 				return
 			}
 			upsertReq.Key = a.getModifiedStateKey(upsertReq.Key)
@@ -1074,7 +1559,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 			if err := mapstructure.Decode(o.Request, &delReq); err != nil {
 				msg := NewErrorResponse("ERR_DESERIALIZE_HTTP_BODY", err.Error())
 				respondWithError(reqCtx, 400, msg)
-				log.Debug(msg)
+				log.WithContext(reqCtx).Debug(msg)
 				return
 			}
 			delReq.Key = a.getModifiedStateKey(delReq.Key)
@@ -1087,7 +1572,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 				"ERR_NOT_SUPPORTED_STATE_OPERATION",
 				fmt.Sprintf("operation type %s not supported", o.Operation))
 			respondWithError(reqCtx, 400, msg)
-			log.Debug(msg)
+			log.WithContext(reqCtx).Debug(msg)
 			return
 		}
 	}
@@ -1100,12 +1585,25 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_TRANSACTION", err.Error())
 		respondWithError(reqCtx, 500, msg)
-		log.Debug(msg)
+		log.WithContext(reqCtx).Debug(msg)
 	} else {
 		respondEmpty(reqCtx, 201)
 	}
 }
 
+// isBindingOperationAllowed checks the bindings configuration and responds with 403 if the operation is denied.
+func (a *api) isBindingOperationAllowed(reqCtx *fasthttp.RequestCtx, name, operation string) bool {
+	if config, ok := a.bindingsConfiguration[name]; ok && !config.IsOperationAllowed(a.id, operation) {
+		msg := NewErrorResponse(
+			"ERR_PERMISSION_DENIED",
+			fmt.Sprintf("Access denied by policy to invoke %s on binding %s", operation, name))
+		respondWithError(reqCtx, net_http.StatusForbidden, msg)
+		log.WithContext(reqCtx).Debug(msg)
+		return false
+	}
+	return true
+}
+
 func (a *api) isSecretAllowed(storeName, key string) bool {
 	if config, ok := a.secretsConfiguration[storeName]; ok {
 		return config.IsSecretAllowed(key)
@@ -7,16 +7,19 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/exporters"
@@ -26,13 +29,19 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
+	"github.com/dapr/dapr/pkg/components"
+	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
 	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/health"
 	"github.com/dapr/dapr/pkg/logger"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
 	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/dapr/pkg/version"
 	routing "github.com/fasthttp/router"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
@@ -127,6 +136,36 @@ func TestPubSubEndpoints(t *testing.T) {
 		}
 	})
 
+	t.Run("Publish to a topic that is not allowed - 403", func(t *testing.T) {
+		testAPI.publishFn = func(req *pubsub.PublishRequest) error {
+			return runtime_pubsub.NewNotAllowedError("topic %s is not allowed for app id %s", req.Topic, "app1")
+		}
+		defer func() {
+			testAPI.publishFn = func(req *pubsub.PublishRequest) error { return nil }
+		}()
+		apiPath := fmt.Sprintf("%s/publish/pubsubname/topic", apiVersionV1)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("{\"key\": \"value\"}"), nil)
+		// assert
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_PERMISSION_DENIED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Publish fails - 500", func(t *testing.T) {
+		testAPI.publishFn = func(req *pubsub.PublishRequest) error {
+			return errors.New("publish failed")
+		}
+		defer func() {
+			testAPI.publishFn = func(req *pubsub.PublishRequest) error { return nil }
+		}()
+		apiPath := fmt.Sprintf("%s/publish/pubsubname/topic", apiVersionV1)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("{\"key\": \"value\"}"), nil)
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_PUBLISH_MESSAGE", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -175,6 +214,121 @@ func TestV1OutputBindingsEndpoints(t *testing.T) {
 		}
 	})
 
+	t.Run("Invoke output bindings - 403 Forbidden", func(t *testing.T) {
+		testAPI.id = "app1"
+		testAPI.bindingsConfiguration = map[string]config.BindingsScope{
+			"testbinding": {
+				BindingName: "testbinding",
+				AppPolicies: []config.BindingAppPolicy{
+					{AppID: "app1", AllowedOperations: []string{"get"}},
+				},
+			},
+		}
+		testAPI.sendToOutputBindingFn = func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) { return nil, nil }
+		defer func() {
+			testAPI.bindingsConfiguration = nil
+		}()
+		apiPath := fmt.Sprintf("%s/bindings/testbinding", apiVersionV1)
+
+		req := OutputBindingRequest{Data: "fake output", Operation: "delete"}
+		b, _ := json.Marshal(&req)
+		resp := fakeServer.DoRequest("POST", apiPath, b, nil)
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_PERMISSION_DENIED", resp.ErrorBody["errorCode"])
+
+		req = OutputBindingRequest{Data: "fake output", Operation: "get"}
+		b, _ = json.Marshal(&req)
+		resp = fakeServer.DoRequest("POST", apiPath, b, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	fakeServer.Shutdown()
+}
+
+func TestV1OutputBindingsStreamEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		sendToOutputBindingStreamFn: func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error) {
+			if name != "testbinding" {
+				return nil, errors.New("missing binding name")
+			}
+			data, err := ioutil.ReadAll(req.Data)
+			if err != nil {
+				return nil, err
+			}
+			return &bindings_loader.StreamInvokeResponse{
+				Data: ioutil.NopCloser(bytes.NewReader(bytes.ToUpper(data))),
+				Metadata: map[string]string{
+					"operation": string(req.Operation),
+					"key":       req.Metadata["key"],
+				},
+			}, nil
+		},
+		json: jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructBindingsEndpoints())
+
+	t.Run("Invoke output bindings stream - 200 OK", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/bindings/testbinding/stream", apiVersionV1)
+		params := map[string]string{
+			"operation":    "create",
+			"metadata.key": "file.bin",
+		}
+		testMethods := []string{"POST", "PUT"}
+		for _, method := range testMethods {
+			// act
+			resp := fakeServer.DoRequest(method, apiPath, []byte("fake output"), params)
+			// assert
+			assert.Equal(t, 200, resp.StatusCode, "failed to invoke output binding stream with %s", method)
+			assert.Equal(t, "FAKE OUTPUT", string(resp.RawBody))
+			assert.Equal(t, "create", resp.RawHeader.Get("operation"))
+			assert.Equal(t, "file.bin", resp.RawHeader.Get("key"))
+		}
+	})
+
+	t.Run("Invoke output bindings stream - body larger than max request body size", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/bindings/testbinding/stream", apiVersionV1)
+		body := bytes.Repeat([]byte("a"), maxRequestBodySize+1024)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, body, map[string]string{"operation": "create"})
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, len(body), len(resp.RawBody))
+	})
+
+	t.Run("Invoke output bindings - 413 body larger than max request body size", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/bindings/testbinding", apiVersionV1)
+		req := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(req)
+		req.SetRequestURI(fmt.Sprintf("http://localhost/%s", apiPath))
+		req.Header.SetMethod(fasthttp.MethodPost)
+		req.SetBody(bytes.Repeat([]byte("a"), maxRequestBodySize+1024))
+		// act
+		// the server closes the connection without reading the whole body, which fails the write
+		// of the body with net/http clients before the response is read
+		conn, err := fakeServer.ln.Dial()
+		assert.NoError(t, err)
+		defer conn.Close()
+		go req.WriteTo(conn)
+		resp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(resp)
+		err = resp.Read(bufio.NewReader(conn))
+		// assert
+		assert.NoError(t, err)
+		assert.Equal(t, 413, resp.StatusCode())
+		assert.True(t, resp.ConnectionClose())
+		assert.Contains(t, string(resp.Body()), "ERR_REQUEST_BODY_TOO_LARGE")
+	})
+
+	t.Run("Invoke output bindings stream - 500 InternalError", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/bindings/notfound/stream", apiVersionV1)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("fake output"), map[string]string{"operation": "create"})
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_INVOKE_OUTPUT_BINDING", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -318,6 +472,186 @@ func TestV1DirectMessagingEndpoints(t *testing.T) {
 		assert.Equal(t, 200, resp.StatusCode)
 	})
 
+	t.Run("Invoke direct messaging with stream header - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+		fakeData := []byte("fakeData")
+
+		fakeStreamResponse := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeStreamResponse.WithRawData(nil, "application/octet-stream")
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		mockDirectMessaging.On("InvokeStream",
+			mock.MatchedBy(func(a context.Context) bool {
+				return true
+			}), mock.MatchedBy(func(b string) bool {
+				return b == "fakeAppID"
+			}), mock.MatchedBy(func(c *invokev1.InvokeMethodRequest) bool {
+				_, data := c.RawData()
+				return len(data) == 0
+			}), mock.MatchedBy(func(d io.Reader) bool {
+				return true
+			})).Return(fakeStreamResponse, ioutil.NopCloser(strings.NewReader("streamedResponse")), nil).Once()
+
+		// act
+		r, _ := gohttp.NewRequest("POST", "http://localhost/"+apiPath, bytes.NewBuffer(fakeData))
+		r.Header.Set("Content-Type", "application/octet-stream")
+		r.Header.Set("dapr-stream", "true")
+		res, err := fakeServer.client.Do(r)
+
+		// assert
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		body, _ := ioutil.ReadAll(res.Body)
+		mockDirectMessaging.AssertNumberOfCalls(t, "InvokeStream", 1)
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 0)
+		assert.Equal(t, 200, res.StatusCode)
+		assert.Equal(t, "application/octet-stream", res.Header.Get("Content-Type"))
+		assert.Equal(t, "streamedResponse", string(body))
+	})
+
+	t.Run("Invoke direct messaging with stream header streams the request body and ends with the response", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+		fakeData := bytes.Repeat([]byte("a"), 5*1024*1024)
+
+		fakeStreamResponse := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeStreamResponse.WithRawData(nil, "application/octet-stream")
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		var invokeCtx context.Context
+		var received []byte
+		mockDirectMessaging.On("InvokeStream",
+			mock.Anything, "fakeAppID", mock.AnythingOfType("*v1.InvokeMethodRequest"), mock.Anything).
+			Run(func(args mock.Arguments) {
+				invokeCtx = args.Get(0).(context.Context)
+				received, _ = ioutil.ReadAll(args.Get(3).(io.Reader))
+			}).
+			Return(fakeStreamResponse, ioutil.NopCloser(strings.NewReader("streamedResponse")), nil).Once()
+
+		// act
+		r, _ := gohttp.NewRequest("POST", "http://localhost/"+apiPath, bytes.NewBuffer(fakeData))
+		r.Header.Set("dapr-stream", "true")
+		res, err := fakeServer.client.Do(r)
+
+		// assert
+		assert.NoError(t, err)
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		assert.Equal(t, 200, res.StatusCode)
+		assert.Equal(t, "streamedResponse", string(body))
+		assert.Equal(t, fakeData, received)
+		select {
+		case <-invokeCtx.Done():
+		case <-time.After(time.Second):
+			assert.Fail(t, "invocation wasn't canceled after the response was written")
+		}
+	})
+
+	t.Run("Invoke direct messaging with request timeout header - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+		fakeData := []byte("fakeData")
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(func(a context.Context) bool {
+				deadline, ok := a.Deadline()
+				return ok && time.Until(deadline) <= 5*time.Minute
+			}), mock.MatchedBy(func(b string) bool {
+				return b == "fakeAppID"
+			}), mock.MatchedBy(func(c *invokev1.InvokeMethodRequest) bool {
+				for k := range c.Metadata() {
+					if strings.EqualFold(k, "dapr-request-timeout") {
+						return false
+					}
+				}
+				return true
+			})).Return(fakeDirectMessageResponse, nil).Once()
+
+		// act
+		r, _ := gohttp.NewRequest("POST", "http://localhost/"+apiPath, bytes.NewBuffer(fakeData))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("dapr-request-timeout", "5m")
+		res, err := fakeServer.client.Do(r)
+
+		// assert
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, 200, res.StatusCode)
+	})
+
+	t.Run("Invoke direct messaging with invalid request timeout header - 400", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		// act
+		r, _ := gohttp.NewRequest("POST", "http://localhost/"+apiPath, bytes.NewBuffer([]byte("fakeData")))
+		r.Header.Set("dapr-request-timeout", "incorrect")
+		res, err := fakeServer.client.Do(r)
+
+		// assert
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 0)
+		assert.Equal(t, 400, res.StatusCode)
+	})
+
+	t.Run("Invoke direct messaging with stream and request timeout headers - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+
+		fakeStreamResponse := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		mockDirectMessaging.On("InvokeStream",
+			mock.MatchedBy(func(a context.Context) bool {
+				deadline, ok := a.Deadline()
+				return ok && time.Until(deadline) <= 5*time.Minute
+			}), mock.MatchedBy(func(b string) bool {
+				return b == "fakeAppID"
+			}), mock.MatchedBy(func(c *invokev1.InvokeMethodRequest) bool {
+				for k := range c.Metadata() {
+					if strings.EqualFold(k, "dapr-request-timeout") {
+						return false
+					}
+				}
+				return true
+			}), mock.Anything).Return(fakeStreamResponse, ioutil.NopCloser(strings.NewReader("streamedResponse")), nil).Once()
+
+		// act
+		r, _ := gohttp.NewRequest("POST", "http://localhost/"+apiPath, bytes.NewBuffer([]byte("fakeData")))
+		r.Header.Set("dapr-stream", "true")
+		r.Header.Set("dapr-request-timeout", "5m")
+		res, err := fakeServer.client.Do(r)
+
+		// assert
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		mockDirectMessaging.AssertNumberOfCalls(t, "InvokeStream", 1)
+		assert.Equal(t, 200, res.StatusCode)
+	})
+
+	t.Run("Invoke direct messaging with stream and invalid request timeout headers - 400", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		// act
+		r, _ := gohttp.NewRequest("POST", "http://localhost/"+apiPath, bytes.NewBuffer([]byte("fakeData")))
+		r.Header.Set("dapr-stream", "true")
+		r.Header.Set("dapr-request-timeout", "incorrect")
+		res, err := fakeServer.client.Do(r)
+
+		// assert
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		mockDirectMessaging.AssertNumberOfCalls(t, "InvokeStream", 0)
+		assert.Equal(t, 400, res.StatusCode)
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -413,6 +747,73 @@ func TestV1DirectMessagingEndpointsWithTracer(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestStreamedResponsesWithDefaultMiddleware(t *testing.T) {
+	// newStreamedBody returns a body that sends its first chunk right away and the rest once released,
+	// so the first chunk only reaches the client if the response isn't buffered by the server
+	newStreamedBody := func() (io.ReadCloser, chan struct{}) {
+		release := make(chan struct{})
+		r, w := io.Pipe()
+		go func() {
+			w.Write([]byte("first")) // nolint: errcheck
+			<-release
+			w.Write([]byte("second")) // nolint: errcheck
+			w.Close()
+		}()
+		return r, release
+	}
+
+	readStreamedBody := func(t *testing.T, fakeServer *fakeHTTPServer, r *gohttp.Request, release chan struct{}) {
+		res, err := fakeServer.client.Do(r)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer res.Body.Close()
+		assert.Equal(t, 200, res.StatusCode)
+
+		first := make([]byte, len("first"))
+		_, err = io.ReadFull(res.Body, first)
+		assert.NoError(t, err)
+		assert.Equal(t, "first", string(first))
+
+		close(release)
+		rest, err := ioutil.ReadAll(res.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "second", string(rest))
+	}
+
+	mockDirectMessaging := new(daprt.MockDirectMessaging)
+	bindingBody, bindingRelease := newStreamedBody()
+	testAPI := &api{
+		directMessaging: mockDirectMessaging,
+		sendToOutputBindingStreamFn: func(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error) {
+			return &bindings_loader.StreamInvokeResponse{Data: bindingBody}, nil
+		},
+		json: jsoniter.ConfigFastest,
+	}
+	testAPI.endpoints = append(testAPI.constructDirectMessagingEndpoints(), testAPI.constructBindingsEndpoints()...)
+
+	fakeServer := newFakeHTTPServer()
+	fakeServer.StartServerWithDefaultMiddleware(testAPI)
+	defer fakeServer.Shutdown()
+
+	t.Run("Invoke direct messaging with stream header", func(t *testing.T) {
+		invokeBody, invokeRelease := newStreamedBody()
+		fakeStreamResponse := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeStreamResponse.WithRawData(nil, "application/octet-stream")
+		mockDirectMessaging.On("InvokeStream", mock.Anything, "fakeAppID", mock.Anything, mock.Anything).
+			Return(fakeStreamResponse, invokeBody, nil).Once()
+
+		r, _ := gohttp.NewRequest("POST", "http://localhost/v1.0/invoke/fakeAppID/method/fakeMethod", strings.NewReader("fakeData"))
+		r.Header.Set("dapr-stream", "true")
+		readStreamedBody(t, fakeServer, r, invokeRelease)
+	})
+
+	t.Run("Invoke output bindings stream", func(t *testing.T) {
+		r, _ := gohttp.NewRequest("POST", "http://localhost/v1.0/bindings/testbinding/stream?operation=get", strings.NewReader("fakeData"))
+		readStreamedBody(t, fakeServer, r, bindingRelease)
+	})
+}
+
 func TestV1ActorEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	testAPI := &api{
@@ -521,14 +922,30 @@ func TestV1MetadataEndpoint(t *testing.T) {
 	testAPI := &api{
 		actor: nil,
 		json:  jsoniter.ConfigFastest,
+		getComponentsFn: func() []components.RegisteredComponent {
+			return []components.RegisteredComponent{
+				{Name: "statestore", Type: "state.redis", Version: "v1", Status: components.StatusLoaded},
+			}
+		},
+		getSubscriptionsFn: func() []runtime_pubsub.Subscription {
+			return []runtime_pubsub.Subscription{
+				{PubsubName: "pubsub", Topic: "orders", Route: "/orders", Metadata: map[string]string{"password": "p4ssw0rd"}},
+			}
+		},
+		configurationName: "appconfig",
 	}
 
 	fakeServer.StartServer(testAPI.constructMetadataEndpoints())
 
 	expectedBody := map[string]interface{}{
-		"id":       "xyz",
-		"actors":   []map[string]interface{}{{"type": "abcd", "count": 10}, {"type": "xyz", "count": 5}},
-		"extended": make(map[string]string),
+		"id":                "xyz",
+		"runtimeVersion":    version.Version(),
+		"configurationName": "appconfig",
+		"actors":            []map[string]interface{}{{"type": "abcd", "count": 10}, {"type": "xyz", "count": 5}},
+		"actorRuntime":      map[string]interface{}{"hostedActorTypes": []string{"abcd", "xyz"}, "placementTableVersion": "1"},
+		"components":        []map[string]interface{}{{"name": "statestore", "type": "state.redis", "version": "v1", "status": "loaded"}},
+		"subscriptions":     []map[string]interface{}{{"pubsubname": "pubsub", "topic": "orders", "route": "/orders"}},
+		"extended":          make(map[string]string),
 	}
 	expectedBodyBytes, _ := json.Marshal(expectedBody)
 
@@ -537,6 +954,7 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		mockActors := new(daprt.MockActors)
 
 		mockActors.On("GetActiveActorsCount")
+		mockActors.On("GetActorRuntimeMetadata")
 
 		testAPI.id = "xyz"
 		testAPI.actor = mockActors
@@ -544,8 +962,79 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
 
 		assert.Equal(t, 200, resp.StatusCode)
-		assert.ElementsMatch(t, expectedBodyBytes, resp.RawBody)
+		assert.JSONEq(t, string(expectedBodyBytes), string(resp.RawBody))
+		assert.NotContains(t, string(resp.RawBody), "p4ssw0rd")
 		mockActors.AssertNumberOfCalls(t, "GetActiveActorsCount", 1)
+		mockActors.AssertNumberOfCalls(t, "GetActorRuntimeMetadata", 1)
+	})
+
+	t.Run("Metadata - actors not enabled", func(t *testing.T) {
+		apiPath := "v1.0/metadata"
+		testAPI.actor = nil
+
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, []interface{}{}, body["actors"])
+		assert.NotContains(t, body, "actorRuntime")
+	})
+
+	fakeServer.Shutdown()
+}
+
+func TestV1AccessControlExplainEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	accessControlList, _ := config.ParseAccessControlSpec(config.AccessControlSpec{
+		DefaultAction: config.DenyAccess,
+		TrustDomain:   "public",
+		AppPolicies: []config.AppPolicySpec{
+			{
+				AppName:       "app1",
+				DefaultAction: config.DenyAccess,
+				TrustDomain:   "public",
+				Namespace:     "default",
+				AppOperationActions: []config.AppOperation{
+					{Operation: "/orders/{id}/items", HTTPVerb: []string{"GET"}, Action: config.AllowAccess},
+				},
+			},
+		},
+	})
+	testAPI := &api{
+		json:              jsoniter.ConfigFastest,
+		accessControlList: accessControlList,
+	}
+
+	fakeServer.StartServer(testAPI.constructAccessControlEndpoints())
+
+	t.Run("Explain - 200 OK", func(t *testing.T) {
+		params := map[string]string{"appId": "app1", "operation": "/orders/1/items", "verb": "get"}
+		resp := fakeServer.DoRequest("GET", "v1.0/accesscontrol/explain", nil, params)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var decision config.AccessControlDecision
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &decision))
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, "app1", decision.AppID)
+		assert.Equal(t, "/orders/{id}/items", decision.Operation)
+		assert.Equal(t, "GET", decision.HTTPVerb)
+		assert.Equal(t, map[string]string{"id": "1"}, decision.PathParameters)
+	})
+
+	t.Run("Explain with unknown verb - 400", func(t *testing.T) {
+		params := map[string]string{"appId": "app1", "operation": "/orders", "verb": "fetch"}
+		resp := fakeServer.DoRequest("GET", "v1.0/accesscontrol/explain", nil, params)
+
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Explain without operation - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/accesscontrol/explain", nil, map[string]string{"appId": "app1"})
+
+		assert.Equal(t, 400, resp.StatusCode)
 	})
 
 	fakeServer.Shutdown()
@@ -1068,7 +1557,12 @@ func (f *fakeHTTPServer) StartServer(endpoints []Endpoint) {
 	router := f.getRouter(endpoints)
 	f.ln = fasthttputil.NewInmemoryListener()
 	go func() {
-		if err := fasthttp.Serve(f.ln, router.Handler); err != nil {
+		server := &fasthttp.Server{
+			Handler:            router.Handler,
+			MaxRequestBodySize: maxRequestBodySize,
+			StreamRequestBody:  true,
+		}
+		if err := server.Serve(f.ln); err != nil {
 			panic(fmt.Errorf("failed to serve: %v", err))
 		}
 	}()
@@ -1100,11 +1594,40 @@ func (f *fakeHTTPServer) StartServerWithTracing(spec config.TracingSpec, endpoin
 	}
 }
 
+// StartServerWithDefaultMiddleware serves the endpoints of the API with the middleware daprd uses by default
+func (f *fakeHTTPServer) StartServerWithDefaultMiddleware(testAPI API) {
+	s := &server{
+		api:        testAPI,
+		config:     ServerConfig{AllowedOrigins: "*"},
+		metricSpec: config.MetricSpec{Enabled: true},
+	}
+	f.ln = fasthttputil.NewInmemoryListener()
+	go func() {
+		server := &fasthttp.Server{
+			Handler:            s.useHandlers(),
+			MaxRequestBodySize: maxRequestBodySize,
+			StreamRequestBody:  true,
+		}
+		if err := server.Serve(f.ln); err != nil {
+			panic(fmt.Errorf("failed to serve: %v", err))
+		}
+	}()
+
+	f.client = gohttp.Client{
+		Timeout: time.Second * 5,
+		Transport: &gohttp.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return f.ln.Dial()
+			},
+		},
+	}
+}
+
 func (f *fakeHTTPServer) StartServerWithAPIToken(endpoints []Endpoint) {
 	router := f.getRouter(endpoints)
 	f.ln = fasthttputil.NewInmemoryListener()
 	go func() {
-		if err := fasthttp.Serve(f.ln, useAPIAuthentication(router.Handler)); err != nil {
+		if err := fasthttp.Serve(f.ln, useAPIAuthentication(router.Handler, auth.NewAPITokenStore(auth.GetAPIToken()))); err != nil {
 			panic(fmt.Errorf("failed to serve: %v", err))
 		}
 	}()
@@ -1142,8 +1665,12 @@ func (f *fakeHTTPServer) getRouter(endpoints []Endpoint) *routing.Router {
 
 	for _, e := range endpoints {
 		path := fmt.Sprintf("/%s/%s", e.Version, e.Route)
+		handler := e.Handler
+		if !e.Streaming {
+			handler = limitRequestBody(handler)
+		}
 		for _, m := range e.Methods {
-			router.Handle(m, path, e.Handler)
+			router.Handle(m, path, handler)
 		}
 	}
 	return router
@@ -1489,6 +2016,149 @@ func TestV1SecretEndpoints(t *testing.T) {
 	})
 }
 
+func TestV1BulkSecretEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	fakeStore := daprt.FakeSecretStore{}
+	fakeStores := map[string]secretstores.SecretStore{
+		"store1": fakeStore,
+		"store2": fakeStore,
+		"store3": fakeStore,
+		"store4": daprt.FakeSingleSecretStore{},
+	}
+	secretsConfiguration := map[string]config.SecretsScope{
+		"store1": {
+			DefaultAccess: config.AllowAccess,
+			DeniedSecrets: []string{"not-allowed"},
+		},
+		"store2": {
+			DefaultAccess:  config.DenyAccess,
+			AllowedSecrets: []string{"good-key"},
+		},
+	}
+
+	testAPI := &api{
+		secretsConfiguration: secretsConfiguration,
+		secretStores:         fakeStores,
+		json:                 jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructSecretEndpoints())
+
+	t.Run("Bulk get secret - 401 ERR_SECRET_STORE_NOT_FOUND", func(t *testing.T) {
+		apiPath := "v1.0/secrets/notexistStore"
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		// assert
+		assert.Equal(t, 401, resp.StatusCode, "reading non-existing store should return 401")
+	})
+
+	t.Run("Bulk get secret - denied secrets are filtered", func(t *testing.T) {
+		apiPath := "v1.0/secrets/store1"
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var secrets map[string]map[string]string
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &secrets))
+		assert.Equal(t, 2, len(secrets))
+		assert.Equal(t, "life is good", secrets["good-key"]["good-key"])
+		assert.NotContains(t, secrets, "not-allowed")
+	})
+
+	t.Run("Bulk get secret - only allowed secrets are returned", func(t *testing.T) {
+		apiPath := "v1.0/secrets/store2"
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var secrets map[string]map[string]string
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &secrets))
+		assert.Equal(t, 1, len(secrets))
+		assert.Contains(t, secrets, "good-key")
+	})
+
+	t.Run("Bulk get secret - prefix filter", func(t *testing.T) {
+		apiPath := "v1.0/secrets/store3"
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"prefix": "good-key-"})
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var secrets map[string]map[string]string
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &secrets))
+		assert.Equal(t, 1, len(secrets))
+		assert.Contains(t, secrets, "good-key-2")
+	})
+
+	t.Run("Bulk get secret - 501 ERR_SECRET_STORE_NOT_SUPPORTED", func(t *testing.T) {
+		apiPath := "v1.0/secrets/store4"
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		// assert
+		assert.Equal(t, 501, resp.StatusCode, "bulk reading a store without bulk support should return 501")
+		assert.Equal(t, "ERR_SECRET_STORE_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Get secret named bulk", func(t *testing.T) {
+		apiPath := "v1.0/secrets/store4/bulk"
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		// assert
+		assert.Equal(t, 204, resp.StatusCode, "a secret named bulk should be read by name")
+	})
+}
+
+func TestV1LoggersEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testLogger := logger.NewLogger("dapr.test.loggers")
+	testLogger.SetOutputLevel(logger.InfoLevel)
+
+	testAPI := &api{
+		json:                jsoniter.ConfigFastest,
+		levelUpdatesEnabled: true,
+	}
+
+	fakeServer.StartServer(testAPI.constructLoggersEndpoints())
+
+	t.Run("List loggers - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/loggers"
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var levels []logger.ScopeLevel
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &levels))
+		assert.Contains(t, levels, logger.ScopeLevel{Scope: "dapr.test.loggers", Level: logger.InfoLevel})
+	})
+
+	t.Run("Set logger level - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/loggers/dapr.test.loggers"
+		resp := fakeServer.DoRequest("PUT", apiPath, []byte(`{"level": "debug"}`), nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, logger.DebugLevel, testLogger.OutputLevel())
+	})
+
+	t.Run("Set logger level - 400 undefined level", func(t *testing.T) {
+		apiPath := "v1.0/loggers/dapr.test.loggers"
+		resp := fakeServer.DoRequest("PUT", apiPath, []byte(`{"level": "verbose"}`), nil)
+
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_LOGGER_LEVEL_SET", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Set logger level - 403 level updates disabled", func(t *testing.T) {
+		testAPI.levelUpdatesEnabled = false
+		defer func() { testAPI.levelUpdatesEnabled = true }()
+
+		apiPath := "v1.0/loggers/dapr.test.loggers"
+		resp := fakeServer.DoRequest("PUT", apiPath, []byte(`{"level": "info"}`), nil)
+
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_LOGGER_LEVEL_UPDATES_DISABLED", resp.ErrorBody["errorCode"])
+		assert.Equal(t, logger.DebugLevel, testLogger.OutputLevel())
+	})
+
+	fakeServer.Shutdown()
+}
+
 func TestV1HealthzEndpoint(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 
@@ -1515,6 +2185,41 @@ func TestV1HealthzEndpoint(t *testing.T) {
 	})
 
 	fakeServer.Shutdown()
+
+	t.Run("Healthz - components report", func(t *testing.T) {
+		componentsHealth := health.NewComponentsHealth(time.Second, time.Second, []string{"statestore"})
+		testAPI := &api{
+			json:             jsoniter.ConfigFastest,
+			readyStatus:      true,
+			componentsHealth: componentsHealth,
+		}
+		fakeServer := newFakeHTTPServer()
+		fakeServer.StartServer(testAPI.constructHealthzEndpoints())
+		defer fakeServer.Shutdown()
+
+		resp := fakeServer.DoRequest("GET", "v1.0/healthz", nil, nil)
+		assert.Equal(t, 500, resp.StatusCode, "required component not loaded should return 500")
+		var res healthzResponse
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		assert.Equal(t, "ERR_HEALTH_NOT_READY", res.ErrorCode)
+
+		componentsHealth.Register("statestore", "state.redis", func() error { return nil })
+		for i := 0; i < 100; i++ {
+			if ready, _ := componentsHealth.Report(); ready {
+				break
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+		resp = fakeServer.DoRequest("GET", "v1.0/healthz", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		res = healthzResponse{}
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		assert.Equal(t, []health.ComponentStatus{{Name: "statestore", Type: "state.redis", Status: health.StatusHealthy, Required: true}}, res.Components)
+
+		componentsHealth.Register("statestore", "state.redis", nil)
+		resp = fakeServer.DoRequest("GET", "v1.0/healthz", nil, nil)
+		assert.Equal(t, 500, resp.StatusCode, "required component that can't be probed should return 500")
+	})
 }
 
 func TestV1TransactionEndpoints(t *testing.T) {
@@ -13,6 +13,8 @@ type ServerConfig struct {
 	Port            int
 	ProfilePort     int
 	EnableProfiling bool
+	// UnixDomainSocket is the path of the Unix domain socket to listen on instead of the port, if set
+	UnixDomainSocket string
 }
 
 // NewServerConfig returns a new HTTP server config
@@ -13,4 +13,6 @@ type Endpoint struct {
 	Route   string
 	Version string
 	Handler fasthttp.RequestHandler
+	// Streaming handlers read the request body as a stream, or buffer it themselves with bufferRequestBody
+	Streaming bool
 }
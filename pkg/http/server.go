@@ -7,7 +7,10 @@ package http
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"strings"
 
 	cors "github.com/AdhityaRamadhanus/fasthttpcors"
@@ -25,6 +28,13 @@ import (
 
 var log = logger.NewLogger("dapr.runtime.http")
 
+const (
+	// unixSocketMode restricts the Unix domain socket of the server to the user and group of daprd
+	unixSocketMode = 0660
+	// maxRequestBodySize is the size of the largest request body buffered for the handlers that don't stream it
+	maxRequestBodySize = fasthttp.DefaultMaxRequestBodySize
+)
+
 // Server is an interface for the Dapr HTTP server
 type Server interface {
 	StartNonBlocking()
@@ -36,33 +46,49 @@ type server struct {
 	metricSpec  config.MetricSpec
 	pipeline    http_middleware.Pipeline
 	api         API
+	apiTokens   *auth.APITokenStore
+	jwtAuth     *auth.JWTAuthenticator
 }
 
 // NewServer returns a new HTTP server
-func NewServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, pipeline http_middleware.Pipeline) Server {
+func NewServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, pipeline http_middleware.Pipeline, apiTokens *auth.APITokenStore, jwtAuth *auth.JWTAuthenticator) Server {
 	return &server{
 		api:         api,
 		config:      config,
 		tracingSpec: tracingSpec,
 		metricSpec:  metricSpec,
 		pipeline:    pipeline,
+		apiTokens:   apiTokens,
+		jwtAuth:     jwtAuth,
 	}
 }
 
 // StartNonBlocking starts a new server in a goroutine
 func (s *server) StartNonBlocking() {
-	handler :=
-		useAPIAuthentication(
-			s.useCors(
-				s.useComponents(
-					s.useRouter())))
+	handler := s.useHandlers()
 
-	handler = s.useMetrics(handler)
-	handler = s.useTracing(handler)
+	// request bodies larger than the max request body size are streamed to the handlers
+	// instead of being rejected, handlers that don't stream them are limited by limitRequestBody
+	customServer := &fasthttp.Server{
+		Handler:            handler,
+		MaxRequestBodySize: maxRequestBodySize,
+		StreamRequestBody:  true,
+	}
 
-	go func() {
-		log.Fatal(fasthttp.ListenAndServe(fmt.Sprintf(":%v", s.config.Port), handler))
-	}()
+	if s.config.UnixDomainSocket != "" {
+		// remove the socket of a previous run, which would make the listener fail
+		if err := os.Remove(s.config.UnixDomainSocket); err != nil && !os.IsNotExist(err) {
+			log.Fatal(err)
+		}
+		log.Infof("http server is listening on unix domain socket %s", s.config.UnixDomainSocket)
+		go func() {
+			log.Fatal(customServer.ListenAndServeUNIX(s.config.UnixDomainSocket, unixSocketMode))
+		}()
+	} else {
+		go func() {
+			log.Fatal(customServer.ListenAndServe(fmt.Sprintf(":%v", s.config.Port)))
+		}()
+	}
 
 	if s.config.EnableProfiling {
 		go func() {
@@ -72,6 +98,19 @@ func (s *server) StartNonBlocking() {
 	}
 }
 
+// useHandlers returns the router wrapped with the middleware of the server
+func (s *server) useHandlers() fasthttp.RequestHandler {
+	handler :=
+		useAPIAuthentication(
+			useJWTAuthentication(
+				s.useCors(
+					s.useComponents(
+						s.useRouter())), s.jwtAuth), s.apiTokens)
+
+	handler = s.useMetrics(handler)
+	return s.useTracing(handler)
+}
+
 func (s *server) useTracing(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	if diag_utils.IsTracingEnabled(s.tracingSpec.SamplingRate) {
 		log.Infof("enabled tracing http middleware")
@@ -105,20 +144,66 @@ func (s *server) useCors(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	return corsHandler.CorsMiddleware(next)
 }
 
-func useAPIAuthentication(next fasthttp.RequestHandler) fasthttp.RequestHandler {
-	token := auth.GetAPIToken()
-	if token == "" {
+func useAPIAuthentication(next fasthttp.RequestHandler, tokens *auth.APITokenStore) fasthttp.RequestHandler {
+	if !tokens.Enabled() {
 		return next
 	}
 	log.Info("enabled token authentication on http server")
 
 	return func(ctx *fasthttp.RequestCtx) {
+		if auth.ExcludedRoute(string(ctx.Request.URI().FullURI())) {
+			next(ctx)
+			return
+		}
+
 		v := ctx.Request.Header.Peek(auth.APITokenHeader)
-		if auth.ExcludedRoute(string(ctx.Request.URI().FullURI())) || string(v) == token {
+		if err := tokens.Authorize(string(v), auth.HTTPRouteScope(string(ctx.Path()))); err != nil {
+			status := http.StatusUnauthorized
+			if err == auth.ErrAPITokenScope {
+				status = http.StatusForbidden
+			}
+			ctx.Error(err.Error(), status)
+			return
+		}
+		next(ctx)
+	}
+}
+
+// useJWTAuthentication verifies the bearer JWT of the caller and sets its claims as dapr-caller-<claim> headers,
+// which are forwarded to the app. Caller headers set by the client are always removed so they can't be spoofed.
+func useJWTAuthentication(next fasthttp.RequestHandler, authenticator *auth.JWTAuthenticator) fasthttp.RequestHandler {
+	if authenticator != nil {
+		log.Info("enabled jwt authentication on http server")
+	}
+
+	return func(ctx *fasthttp.RequestCtx) {
+		removeCallerClaimHeaders(&ctx.Request.Header)
+		if authenticator == nil || auth.ExcludedRoute(string(ctx.Request.URI().FullURI())) {
 			next(ctx)
-		} else {
-			ctx.Error("invalid api token", http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := authenticator.Authenticate(string(ctx.Request.Header.Peek(auth.AuthorizationHeader)))
+		if err != nil {
+			ctx.Error(err.Error(), http.StatusUnauthorized)
+			return
 		}
+		for name, value := range claims {
+			ctx.Request.Header.Set(auth.CallerClaimHeader(name), value)
+		}
+		next(ctx)
+	}
+}
+
+func removeCallerClaimHeaders(header *fasthttp.RequestHeader) {
+	var keys []string
+	header.VisitAll(func(key []byte, value []byte) {
+		if k := string(key); auth.IsCallerClaimHeader(k) {
+			keys = append(keys, k)
+		}
+	})
+	for _, k := range keys {
+		header.Del(k)
 	}
 }
 
@@ -134,9 +219,51 @@ func (s *server) getRouter(endpoints []Endpoint) *routing.Router {
 
 	for _, e := range endpoints {
 		path := fmt.Sprintf("/%s/%s", e.Version, e.Route)
+		handler := e.Handler
+		if !e.Streaming {
+			handler = limitRequestBody(handler)
+		}
 		for _, m := range e.Methods {
-			router.Handle(m, path, e.Handler)
+			router.Handle(m, path, handler)
 		}
 	}
 	return router
 }
+
+// limitRequestBody buffers the request body for handlers that don't stream it
+func limitRequestBody(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(reqCtx *fasthttp.RequestCtx) {
+		if bufferRequestBody(reqCtx) {
+			next(reqCtx)
+		}
+	}
+}
+
+// bufferRequestBody reads a streamed request body in memory, responding with 413 and returning false
+// if it's larger than maxRequestBodySize
+func bufferRequestBody(reqCtx *fasthttp.RequestCtx) bool {
+	if !reqCtx.Request.IsBodyStream() {
+		return true
+	}
+
+	msg := NewErrorResponse("ERR_REQUEST_BODY_TOO_LARGE", fmt.Sprintf("request body is larger than %d bytes", maxRequestBodySize))
+	if reqCtx.Request.Header.ContentLength() > maxRequestBodySize {
+		// the rest of the body is left unread, so the connection can't be reused
+		reqCtx.SetConnectionClose()
+		respondWithError(reqCtx, fasthttp.StatusRequestEntityTooLarge, msg)
+		return false
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(reqCtx.RequestBodyStream(), maxRequestBodySize+1))
+	if err != nil {
+		msg = NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+		return false
+	}
+	if len(body) > maxRequestBodySize {
+		reqCtx.SetConnectionClose()
+		respondWithError(reqCtx, fasthttp.StatusRequestEntityTooLarge, msg)
+		return false
+	}
+	reqCtx.Request.SetBody(body)
+	return true
+}
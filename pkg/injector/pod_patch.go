@@ -38,7 +38,16 @@ const (
 	daprAPITokenSecret                = "dapr.io/api-token-secret" /* #nosec */
 	daprLogAsJSON                     = "dapr.io/log-as-json"
 	daprAppMaxConcurrencyKey          = "dapr.io/app-max-concurrency"
+	daprAppSSLKey                     = "dapr.io/app-ssl"
+	daprAppCACertSecretKey            = "dapr.io/app-ca-cert-secret" /* #nosec */
+	daprAppUnixSocketKey              = "dapr.io/app-unix-socket"
+	daprUnixDomainSocketKey           = "dapr.io/unix-domain-socket"
 	daprMetricsPortKey                = "dapr.io/metrics-port"
+	daprMetricsExportersKey           = "dapr.io/metrics-exporters"
+	daprMetricsOTLPEndpointKey        = "dapr.io/metrics-otlp-endpoint"
+	daprMetricsOTLPProtocolKey        = "dapr.io/metrics-otlp-protocol"
+	daprMetricsStatsdAddressKey       = "dapr.io/metrics-statsd-address"
+	daprMetricsPushIntervalKey        = "dapr.io/metrics-push-interval"
 	daprCPULimitKey                   = "dapr.io/sidecar-cpu-limit"
 	daprMemoryLimitKey                = "dapr.io/sidecar-memory-limit"
 	daprCPURequestKey                 = "dapr.io/sidecar-cpu-request"
@@ -67,6 +76,11 @@ const (
 	defaultLogLevel                   = "info"
 	defaultLogAsJSON                  = false
 	kubernetesMountPath               = "/var/run/secrets/kubernetes.io/serviceaccount"
+	appCACertVolumeName               = "dapr-app-ca-cert"
+	appCACertMountPath                = "/var/run/dapr/app-ca-cert"
+	appCACertFileName                 = "ca.crt"
+	appUnixSocketVolumeName           = "dapr-app-unix-socket"
+	unixDomainSocketVolumeName        = "dapr-unix-domain-socket"
 	defaultConfig                     = "daprsystem"
 	defaultMetricsPort                = 9090
 	sidecarHealthzPath                = "healthz"
@@ -86,6 +100,13 @@ const (
 	daprProtocolKey       = "dapr.io/protocol"
 )
 
+var (
+	// unsafeUnixSocketDirs are the directories that can't hold the Unix domain sockets
+	unsafeUnixSocketDirs = []string{"/", "/bin", "/boot", "/etc", "/lib", "/lib64", "/root", "/sbin", "/usr", "/var", "/var/run", "/run"}
+	// unsafeUnixSocketParentDirs are the directories whose subdirectories can't hold the Unix domain sockets either
+	unsafeUnixSocketParentDirs = []string{"/dev", "/proc", "/sys", "/var/run/secrets", "/run/secrets"}
+)
+
 func (i *injector) getPodPatchOperations(ar *v1beta1.AdmissionReview,
 	namespace, image string, kubeClient *kubernetes.Clientset, daprClient scheme.Interface) ([]PatchOperation, error) {
 	req := ar.Request
@@ -156,10 +177,137 @@ func (i *injector) getPodPatchOperations(ar *v1beta1.AdmissionReview,
 		},
 	)
 	patchOps = append(patchOps, envPatchOps...)
+	volumePatchOps, err := getVolumePatchOperations(pod, pod.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	patchOps = append(patchOps, volumePatchOps...)
 
 	return patchOps, nil
 }
 
+// getVolumePatchOperations adds the volumes mounted by the sidecar container to the pod, and mounts the volumes
+// sharing Unix domain sockets with the app in the user containers.
+func getVolumePatchOperations(pod corev1.Pod, annotations map[string]string) ([]PatchOperation, error) {
+	volumes := []corev1.Volume{}
+	socketMounts := []corev1.VolumeMount{}
+
+	if secret := getAppCACertSecret(annotations); secret != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: appCACertVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: secret},
+			},
+		})
+	}
+	unixSocketMounts, err := getUnixSocketVolumeMounts(annotations)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range unixSocketMounts {
+		volumes = append(volumes, corev1.Volume{
+			Name: m.Name,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+		socketMounts = append(socketMounts, m)
+	}
+	if len(volumes) == 0 {
+		return nil, nil
+	}
+
+	var patchOps []PatchOperation
+	if len(pod.Spec.Volumes) == 0 {
+		patchOps = append(patchOps, PatchOperation{
+			Op:    "add",
+			Path:  "/spec/volumes",
+			Value: volumes,
+		})
+	} else {
+		for _, v := range volumes {
+			patchOps = append(patchOps, PatchOperation{
+				Op:    "add",
+				Path:  "/spec/volumes/-",
+				Value: v,
+			})
+		}
+	}
+
+	if len(socketMounts) == 0 {
+		return patchOps, nil
+	}
+	for i, container := range pod.Spec.Containers {
+		path := fmt.Sprintf("%s/%d/volumeMounts", containersPath, i)
+		if len(container.VolumeMounts) == 0 {
+			patchOps = append(patchOps, PatchOperation{
+				Op:    "add",
+				Path:  path,
+				Value: socketMounts,
+			})
+			continue
+		}
+		for _, m := range socketMounts {
+			patchOps = append(patchOps, PatchOperation{
+				Op:    "add",
+				Path:  path + "/-",
+				Value: m,
+			})
+		}
+	}
+	return patchOps, nil
+}
+
+// getUnixSocketVolumeMounts returns the mounts of the directories holding the Unix domain sockets shared by the
+// sidecar and the app. Both sockets share a single volume when they are in the same directory.
+func getUnixSocketVolumeMounts(annotations map[string]string) ([]corev1.VolumeMount, error) {
+	mounts := []corev1.VolumeMount{}
+	if socket := getAppUnixSocket(annotations); socket != "" {
+		dir := path.Dir(socket)
+		if err := validateUnixSocketDir(dir); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s annotation %s", daprAppUnixSocketKey, socket)
+		}
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      appUnixSocketVolumeName,
+			MountPath: dir,
+		})
+	}
+	if dir := getUnixDomainSocket(annotations); dir != "" {
+		if err := validateUnixSocketDir(dir); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s annotation %s", daprUnixDomainSocketKey, dir)
+		}
+		if len(mounts) > 0 && mounts[0].MountPath == path.Clean(dir) {
+			return mounts, nil
+		}
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      unixDomainSocketVolumeName,
+			MountPath: dir,
+		})
+	}
+	return mounts, nil
+}
+
+// validateUnixSocketDir rejects the directories that can't be replaced by an empty volume in the containers:
+// the root and the system directories, or any directory under the ones holding devices, kernel interfaces
+// and the service account token.
+func validateUnixSocketDir(dir string) error {
+	if !path.IsAbs(dir) {
+		return errors.New("the socket directory must be an absolute path")
+	}
+	dir = path.Clean(dir)
+	for _, unsafe := range unsafeUnixSocketDirs {
+		if dir == unsafe {
+			return errors.Errorf("the socket directory can't be %s", dir)
+		}
+	}
+	for _, unsafe := range unsafeUnixSocketParentDirs {
+		if dir == unsafe || strings.HasPrefix(dir, unsafe+"/") {
+			return errors.Errorf("the socket directory can't be in %s", unsafe)
+		}
+	}
+	return nil
+}
+
 // This function add Dapr environment variables to all the containers in any Dapr enabled pod.
 // The containers can be injected or user defined.
 func addDaprEnvVarsToContainers(containers []corev1.Container) []PatchOperation {
@@ -305,6 +453,23 @@ func getAppID(pod corev1.Pod) string {
 	return getStringAnnotationOrDefault(pod.Annotations, idKey, pod.GetName())
 }
 
+// getMetricsExporterArgs returns the daprd flags of the metrics exporters set in the annotations
+func getMetricsExporterArgs(annotations map[string]string) []string {
+	var args []string
+	for _, key := range []string{
+		daprMetricsExportersKey,
+		daprMetricsOTLPEndpointKey,
+		daprMetricsOTLPProtocolKey,
+		daprMetricsStatsdAddressKey,
+		daprMetricsPushIntervalKey,
+	} {
+		if val := getStringAnnotation(annotations, key); val != "" {
+			args = append(args, "--"+strings.TrimPrefix(key, "dapr.io/"), val)
+		}
+	}
+	return args
+}
+
 func getLogLevel(annotations map[string]string) string {
 	return getStringAnnotationOrDefault(annotations, daprLogLevel, defaultLogLevel)
 }
@@ -323,6 +488,22 @@ func profilingEnabled(annotations map[string]string) bool {
 	return isEnabled
 }
 
+func appSSLEnabled(annotations map[string]string) bool {
+	return getBoolAnnotationOrDefault(annotations, daprAppSSLKey, false)
+}
+
+func getAppCACertSecret(annotations map[string]string) string {
+	return getStringAnnotationOrDefault(annotations, daprAppCACertSecretKey, "")
+}
+
+func getAppUnixSocket(annotations map[string]string) string {
+	return getStringAnnotationOrDefault(annotations, daprAppUnixSocketKey, "")
+}
+
+func getUnixDomainSocket(annotations map[string]string) string {
+	return getStringAnnotationOrDefault(annotations, daprUnixDomainSocketKey, "")
+}
+
 func getAPITokenSecret(annotations map[string]string) string {
 	return getStringAnnotationOrDefault(annotations, daprAPITokenSecret, "")
 }
@@ -543,6 +724,8 @@ func getSidecarContainer(annotations map[string]string, id, daprSidecarImage, na
 		}
 	}
 
+	c.Args = append(c.Args, getMetricsExporterArgs(annotations)...)
+
 	if logAsJSONEnabled(annotations) {
 		c.Args = append(c.Args, "--log-as-json")
 	}
@@ -551,6 +734,39 @@ func getSidecarContainer(annotations map[string]string, id, daprSidecarImage, na
 		c.Args = append(c.Args, "--enable-profiling")
 	}
 
+	if appSSLEnabled(annotations) {
+		c.Args = append(c.Args, "--app-ssl")
+	}
+
+	if secret := getAppCACertSecret(annotations); secret != "" {
+		c.Args = append(c.Args, "--app-ca-cert", path.Join(appCACertMountPath, appCACertFileName))
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+			Name:      appCACertVolumeName,
+			MountPath: appCACertMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	if socket := getAppUnixSocket(annotations); socket != "" {
+		c.Args = append(c.Args, "--app-unix-socket", socket)
+	}
+
+	if dir := getUnixDomainSocket(annotations); dir != "" {
+		c.Args = append(c.Args, "--unix-domain-socket", dir)
+		// the Dapr HTTP API doesn't listen on its port anymore, the sidecar is probed on its internal gRPC port
+		tcpHandler := corev1.Handler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(sidecarInternalGRPCPort)},
+		}
+		c.ReadinessProbe.Handler = tcpHandler
+		c.LivenessProbe.Handler = tcpHandler
+	}
+
+	unixSocketMounts, err := getUnixSocketVolumeMounts(annotations)
+	if err != nil {
+		return nil, err
+	}
+	c.VolumeMounts = append(c.VolumeMounts, unixSocketMounts...)
+
 	if mtlsEnabled && trustAnchors != "" {
 		c.Args = append(c.Args, "--enable-mtls")
 		c.Env = append(c.Env, corev1.EnvVar{
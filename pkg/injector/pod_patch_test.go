@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -42,6 +43,42 @@ func TestLogAsJSONEnabled(t *testing.T) {
 	})
 }
 
+func TestAppSSLEnabled(t *testing.T) {
+	t.Run("dapr.io/app-ssl is true", func(t *testing.T) {
+		fakeAnnotation := map[string]string{
+			daprAppSSLKey: "true",
+		}
+
+		assert.Equal(t, true, appSSLEnabled(fakeAnnotation))
+	})
+
+	t.Run("dapr.io/app-ssl is not given", func(t *testing.T) {
+		fakeAnnotation := map[string]string{}
+
+		assert.Equal(t, false, appSSLEnabled(fakeAnnotation))
+	})
+}
+
+func TestGetMetricsExporterArgs(t *testing.T) {
+	t.Run("metrics exporters are given", func(t *testing.T) {
+		fakeAnnotation := map[string]string{
+			daprMetricsExportersKey:    "prometheus,otlp",
+			daprMetricsOTLPEndpointKey: "otel-collector:4317",
+		}
+
+		assert.Equal(t, []string{
+			"--metrics-exporters", "prometheus,otlp",
+			"--metrics-otlp-endpoint", "otel-collector:4317",
+		}, getMetricsExporterArgs(fakeAnnotation))
+	})
+
+	t.Run("metrics exporters are not given", func(t *testing.T) {
+		fakeAnnotation := map[string]string{}
+
+		assert.Empty(t, getMetricsExporterArgs(fakeAnnotation))
+	})
+}
+
 func TestFormatProbePath(t *testing.T) {
 	testCases := []struct {
 		given    []string
@@ -118,6 +155,150 @@ func TestGetSideCarContainer(t *testing.T) {
 	assert.EqualValues(t, expectedArgs, container.Args)
 }
 
+func TestGetSideCarContainerWithAppCACertAndUnixSockets(t *testing.T) {
+	annotations := map[string]string{
+		daprAppSSLKey:           "true",
+		daprAppCACertSecretKey:  "app-ca",
+		daprAppUnixSocketKey:    "/tmp/app/app.socket",
+		daprUnixDomainSocketKey: "/tmp/dapr",
+	}
+
+	container, _ := getSidecarContainer(annotations, "app_id", "darpio/dapr", "dapr-system", "controlplane:9000", "placement:50000", nil, "", "", "", "sentry:50000", false, "")
+
+	args := strings.Join(container.Args, " ")
+	assert.Contains(t, args, "--app-ssl --app-ca-cert /var/run/dapr/app-ca-cert/ca.crt")
+	assert.Contains(t, args, "--app-unix-socket /tmp/app/app.socket")
+	assert.Contains(t, args, "--unix-domain-socket /tmp/dapr")
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: appCACertVolumeName, MountPath: appCACertMountPath, ReadOnly: true},
+		{Name: appUnixSocketVolumeName, MountPath: "/tmp/app"},
+		{Name: unixDomainSocketVolumeName, MountPath: "/tmp/dapr"},
+	}, container.VolumeMounts)
+	assert.Nil(t, container.ReadinessProbe.HTTPGet)
+	assert.Equal(t, intstr.FromInt(sidecarInternalGRPCPort), container.ReadinessProbe.TCPSocket.Port)
+}
+
+func TestGetVolumePatchOperations(t *testing.T) {
+	t.Run("no volumes", func(t *testing.T) {
+		pod := corev1.Pod{}
+
+		patchOps, err := getVolumePatchOperations(pod, map[string]string{daprAppSSLKey: "true"})
+		assert.NoError(t, err)
+		assert.Empty(t, patchOps)
+	})
+
+	t.Run("app CA certificate secret", func(t *testing.T) {
+		pod := corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}},
+			},
+		}
+
+		patchOps, err := getVolumePatchOperations(pod, map[string]string{daprAppCACertSecretKey: "app-ca"})
+		assert.NoError(t, err)
+		assert.Equal(t, []PatchOperation{
+			{
+				Op:   "add",
+				Path: "/spec/volumes",
+				Value: []corev1.Volume{
+					{
+						Name: appCACertVolumeName,
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{SecretName: "app-ca"},
+						},
+					},
+				},
+			},
+		}, patchOps)
+	})
+
+	t.Run("unix sockets shared with existing volumes", func(t *testing.T) {
+		pod := corev1.Pod{
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{Name: "data"}},
+				Containers: []corev1.Container{
+					{Name: "app", VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}},
+				},
+			},
+		}
+
+		patchOps, err := getVolumePatchOperations(pod, map[string]string{daprUnixDomainSocketKey: "/tmp/dapr"})
+		assert.NoError(t, err)
+		emptyDir := corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		assert.Equal(t, []PatchOperation{
+			{
+				Op:    "add",
+				Path:  "/spec/volumes/-",
+				Value: corev1.Volume{Name: unixDomainSocketVolumeName, VolumeSource: emptyDir},
+			},
+			{
+				Op:    "add",
+				Path:  "/spec/containers/0/volumeMounts/-",
+				Value: corev1.VolumeMount{Name: unixDomainSocketVolumeName, MountPath: "/tmp/dapr"},
+			},
+		}, patchOps)
+	})
+
+	t.Run("unix sockets in the same directory share a volume", func(t *testing.T) {
+		pod := corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}},
+			},
+		}
+
+		patchOps, err := getVolumePatchOperations(pod, map[string]string{
+			daprAppUnixSocketKey:    "/tmp/dapr/app.socket",
+			daprUnixDomainSocketKey: "/tmp/dapr/",
+		})
+		assert.NoError(t, err)
+		emptyDir := corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		assert.Equal(t, []PatchOperation{
+			{
+				Op:    "add",
+				Path:  "/spec/volumes",
+				Value: []corev1.Volume{{Name: appUnixSocketVolumeName, VolumeSource: emptyDir}},
+			},
+			{
+				Op:    "add",
+				Path:  "/spec/containers/0/volumeMounts",
+				Value: []corev1.VolumeMount{{Name: appUnixSocketVolumeName, MountPath: "/tmp/dapr"}},
+			},
+		}, patchOps)
+	})
+
+	t.Run("unsafe unix socket directories are rejected", func(t *testing.T) {
+		pod := corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}},
+			},
+		}
+
+		for _, annotations := range []map[string]string{
+			{daprAppUnixSocketKey: "/app.socket"},
+			{daprAppUnixSocketKey: "app.socket"},
+			{daprAppUnixSocketKey: "/etc/app.socket"},
+			{daprUnixDomainSocketKey: "/"},
+			{daprUnixDomainSocketKey: "/tmp/../"},
+			{daprUnixDomainSocketKey: "tmp/dapr"},
+			{daprUnixDomainSocketKey: "/usr"},
+			{daprUnixDomainSocketKey: "/proc/self"},
+			{daprUnixDomainSocketKey: "/var/run/secrets/kubernetes.io"},
+		} {
+			_, err := getVolumePatchOperations(pod, annotations)
+			assert.Error(t, err, "%v", annotations)
+		}
+	})
+}
+
+func TestGetSideCarContainerWithUnsafeUnixSocket(t *testing.T) {
+	annotations := map[string]string{
+		daprUnixDomainSocketKey: "/",
+	}
+
+	_, err := getSidecarContainer(annotations, "app_id", "darpio/dapr", "dapr-system", "controlplane:9000", "placement:50000", nil, "", "", "", "sentry:50000", false, "")
+	assert.Error(t, err)
+}
+
 func TestAddDaprEnvVarsToContainers(t *testing.T) {
 	testCases := []struct {
 		testName      string
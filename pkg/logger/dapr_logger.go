@@ -1,11 +1,13 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"time"
 
 	"github.com/dapr/dapr/pkg/version"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 )
 
 // daprLogger is the implemention for logrus
@@ -29,6 +31,9 @@ func newDaprLogger(name string) *daprLogger {
 	}
 
 	dl.EnableJSONOutput(defaultJSONOutput)
+	if globalSinks != nil {
+		globalSinks.apply(dl)
+	}
 
 	return dl
 }
@@ -84,6 +89,23 @@ func (l *daprLogger) SetOutputLevel(outputLevel LogLevel) {
 	l.logger.Logger.SetLevel(toLogrusLevel(outputLevel))
 }
 
+// OutputLevel returns the log output level
+func (l *daprLogger) OutputLevel() LogLevel {
+	switch l.logger.Logger.GetLevel() {
+	case logrus.DebugLevel, logrus.TraceLevel:
+		return DebugLevel
+	case logrus.InfoLevel:
+		return InfoLevel
+	case logrus.WarnLevel:
+		return WarnLevel
+	case logrus.ErrorLevel:
+		return ErrorLevel
+	case logrus.FatalLevel, logrus.PanicLevel:
+		return FatalLevel
+	}
+	return UndefinedLevel
+}
+
 // WithLogType specify the log_type field in log. Default value is LogTypeLog
 func (l *daprLogger) WithLogType(logType string) Logger {
 	return &daprLogger{
@@ -92,6 +114,32 @@ func (l *daprLogger) WithLogType(logType string) Logger {
 	}
 }
 
+// spanFromContext returns the span of the context passed to WithContext. The runtime replaces it at start-up
+// with the lookup of diagnostics/utils, which can't be imported here, that also finds the span of a fasthttp request.
+var spanFromContext = trace.FromContext
+
+// SetSpanFromContext sets the function returning the span of the context passed to WithContext
+func SetSpanFromContext(fn func(ctx context.Context) *trace.Span) {
+	spanFromContext = fn
+}
+
+// WithContext adds the trace_id and span_id fields of the span in ctx to log, if there is one
+func (l *daprLogger) WithContext(ctx context.Context) Logger {
+	span := spanFromContext(ctx)
+	if span == nil {
+		return l
+	}
+
+	sc := span.SpanContext()
+	return &daprLogger{
+		name: l.name,
+		logger: l.logger.WithFields(logrus.Fields{
+			logFieldTraceID: sc.TraceID.String(),
+			logFieldSpanID:  sc.SpanID.String(),
+		}),
+	}
+}
+
 // Info logs a message at level Info.
 func (l *daprLogger) Info(args ...interface{}) {
 	l.logger.Log(logrus.InfoLevel, args...)
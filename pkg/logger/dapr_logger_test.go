@@ -2,6 +2,7 @@ package logger
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"github.com/dapr/dapr/pkg/version"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
 )
 
 const fakeLoggerName = "fakeLogger"
@@ -177,6 +179,64 @@ func TestWithTypeFields(t *testing.T) {
 	assert.Equalf(t, LogTypeLog, o[logFieldType], "testLogger must be %s type", LogTypeLog)
 }
 
+func TestWithContextFields(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(InfoLevel)
+
+	t.Run("context without span", func(t *testing.T) {
+		testLogger.WithContext(context.Background()).Info("no span")
+
+		b, _ := buf.ReadBytes('\n')
+		var o map[string]interface{}
+		json.Unmarshal(b, &o)
+
+		assert.NotContains(t, o, logFieldTraceID)
+		assert.NotContains(t, o, logFieldSpanID)
+	})
+
+	t.Run("context with span", func(t *testing.T) {
+		ctx, span := trace.StartSpan(context.Background(), "test")
+		defer span.End()
+		testLogger.WithContext(ctx).Info("with span")
+
+		b, _ := buf.ReadBytes('\n')
+		var o map[string]interface{}
+		json.Unmarshal(b, &o)
+
+		assert.Equal(t, span.SpanContext().TraceID.String(), o[logFieldTraceID])
+		assert.Equal(t, span.SpanContext().SpanID.String(), o[logFieldSpanID])
+	})
+
+	t.Run("span from the lookup set with SetSpanFromContext", func(t *testing.T) {
+		_, span := trace.StartSpan(context.Background(), "test")
+		defer span.End()
+		SetSpanFromContext(func(ctx context.Context) *trace.Span {
+			return span
+		})
+		defer SetSpanFromContext(trace.FromContext)
+		testLogger.WithContext(context.Background()).Info("with span")
+
+		b, _ := buf.ReadBytes('\n')
+		var o map[string]interface{}
+		json.Unmarshal(b, &o)
+
+		assert.Equal(t, span.SpanContext().TraceID.String(), o[logFieldTraceID])
+		assert.Equal(t, span.SpanContext().SpanID.String(), o[logFieldSpanID])
+	})
+}
+
+func TestOutputLevel(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+
+	for _, lvl := range []LogLevel{DebugLevel, InfoLevel, WarnLevel, ErrorLevel, FatalLevel} {
+		testLogger.SetOutputLevel(lvl)
+		assert.Equal(t, lvl, testLogger.OutputLevel())
+	}
+}
+
 func TestToLogrusLevel(t *testing.T) {
 	t.Run("Dapr DebugLevel to Logrus.DebugLevel", func(t *testing.T) {
 		assert.Equal(t, logrus.DebugLevel, toLogrusLevel(DebugLevel))
@@ -0,0 +1,170 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	bytesPerMegabyte = 1024 * 1024
+	// backupTimeFormat is the format of the timestamp suffix of the rotated log files
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+)
+
+// rotatingFileWriter writes the logs to a file, which is renamed with a timestamp suffix and replaced
+// by a new file when it exceeds its maximum size or age
+type rotatingFileWriter struct {
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	lock     sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	closed   bool
+}
+
+func newRotatingFileWriter(options *Options) (*rotatingFileWriter, error) {
+	if options.FilePath == "" {
+		return nil, errors.New("--log-file is required by the file log output")
+	}
+	maxSize, err := strconv.ParseInt(options.FileMaxSize, 10, 64)
+	if err != nil || maxSize < 0 {
+		return nil, errors.Errorf("invalid value for --log-file-max-size: %s", options.FileMaxSize)
+	}
+	maxAge, err := time.ParseDuration(options.FileMaxAge)
+	if err != nil || maxAge < 0 {
+		return nil, errors.Errorf("invalid value for --log-file-max-age: %s", options.FileMaxAge)
+	}
+	maxBackups, err := strconv.Atoi(options.FileMaxBackups)
+	if err != nil || maxBackups < 0 {
+		return nil, errors.Errorf("invalid value for --log-file-max-backups: %s", options.FileMaxBackups)
+	}
+
+	w := &rotatingFileWriter{
+		path:       options.FilePath,
+		maxSize:    maxSize * bytesPerMegabyte,
+		maxAge:     maxAge,
+		maxBackups: maxBackups,
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write writes a formatted log entry, after rotating the file if the entry doesn't fit in it
+func (w *rotatingFileWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+	if w.file == nil {
+		// a previous rotation renamed the log file but failed to open a new one
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+	if w.size > 0 && ((w.maxSize > 0 && w.size+int64(len(p)) > w.maxSize) ||
+		(w.maxAge > 0 && time.Since(w.openedAt) >= w.maxAge)) {
+		// the entry is still written when the log file is reopened after a failed rotation
+		if err := w.rotate(); err != nil && w.file == nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes the log file
+func (w *rotatingFileWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.closed = true
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// open opens the log file for appending, creating it and its directory if needed
+func (w *rotatingFileWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.path), 0755); err != nil {
+		return errors.Wrap(err, "failed to create the log file directory")
+	}
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open the log file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to open the log file")
+	}
+
+	w.file = f
+	w.size = info.Size()
+	w.openedAt = time.Now()
+	return nil
+}
+
+// rotate renames the log file with a timestamp suffix, opens a new one and removes the oldest backups.
+// The log file is reopened for appending when it can't be renamed.
+func (w *rotatingFileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+	if err := os.Rename(w.path, w.path+"."+time.Now().Format(backupTimeFormat)); err != nil {
+		if openErr := w.open(); openErr != nil {
+			return openErr
+		}
+		return errors.Wrap(err, "failed to rotate the log file")
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	return w.removeOldBackups()
+}
+
+func (w *rotatingFileWriter) removeOldBackups() error {
+	if w.maxBackups == 0 {
+		return nil
+	}
+	backups, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return err
+	}
+	if len(backups) <= w.maxBackups {
+		return nil
+	}
+
+	// the timestamp suffixes sort the backups from the oldest to the newest
+	sort.Strings(backups)
+	for _, b := range backups[:len(backups)-w.maxBackups] {
+		if err := os.Remove(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
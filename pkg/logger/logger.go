@@ -6,8 +6,13 @@
 package logger
 
 import (
+	"context"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -25,6 +30,8 @@ const (
 	logFieldInstance  = "instance"
 	logFieldDaprVer   = "ver"
 	logFieldAppID     = "app_id"
+	logFieldTraceID   = "trace_id"
+	logFieldSpanID    = "span_id"
 )
 
 // LogLevel is Dapr Logger Level type
@@ -47,10 +54,12 @@ const (
 )
 
 // globalLoggers is the collection of Dapr Logger that is shared globally.
-// TODO: User will disable or enable logger on demand.
 var globalLoggers = map[string]Logger{}
 var globalLoggersLock = sync.RWMutex{}
 
+// levelUpdatesEnabled is set to 1 when the log levels can be set with the loggers endpoints
+var levelUpdatesEnabled int32
+
 // Logger includes the logging api sets
 type Logger interface {
 	// EnableJSONOutput enables JSON formatted output log
@@ -60,9 +69,13 @@ type Logger interface {
 	SetAppID(id string)
 	// SetOutputLevel sets log output level
 	SetOutputLevel(outputLevel LogLevel)
+	// OutputLevel returns the log output level
+	OutputLevel() LogLevel
 
 	// WithLogType specify the log_type field in log. Default value is LogTypeLog
 	WithLogType(logType string) Logger
+	// WithContext adds the trace_id and span_id fields of the span in ctx to log, if there is one
+	WithContext(ctx context.Context) Logger
 
 	// Info logs a message at level Info.
 	Info(args ...interface{})
@@ -130,3 +143,59 @@ func getLoggers() map[string]Logger {
 
 	return l
 }
+
+// ScopeLevel is the log output level of a logger scope
+type ScopeLevel struct {
+	Scope string   `json:"scope"`
+	Level LogLevel `json:"level"`
+}
+
+// ListScopeLevels returns the log output levels of all registered loggers, sorted by scope
+func ListScopeLevels() []ScopeLevel {
+	loggers := getLoggers()
+
+	levels := make([]ScopeLevel, 0, len(loggers))
+	for k, v := range loggers {
+		levels = append(levels, ScopeLevel{Scope: k, Level: v.OutputLevel()})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Scope < levels[j].Scope
+	})
+
+	return levels
+}
+
+// SetScopeLevel sets the log output level of the logger of the given scope at runtime.
+// The level also applies to nested scopes, e.g. setting dapr.runtime sets dapr.runtime.actor.
+func SetScopeLevel(scope string, level string) error {
+	logLevel := toLogLevel(level)
+	if logLevel == UndefinedLevel {
+		return errors.Errorf("undefined log level: %s", level)
+	}
+
+	found := false
+	for k, v := range getLoggers() {
+		if k == scope || strings.HasPrefix(k, scope+".") {
+			v.SetOutputLevel(logLevel)
+			found = true
+		}
+	}
+	if !found {
+		return errors.Errorf("no logger found for scope: %s", scope)
+	}
+	return nil
+}
+
+// LevelUpdatesEnabled returns whether the log levels of logger scopes can be set with the loggers endpoints
+// of the healthz server and of the Dapr HTTP API, as set by the --log-level-updates flag
+func LevelUpdatesEnabled() bool {
+	return atomic.LoadInt32(&levelUpdatesEnabled) == 1
+}
+
+func setLevelUpdatesEnabled(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&levelUpdatesEnabled, v)
+}
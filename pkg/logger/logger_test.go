@@ -61,3 +61,42 @@ func TestToLogLevel(t *testing.T) {
 		assert.Equal(t, UndefinedLevel, toLogLevel("undefined"))
 	})
 }
+
+func TestScopeLevels(t *testing.T) {
+	clearLoggers()
+	runtimeLogger := NewLogger("dapr.runtime")
+	actorLogger := NewLogger("dapr.runtime.actor")
+	operatorLogger := NewLogger("dapr.operator")
+	for _, l := range []Logger{runtimeLogger, actorLogger, operatorLogger} {
+		l.SetOutputLevel(InfoLevel)
+	}
+
+	t.Run("list scope levels", func(t *testing.T) {
+		assert.Equal(t, []ScopeLevel{
+			{Scope: "dapr.operator", Level: InfoLevel},
+			{Scope: "dapr.runtime", Level: InfoLevel},
+			{Scope: "dapr.runtime.actor", Level: InfoLevel},
+		}, ListScopeLevels())
+	})
+
+	t.Run("set nested scope level", func(t *testing.T) {
+		assert.NoError(t, SetScopeLevel("dapr.runtime.actor", "debug"))
+		assert.Equal(t, InfoLevel, runtimeLogger.OutputLevel())
+		assert.Equal(t, DebugLevel, actorLogger.OutputLevel())
+	})
+
+	t.Run("set parent scope level", func(t *testing.T) {
+		assert.NoError(t, SetScopeLevel("dapr.runtime", "warn"))
+		assert.Equal(t, WarnLevel, runtimeLogger.OutputLevel())
+		assert.Equal(t, WarnLevel, actorLogger.OutputLevel())
+		assert.Equal(t, InfoLevel, operatorLogger.OutputLevel())
+	})
+
+	t.Run("undefined level", func(t *testing.T) {
+		assert.Error(t, SetScopeLevel("dapr.runtime", "verbose"))
+	})
+
+	t.Run("scope prefix is not a parent scope", func(t *testing.T) {
+		assert.Error(t, SetScopeLevel("dapr.run", "debug"))
+	})
+}
@@ -13,6 +13,13 @@ const (
 	defaultJSONOutput  = false
 	defaultOutputLevel = "info"
 	undefinedAppID     = ""
+
+	defaultOutputs        = StdoutOutput
+	defaultFileMaxSize    = "100"
+	defaultFileMaxAge     = "24h"
+	defaultFileMaxBackups = "7"
+	defaultOTLPProtocol   = "grpc"
+	defaultSyslogAddress  = "udp://localhost:514"
 )
 
 // Options defines the sets of options for Dapr logging
@@ -25,6 +32,28 @@ type Options struct {
 
 	// OutputLevel is the level of logging
 	OutputLevel string
+
+	// Outputs is the comma separated list of the outputs of the logs: stdout, file, otlp and syslog
+	Outputs string
+
+	// FilePath is the path of the log file of the file output
+	FilePath string
+	// FileMaxSize is the size in megabytes at which the log file is rotated, 0 disables size-based rotation
+	FileMaxSize string
+	// FileMaxAge is the duration after which the log file is rotated, 0 disables age-based rotation
+	FileMaxAge string
+	// FileMaxBackups is the number of rotated log files that are kept, 0 keeps all of them
+	FileMaxBackups string
+
+	// OTLPEndpoint and OTLPProtocol are the address and protocol of the OpenTelemetry collector of the otlp output
+	OTLPEndpoint string
+	OTLPProtocol string
+
+	// SyslogAddress is the address of the syslog server of the syslog output, with a udp:// or tcp:// scheme
+	SyslogAddress string
+
+	// LevelUpdatesEnabled allows setting the log levels of logger scopes with the loggers endpoints of the healthz server and the Dapr HTTP API
+	LevelUpdatesEnabled bool
 }
 
 // SetOutputLevel sets the log output level
@@ -55,6 +84,51 @@ func (o *Options) AttachCmdFlags(
 		"log-as-json",
 		defaultJSONOutput,
 		"print log as JSON (default false)")
+	stringVar(
+		&o.Outputs,
+		"log-outputs",
+		defaultOutputs,
+		"Comma separated list of the log outputs: stdout, file, otlp, syslog")
+	stringVar(
+		&o.FilePath,
+		"log-file",
+		"",
+		"Path of the log file of the file output")
+	stringVar(
+		&o.FileMaxSize,
+		"log-file-max-size",
+		defaultFileMaxSize,
+		"Size in megabytes at which the log file is rotated, 0 disables size-based rotation")
+	stringVar(
+		&o.FileMaxAge,
+		"log-file-max-age",
+		defaultFileMaxAge,
+		"Duration after which the log file is rotated, 0 disables age-based rotation")
+	stringVar(
+		&o.FileMaxBackups,
+		"log-file-max-backups",
+		defaultFileMaxBackups,
+		"Number of rotated log files that are kept, 0 keeps all of them")
+	stringVar(
+		&o.OTLPEndpoint,
+		"log-otlp-endpoint",
+		"",
+		"Address of the OpenTelemetry collector of the otlp output: host:port for grpc, with an http:// scheme to disable TLS, or the URL of the logs endpoint for http")
+	stringVar(
+		&o.OTLPProtocol,
+		"log-otlp-protocol",
+		defaultOTLPProtocol,
+		"Protocol of the OpenTelemetry collector of the otlp output: grpc or http")
+	stringVar(
+		&o.SyslogAddress,
+		"log-syslog-address",
+		defaultSyslogAddress,
+		"Address of the syslog server of the syslog output, with a udp:// or tcp:// scheme")
+	boolVar(
+		&o.LevelUpdatesEnabled,
+		"log-level-updates",
+		false,
+		"Allow setting the log levels of logger scopes at runtime with PUT /loggers/{scope} on the healthz port and PUT /v1.0/loggers/{scope} on the Dapr HTTP API (default false)")
 }
 
 // DefaultOptions returns default values of Options
@@ -63,6 +137,12 @@ func DefaultOptions() Options {
 		JSONFormatEnabled: defaultJSONOutput,
 		appID:             undefinedAppID,
 		OutputLevel:       defaultOutputLevel,
+		Outputs:           defaultOutputs,
+		FileMaxSize:       defaultFileMaxSize,
+		FileMaxAge:        defaultFileMaxAge,
+		FileMaxBackups:    defaultFileMaxBackups,
+		OTLPProtocol:      defaultOTLPProtocol,
+		SyslogAddress:     defaultSyslogAddress,
 	}
 }
 
@@ -87,5 +167,7 @@ func ApplyOptionsToLoggers(options *Options) error {
 	for _, v := range internalLoggers {
 		v.SetOutputLevel(daprLogLevel)
 	}
-	return nil
+	setLevelUpdatesEnabled(options.LevelUpdatesEnabled)
+
+	return applySinks(options)
 }
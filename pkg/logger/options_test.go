@@ -6,6 +6,9 @@
 package logger
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,6 +53,28 @@ func TestOptions(t *testing.T) {
 		assert.True(t, logLevelAsserted)
 		assert.True(t, logAsJSONAsserted)
 	})
+
+	t.Run("attaching log output cmd flags", func(t *testing.T) {
+		o := DefaultOptions()
+
+		flags := map[string]string{}
+		testStringVarFn := func(p *string, name string, value string, usage string) {
+			flags[name] = value
+		}
+		testBoolVarFn := func(p *bool, name string, value bool, usage string) {}
+
+		o.AttachCmdFlags(testStringVarFn, testBoolVarFn)
+
+		// assert
+		assert.Equal(t, defaultOutputs, flags["log-outputs"])
+		assert.Equal(t, "", flags["log-file"])
+		assert.Equal(t, defaultFileMaxSize, flags["log-file-max-size"])
+		assert.Equal(t, defaultFileMaxAge, flags["log-file-max-age"])
+		assert.Equal(t, defaultFileMaxBackups, flags["log-file-max-backups"])
+		assert.Equal(t, "", flags["log-otlp-endpoint"])
+		assert.Equal(t, defaultOTLPProtocol, flags["log-otlp-protocol"])
+		assert.Equal(t, defaultSyslogAddress, flags["log-syslog-address"])
+	})
 }
 
 func TestApplyOptionsToLoggers(t *testing.T) {
@@ -70,7 +95,7 @@ func TestApplyOptionsToLoggers(t *testing.T) {
 		l.SetOutputLevel(InfoLevel)
 	}
 
-	ApplyOptionsToLoggers(&testOptions)
+	assert.NoError(t, ApplyOptionsToLoggers(&testOptions))
 
 	for _, l := range testLoggers {
 		assert.Equal(
@@ -83,3 +108,30 @@ func TestApplyOptionsToLoggers(t *testing.T) {
 			(l.(*daprLogger)).logger.Logger.GetLevel())
 	}
 }
+
+func TestApplyOutputOptionsToLoggers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	testOptions := DefaultOptions()
+	testOptions.Outputs = "file"
+	testOptions.FilePath = filepath.Join(dir, "daprd.log")
+
+	existingLogger := NewLogger("testOutputLogger0")
+	assert.NoError(t, ApplyOptionsToLoggers(&testOptions))
+	// loggers created after the options are applied write to the same outputs
+	newLogger := NewLogger("testOutputLogger1")
+
+	existingLogger.Info("existing logger")
+	newLogger.Info("new logger")
+
+	b, err := ioutil.ReadFile(testOptions.FilePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "existing logger")
+	assert.Contains(t, string(b), "new logger")
+
+	defaultOptions := DefaultOptions()
+	assert.NoError(t, ApplyOptionsToLoggers(&defaultOptions))
+	assert.Equal(t, os.Stdout, (newLogger.(*daprLogger)).logger.Logger.Out)
+}
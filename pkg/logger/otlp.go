@@ -0,0 +1,140 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package logger
+
+import (
+	"crypto/tls"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/otlp"
+	collectorlogspb "github.com/dapr/dapr/pkg/otlp/proto/collector/logs/v1"
+	logspb "github.com/dapr/dapr/pkg/otlp/proto/logs/v1"
+	resourcepb "github.com/dapr/dapr/pkg/otlp/proto/resource/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// otlpExportTimeout is the timeout of each export call to the collector
+const otlpExportTimeout = time.Second * 10
+
+// otlpLogSender sends the log records to an OpenTelemetry collector with OTLP over gRPC or HTTP
+type otlpLogSender struct {
+	client   *otlp.Client
+	resource *resourcepb.Resource
+}
+
+func newOTLPSink(endpoint, protocol string) (*asyncSink, error) {
+	if endpoint == "" {
+		return nil, errors.New("--log-otlp-endpoint is required by the otlp log output")
+	}
+	protocol = strings.ToLower(protocol)
+	if protocol != otlp.GRPCProtocol && protocol != otlp.HTTPProtocol {
+		return nil, errors.Errorf("invalid value for --log-otlp-protocol: %s, must be %s or %s", protocol, otlp.GRPCProtocol, otlp.HTTPProtocol)
+	}
+
+	// the scheme of a grpc endpoint selects whether the connection uses TLS
+	insecure := false
+	if protocol == otlp.GRPCProtocol {
+		if strings.HasPrefix(endpoint, "http://") {
+			insecure = true
+		}
+		endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
+	}
+
+	client, err := otlp.NewClient(otlp.ClientOptions{
+		EndpointAddress: endpoint,
+		Protocol:        protocol,
+		Insecure:        insecure,
+		TLSConfig:       &tls.Config{},
+		Timeout:         otlpExportTimeout,
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to create OTLP log output: %v", err)
+	}
+	s := &otlpLogSender{
+		client:   client,
+		resource: otlp.NewResource(filepath.Base(os.Args[0])),
+	}
+	return newAsyncSink(s.send, client.Close), nil
+}
+
+func (s *otlpLogSender) send(records []logRecord) error {
+	return s.client.Export(otlp.LogsExportMethod, s.newRequest(records))
+}
+
+// newRequest returns an ExportLogsServiceRequest with the log records
+func (s *otlpLogSender) newRequest(records []logRecord) *collectorlogspb.ExportLogsServiceRequest {
+	logRecords := make([]*logspb.LogRecord, 0, len(records))
+	for _, r := range records {
+		logRecords = append(logRecords, newLogRecord(r))
+	}
+
+	return &collectorlogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: s.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      otlp.NewScope(),
+				LogRecords: logRecords,
+			}},
+		}},
+	}
+}
+
+// newLogRecord returns the LogRecord of a log record. The fields of the log record are
+// converted to attributes, except for the trace and span IDs added by WithContext.
+func newLogRecord(r logRecord) *logspb.LogRecord {
+	attributes := make(map[string]interface{}, len(r.fields))
+	for k, v := range r.fields {
+		if k != logFieldTraceID && k != logFieldSpanID {
+			attributes[k] = v
+		}
+	}
+
+	return &logspb.LogRecord{
+		TimeUnixNano:   uint64(r.time.UnixNano()),
+		SeverityNumber: otlpSeverityNumber(r.level),
+		SeverityText:   r.level.String(),
+		Body:           otlp.NewAnyValue(r.message),
+		Attributes:     otlp.NewAttributes(attributes),
+		TraceId:        hexField(r.fields, logFieldTraceID),
+		SpanId:         hexField(r.fields, logFieldSpanID),
+	}
+}
+
+// hexField decodes a hex encoded field, or returns nil if it is missing or invalid
+func hexField(fields logrus.Fields, key string) []byte {
+	v, ok := fields[key].(string)
+	if !ok {
+		return nil
+	}
+	b, err := hex.DecodeString(v)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// otlpSeverityNumber returns the OTLP severity number of a log level
+func otlpSeverityNumber(level logrus.Level) logspb.SeverityNumber {
+	switch level {
+	case logrus.TraceLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_TRACE
+	case logrus.DebugLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case logrus.InfoLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case logrus.WarnLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case logrus.ErrorLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	}
+}
@@ -0,0 +1,266 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package logger
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// StdoutOutput writes the logs to stdout
+	StdoutOutput = "stdout"
+	// FileOutput writes the logs to a file that is rotated by size and age
+	FileOutput = "file"
+	// OTLPOutput ships the logs to an OpenTelemetry collector
+	OTLPOutput = "otlp"
+	// SyslogOutput ships the logs to a syslog server
+	SyslogOutput = "syslog"
+
+	// sinkQueueSize is the number of log records buffered by a sink, further records are dropped
+	sinkQueueSize = 4096
+	// sinkBatchSize is the maximum number of log records sent at once by a sink
+	sinkBatchSize = 256
+	// sinkFlushInterval is the interval at which a sink sends the buffered log records
+	sinkFlushInterval = time.Second
+)
+
+// globalSinks are the outputs applied to all loggers, including the ones created after the options are applied
+var globalSinks *sinks
+
+// registerExitHandler makes sure that the buffered log records are sent before the process exits on Fatal
+var registerExitHandler sync.Once
+
+// sinks are the outputs of the logs selected by the options
+type sinks struct {
+	// output is the writer of the formatted logs, for the stdout and file outputs
+	output io.Writer
+	// hooks ship the log records to the otlp and syslog outputs
+	hooks   []logrus.Hook
+	closers []io.Closer
+}
+
+// applySinks replaces the outputs of all loggers with the outputs selected by options
+func applySinks(options *Options) error {
+	s, err := newSinks(options)
+	if err != nil {
+		return err
+	}
+
+	registerExitHandler.Do(func() {
+		logrus.RegisterExitHandler(closeGlobalSinks)
+	})
+
+	globalLoggersLock.Lock()
+	defer globalLoggersLock.Unlock()
+
+	old := globalSinks
+	globalSinks = s
+	for _, v := range globalLoggers {
+		if l, ok := v.(*daprLogger); ok {
+			s.apply(l)
+		}
+	}
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
+
+func closeGlobalSinks() {
+	globalLoggersLock.RLock()
+	defer globalLoggersLock.RUnlock()
+
+	if globalSinks != nil {
+		globalSinks.Close()
+	}
+}
+
+func newSinks(options *Options) (*sinks, error) {
+	outputs := options.Outputs
+	if outputs == "" {
+		outputs = defaultOutputs
+	}
+
+	s := &sinks{}
+	var writers []io.Writer
+	for _, name := range strings.Split(outputs, ",") {
+		var err error
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+			continue
+		case StdoutOutput:
+			writers = append(writers, os.Stdout)
+		case FileOutput:
+			var w *rotatingFileWriter
+			if w, err = newRotatingFileWriter(options); err == nil {
+				writers = append(writers, w)
+				s.closers = append(s.closers, w)
+			}
+		case OTLPOutput:
+			var h *asyncSink
+			if h, err = newOTLPSink(options.OTLPEndpoint, options.OTLPProtocol); err == nil {
+				s.hooks = append(s.hooks, h)
+				s.closers = append(s.closers, h)
+			}
+		case SyslogOutput:
+			var h *asyncSink
+			if h, err = newSyslogSink(options.SyslogAddress); err == nil {
+				s.hooks = append(s.hooks, h)
+				s.closers = append(s.closers, h)
+			}
+		default:
+			err = errors.Errorf("invalid value for --log-outputs: %s", name)
+		}
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+
+	switch len(writers) {
+	case 0:
+		s.output = ioutil.Discard
+	case 1:
+		s.output = writers[0]
+	default:
+		s.output = io.MultiWriter(writers...)
+	}
+	return s, nil
+}
+
+// apply sets the outputs of a logger
+func (s *sinks) apply(l *daprLogger) {
+	hooks := logrus.LevelHooks{}
+	for _, h := range s.hooks {
+		hooks.Add(h)
+	}
+	l.logger.Logger.SetOutput(s.output)
+	l.logger.Logger.ReplaceHooks(hooks)
+}
+
+// Close sends the buffered log records and closes the outputs
+func (s *sinks) Close() error {
+	for _, c := range s.closers {
+		c.Close()
+	}
+	return nil
+}
+
+// logRecord is a copy of a log entry that is shipped asynchronously
+type logRecord struct {
+	time    time.Time
+	level   logrus.Level
+	message string
+	fields  logrus.Fields
+}
+
+// asyncSink is a logrus hook that buffers the log records and sends them in batches from a goroutine,
+// so that logging never blocks on the network. Records are dropped while the buffer is full.
+type asyncSink struct {
+	send  func(records []logRecord) error
+	close func() error
+
+	records   chan logRecord
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newAsyncSink(send func(records []logRecord) error, close func() error) *asyncSink {
+	s := &asyncSink{
+		send:    send,
+		close:   close,
+		records: make(chan logRecord, sinkQueueSize),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Levels returns the levels of the log entries sent to the sink
+func (s *asyncSink) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire buffers a log entry
+func (s *asyncSink) Fire(entry *logrus.Entry) error {
+	fields := make(logrus.Fields, len(entry.Data))
+	for k, v := range entry.Data {
+		fields[k] = v
+	}
+
+	select {
+	case s.records <- logRecord{time: entry.Time, level: entry.Level, message: entry.Message, fields: fields}:
+	default:
+	}
+	return nil
+}
+
+func (s *asyncSink) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(sinkFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]logRecord, 0, sinkBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		// the sink can't log its own errors without sending them to itself
+		if err := s.send(batch); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to send logs: %v\n", err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case r := <-s.records:
+			batch = append(batch, r)
+			if len(batch) == sinkBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stop:
+			for {
+				select {
+				case r := <-s.records:
+					batch = append(batch, r)
+					if len(batch) == sinkBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close sends the buffered log records and closes the destination of the sink
+func (s *asyncSink) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		if s.close != nil {
+			err = s.close()
+		}
+	})
+	return err
+}
@@ -0,0 +1,235 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package logger
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/otlp"
+	collectorlogspb "github.com/dapr/dapr/pkg/otlp/proto/collector/logs/v1"
+	logspb "github.com/dapr/dapr/pkg/otlp/proto/logs/v1"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+)
+
+func TestNewSinks(t *testing.T) {
+	t.Run("stdout by default", func(t *testing.T) {
+		s, err := newSinks(&Options{})
+		require.NoError(t, err)
+		assert.Equal(t, os.Stdout, s.output)
+		assert.Empty(t, s.hooks)
+	})
+
+	t.Run("no writer output", func(t *testing.T) {
+		s, err := newSinks(&Options{Outputs: "syslog", SyslogAddress: defaultSyslogAddress})
+		require.NoError(t, err)
+		defer s.Close()
+		assert.Equal(t, ioutil.Discard, s.output)
+		assert.Len(t, s.hooks, 1)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		_, err := newSinks(&Options{Outputs: "stdout, kafka"})
+		assert.Error(t, err)
+	})
+
+	t.Run("file output requires a path", func(t *testing.T) {
+		o := DefaultOptions()
+		o.Outputs = "file"
+		_, err := newSinks(&o)
+		assert.Error(t, err)
+	})
+
+	t.Run("otlp output requires an endpoint", func(t *testing.T) {
+		o := DefaultOptions()
+		o.Outputs = "otlp"
+		_, err := newSinks(&o)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid syslog address", func(t *testing.T) {
+		o := DefaultOptions()
+		o.Outputs = "syslog"
+		o.SyslogAddress = "localhost:514"
+		_, err := newSinks(&o)
+		assert.Error(t, err)
+	})
+}
+
+func TestRotatingFileWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	o := DefaultOptions()
+	o.FilePath = filepath.Join(dir, "daprd.log")
+	o.FileMaxBackups = "2"
+	w, err := newRotatingFileWriter(&o)
+	require.NoError(t, err)
+	defer w.Close()
+	w.maxSize = 10
+
+	for _, line := range []string{"line one\n", "line two\n", "line three\n", "line four\n"} {
+		_, err = w.Write([]byte(line))
+		require.NoError(t, err)
+		// distinct timestamp suffixes for the rotated files
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	b, err := ioutil.ReadFile(o.FilePath)
+	require.NoError(t, err)
+	assert.Equal(t, "line four\n", string(b))
+
+	backups, err := filepath.Glob(o.FilePath + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	b, err = ioutil.ReadFile(backups[1])
+	require.NoError(t, err)
+	assert.Equal(t, "line three\n", string(b))
+
+	t.Run("rotate by age", func(t *testing.T) {
+		w.maxSize = 0
+		w.maxAge = time.Millisecond
+		time.Sleep(2 * time.Millisecond)
+		_, err = w.Write([]byte("line five\n"))
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadFile(o.FilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "line five\n", string(b))
+	})
+
+	t.Run("rename failure", func(t *testing.T) {
+		o := DefaultOptions()
+		o.FilePath = filepath.Join(dir, "rename", "daprd.log")
+		w, err := newRotatingFileWriter(&o)
+		require.NoError(t, err)
+		defer w.Close()
+		w.maxSize = 10
+
+		_, err = w.Write([]byte("line one\n"))
+		require.NoError(t, err)
+		// the log file can't be renamed once its directory is removed
+		require.NoError(t, os.RemoveAll(filepath.Dir(o.FilePath)))
+		_, err = w.Write([]byte("line two\n"))
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadFile(o.FilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "line two\n", string(b))
+
+		// the next rotation succeeds
+		_, err = w.Write([]byte("line three\n"))
+		require.NoError(t, err)
+
+		b, err = ioutil.ReadFile(o.FilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "line three\n", string(b))
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		o := DefaultOptions()
+		o.FilePath = filepath.Join(dir, "closed", "daprd.log")
+		w, err := newRotatingFileWriter(&o)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		_, err = w.Write([]byte("line one\n"))
+		assert.Equal(t, os.ErrClosed, err)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		o := DefaultOptions()
+		o.FilePath = filepath.Join(dir, "daprd.log")
+		o.FileMaxSize = "-1"
+		_, err := newRotatingFileWriter(&o)
+		assert.Error(t, err)
+
+		o = DefaultOptions()
+		o.FilePath = filepath.Join(dir, "daprd.log")
+		o.FileMaxAge = "1d"
+		_, err = newRotatingFileWriter(&o)
+		assert.Error(t, err)
+	})
+}
+
+func TestSyslogSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	s, err := newSyslogSink("udp://" + conn.LocalAddr().String())
+	require.NoError(t, err)
+
+	l := newDaprLogger("dapr.test.syslog")
+	l.logger.Logger.SetOutput(ioutil.Discard)
+	l.logger.Logger.AddHook(s)
+	l.Warn("syslog message")
+	require.NoError(t, s.Close())
+
+	buf := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	msg := string(buf[:n])
+	assert.True(t, strings.HasPrefix(msg, "<28>1 "), msg)
+	assert.True(t, strings.HasSuffix(msg, " dapr.test.syslog - syslog message"), msg)
+}
+
+func TestOTLPSink(t *testing.T) {
+	requests := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, otlp.ContentType, r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- body
+	}))
+	defer server.Close()
+
+	s, err := newOTLPSink(server.URL+"/v1/logs", "http")
+	require.NoError(t, err)
+
+	l := newDaprLogger("dapr.test.otlp")
+	l.logger.Logger.SetOutput(ioutil.Discard)
+	l.logger.Logger.AddHook(s)
+	ctx, span := trace.StartSpan(context.Background(), "test")
+	l.WithContext(ctx).Error("otlp message")
+	span.End()
+	require.NoError(t, s.Close())
+
+	var req collectorlogspb.ExportLogsServiceRequest
+	require.NoError(t, proto.Unmarshal(<-requests, &req))
+	require.Len(t, req.ResourceLogs, 1)
+	require.Len(t, req.ResourceLogs[0].ScopeLogs, 1)
+	require.Len(t, req.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+
+	record := req.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, record.SeverityNumber)
+	assert.Equal(t, "error", record.SeverityText)
+	assert.Equal(t, "otlp message", record.Body.GetStringValue())
+	traceID := span.SpanContext().TraceID
+	assert.Equal(t, traceID[:], record.TraceId)
+	spanID := span.SpanContext().SpanID
+	assert.Equal(t, spanID[:], record.SpanId)
+
+	attributes := map[string]bool{}
+	for _, a := range record.Attributes {
+		attributes[a.Key] = true
+	}
+	assert.True(t, attributes[logFieldScope])
+	assert.False(t, attributes[logFieldTraceID])
+}
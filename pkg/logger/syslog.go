@@ -0,0 +1,129 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package logger
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// syslogFacility is the facility of the messages sent to syslog, system daemons
+	syslogFacility = 3
+	// syslogTimeFormat is the RFC 5424 timestamp format, which is limited to microseconds
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+	syslogNilValue   = "-"
+
+	syslogDialTimeout  = time.Second * 5
+	syslogWriteTimeout = time.Second * 5
+)
+
+// syslogSender sends the log records as RFC 5424 messages to a syslog server over UDP or TCP
+type syslogSender struct {
+	network  string
+	address  string
+	hostname string
+	appName  string
+	pid      int
+
+	conn net.Conn
+}
+
+func newSyslogSink(address string) (*asyncSink, error) {
+	u, err := url.Parse(address)
+	if err != nil || (u.Scheme != "udp" && u.Scheme != "tcp") || u.Host == "" {
+		return nil, errors.Errorf("invalid value for --log-syslog-address: %s, must be udp://host:port or tcp://host:port", address)
+	}
+
+	hostname, _ := os.Hostname()
+	s := &syslogSender{
+		network:  u.Scheme,
+		address:  u.Host,
+		hostname: hostname,
+		appName:  filepath.Base(os.Args[0]),
+		pid:      os.Getpid(),
+	}
+	return newAsyncSink(s.send, s.close), nil
+}
+
+func (s *syslogSender) send(records []logRecord) error {
+	if s.conn == nil {
+		conn, err := net.DialTimeout(s.network, s.address, syslogDialTimeout)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	for _, r := range records {
+		msg := s.format(r)
+		if s.network == "tcp" {
+			// octet counting framing of RFC 6587
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+		s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)) // nolint: errcheck
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			// reconnect on the next batch
+			s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSender) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+// format formats a log record as an RFC 5424 message, with the scope of the logger as MSGID
+func (s *syslogSender) format(r logRecord) string {
+	scope, _ := r.fields[logFieldScope].(string)
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s %s %s",
+		syslogFacility*8+syslogSeverity(r.level),
+		r.time.Format(syslogTimeFormat),
+		syslogValue(s.hostname),
+		syslogValue(s.appName),
+		s.pid,
+		syslogValue(scope),
+		syslogNilValue,
+		r.message)
+}
+
+func syslogValue(v string) string {
+	if v == "" {
+		return syslogNilValue
+	}
+	return v
+}
+
+// syslogSeverity returns the RFC 5424 severity of a log level
+func syslogSeverity(level logrus.Level) int {
+	switch level {
+	case logrus.PanicLevel:
+		return 0
+	case logrus.FatalLevel:
+		return 2
+	case logrus.ErrorLevel:
+		return 3
+	case logrus.WarnLevel:
+		return 4
+	case logrus.InfoLevel:
+		return 6
+	default:
+		return 7
+	}
+}
@@ -8,15 +8,20 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	nr "github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/dapr/pkg/channel"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/grpc/proxy"
+	"github.com/dapr/dapr/pkg/logger"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/retry"
 	"github.com/dapr/dapr/utils"
@@ -24,12 +29,15 @@ import (
 	"github.com/valyala/fasthttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 )
 
+var log = logger.NewLogger("dapr.runtime.direct_messaging")
+
 // messageClientConnection is the function type to connect to the other
 // applications to send the message using service invocation.
 type messageClientConnection func(address, id string, namespace string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error)
@@ -37,25 +45,35 @@ type messageClientConnection func(address, id string, namespace string, skipTLS,
 // DirectMessaging is the API interface for invoking a remote app
 type DirectMessaging interface {
 	Invoke(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error)
+	InvokeStream(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest, body io.Reader) (*invokev1.InvokeMethodResponse, io.ReadCloser, error)
+	ProxyStream(ctx context.Context, targetAppID, fullMethod string, stream grpc.ServerStream) error
 }
 
 type directMessaging struct {
-	appChannel          channel.AppChannel
-	connectionCreatorFn messageClientConnection
-	appID               string
-	mode                modes.DaprMode
-	grpcPort            int
-	namespace           string
-	resolver            nr.Resolver
-	tracingSpec         config.TracingSpec
-	hostAddress         string
-	hostName            string
+	appChannel            channel.AppChannel
+	connectionCreatorFn   messageClientConnection
+	connectionCloserFn    func(address string)
+	appID                 string
+	mode                  modes.DaprMode
+	grpcPort              int
+	namespace             string
+	resolver              nr.Resolver
+	tracingSpec           config.TracingSpec
+	serviceInvocationSpec config.ServiceInvocationSpec
+	loadBalancer          *loadBalancer
+	hostAddress           string
+	hostName              string
+
+	addressesLock sync.Mutex
+	// addresses are the resolved endpoint addresses of each remote app, whose pooled connections
+	// are closed once they are no longer resolved
+	addresses map[string]map[string]bool
 }
 
 type remoteApp struct {
 	id        string
 	namespace string
-	address   string
+	endpoints []nr_loader.Endpoint
 }
 
 // NewDirectMessaging returns a new direct messaging api
@@ -64,21 +82,27 @@ func NewDirectMessaging(
 	port int, mode modes.DaprMode,
 	appChannel channel.AppChannel,
 	clientConnFn messageClientConnection,
+	clientConnCloseFn func(address string),
 	resolver nr.Resolver,
-	tracingSpec config.TracingSpec) DirectMessaging {
+	tracingSpec config.TracingSpec,
+	serviceInvocationSpec config.ServiceInvocationSpec) DirectMessaging {
 	hAddr, _ := utils.GetHostAddress()
 	hName, _ := os.Hostname()
 	return &directMessaging{
-		appChannel:          appChannel,
-		connectionCreatorFn: clientConnFn,
-		appID:               appID,
-		mode:                mode,
-		grpcPort:            port,
-		namespace:           namespace,
-		resolver:            resolver,
-		tracingSpec:         tracingSpec,
-		hostAddress:         hAddr,
-		hostName:            hName,
+		appChannel:            appChannel,
+		connectionCreatorFn:   clientConnFn,
+		connectionCloserFn:    clientConnCloseFn,
+		appID:                 appID,
+		mode:                  mode,
+		grpcPort:              port,
+		namespace:             namespace,
+		resolver:              resolver,
+		tracingSpec:           tracingSpec,
+		serviceInvocationSpec: serviceInvocationSpec,
+		loadBalancer:          newLoadBalancer(serviceInvocationSpec.LoadBalancing, utils.GetZone()),
+		hostAddress:           hAddr,
+		hostName:              hName,
+		addresses:             map[string]map[string]bool{},
 	}
 }
 
@@ -95,6 +119,55 @@ func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *i
 	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemote, req)
 }
 
+// InvokeStream invokes an app, either local or remote, streaming the request body read from body and
+// the response body returned as a ReadCloser, which the caller must close. As the request body can't
+// be replayed, streamed invocations are not retried.
+func (d *directMessaging) InvokeStream(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest, body io.Reader) (*invokev1.InvokeMethodResponse, io.ReadCloser, error) {
+	app, err := d.getRemoteApp(targetAppID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if app.id == d.appID && app.namespace == d.namespace {
+		if d.appChannel == nil {
+			return nil, nil, errors.New("cannot invoke local endpoint: app channel not initialized")
+		}
+		return channel.InvokeMethodStream(ctx, d.appChannel, req, body)
+	}
+	return d.invokeRemoteStream(ctx, app, req, body)
+}
+
+// ProxyStream transparently proxies the gRPC call received on stream to the fullMethod of an app,
+// either local or remote, forwarding its raw frames. Proxied calls are not retried.
+func (d *directMessaging) ProxyStream(ctx context.Context, targetAppID, fullMethod string, stream grpc.ServerStream) error {
+	app, err := d.getRemoteApp(targetAppID)
+	if err != nil {
+		return err
+	}
+
+	span := diag_utils.SpanFromContext(ctx)
+	ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
+
+	if app.id == d.appID && app.namespace == d.namespace {
+		proxyChannel, ok := d.appChannel.(channel.ProxyAppChannel)
+		if !ok {
+			return status.Errorf(codes.Unimplemented, "app channel does not support proxying gRPC calls to app %s", app.id)
+		}
+		return proxyChannel.ProxyStream(ctx, fullMethod, stream)
+	}
+
+	address := d.loadBalancer.pick(app)
+	conn, err := d.connectionCreatorFn(address, app.id, app.namespace, false, false)
+	if err != nil {
+		return err
+	}
+
+	defer d.loadBalancer.start(address)()
+	ctx = metadata.AppendToOutgoingContext(ctx, d.forwardedHeadersPairs(app.id)...)
+	log.WithContext(ctx).Debugf("proxying call %s to app %s at %s", fullMethod, app.id, address)
+	return proxy.Forward(ctx, conn, fullMethod, stream)
+}
+
 // requestAppIDAndNamespace takes an app id and returns the app id, namespace and error.
 func (d *directMessaging) requestAppIDAndNamespace(targetAppID string) (string, string, error) {
 	items := strings.Split(targetAppID, ".")
@@ -107,7 +180,9 @@ func (d *directMessaging) requestAppIDAndNamespace(targetAppID string) (string,
 	}
 }
 
-// invokeWithRetry will call a remote endpoint for the specified number of retries and will only retry in the case of transient failures
+// invokeWithRetry will call a remote endpoint for the specified number of retries and will only retry in the case of transient failures.
+// Each attempt is sent to the endpoint picked by the load balancer, and endpoints that are unavailable are ejected, so that
+// the retries of apps with several instances are sent to another instance.
 // TODO: check why https://github.com/grpc-ecosystem/go-grpc-middleware/blob/master/retry/examples_test.go doesn't recover the connection when target
 // Server shuts down.
 func (d *directMessaging) invokeWithRetry(
@@ -118,15 +193,29 @@ func (d *directMessaging) invokeWithRetry(
 	fn func(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error),
 	req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	for i := 0; i < numRetries; i++ {
-		resp, err := fn(ctx, app.id, app.namespace, app.address, req)
+		address := d.loadBalancer.pick(app)
+		done := d.loadBalancer.start(address)
+		resp, err := fn(ctx, app.id, app.namespace, address, req)
+		done()
 		if err == nil {
 			return resp, nil
 		}
+		log.WithContext(ctx).Debugf("invoking app %s at %s failed (attempt %d of %d): %s", app.id, address, i+1, numRetries, err)
 		time.Sleep(backoffInterval)
 
 		code := status.Code(err)
+		if code == codes.Unavailable {
+			log.WithContext(ctx).Debugf("ejecting unavailable endpoint %s of app %s", address, app.id)
+			d.loadBalancer.eject(address)
+			if len(app.endpoints) > 1 {
+				// the next attempt is sent to another endpoint, and the connection to the ejected one
+				// is recreated if it's picked again
+				d.closeConnection(address)
+				continue
+			}
+		}
 		if code == codes.Unavailable || code == codes.Unauthenticated {
-			_, connerr := d.connectionCreatorFn(app.address, app.id, app.namespace, false, true)
+			_, connerr := d.connectionCreatorFn(address, app.id, app.namespace, false, true)
 			if connerr != nil {
 				return nil, connerr
 			}
@@ -148,16 +237,19 @@ func (d *directMessaging) invokeLocal(ctx context.Context, req *invokev1.InvokeM
 func (d *directMessaging) invokeRemote(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	conn, err := d.connectionCreatorFn(appAddress, appID, namespace, false, false)
 	if err != nil {
-		return nil, err
+		// the endpoint can't be reached, which is retried like a failed call
+		return nil, status.Error(codes.Unavailable, err.Error())
 	}
 
 	span := diag_utils.SpanFromContext(ctx)
 
-	// TODO: Use built-in grpc client timeout instead of using context timeout
-	// no ops if span context is empty
-	ctx, cancel := context.WithTimeout(ctx, channel.DefaultChannelRequestTimeout)
+	// a deadline supplied by the caller is honoured, otherwise the timeout configured for the target app applies.
+	// gRPC propagates the deadline to the Dapr instance of the target app.
+	timeout := channel.RequestTimeout(ctx, d.serviceInvocationSpec.RequestTimeout(appID))
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	// no ops if span context is empty
 	ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
 
 	d.addForwardedHeadersToMetadata(req)
@@ -172,6 +264,82 @@ func (d *directMessaging) invokeRemote(ctx context.Context, appID, namespace, ap
 	return invokev1.InternalInvokeResponse(resp)
 }
 
+func (d *directMessaging) invokeRemoteStream(ctx context.Context, app remoteApp, req *invokev1.InvokeMethodRequest, body io.Reader) (*invokev1.InvokeMethodResponse, io.ReadCloser, error) {
+	address := d.loadBalancer.pick(app)
+	log.WithContext(ctx).Debugf("invoking app %s at %s with a streamed request", app.id, address)
+	conn, err := d.connectionCreatorFn(address, app.id, app.namespace, false, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	span := diag_utils.SpanFromContext(ctx)
+
+	// the stream lives until the response body is closed, so it is not bound by the channel request timeout,
+	// and the invocation is outstanding for the load balancer until then
+	ctx, cancelStream := context.WithCancel(ctx)
+	done := d.loadBalancer.start(address)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			cancelStream()
+			done()
+		})
+	}
+	ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
+
+	d.addForwardedHeadersToMetadata(req)
+	d.addDestinationAppIDHeaderToMetadata(app.id, req)
+
+	clientV1 := internalv1pb.NewServiceInvocationClient(conn)
+	stream, err := clientV1.CallLocalStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	if err = stream.Send(&internalv1pb.InternalInvokeRequestStream{Request: req.Proto()}); err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	go func() {
+		if body != nil {
+			err := invokev1.SendChunks(body, func(chunk []byte) error {
+				return stream.Send(&internalv1pb.InternalInvokeRequestStream{Data: chunk})
+			})
+			if err != nil {
+				// abort the call rather than delivering a truncated body
+				cancel()
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	if first.Response == nil {
+		cancel()
+		return nil, nil, errors.New("missing response in the first message of the response stream")
+	}
+	resp, err := invokev1.InternalInvokeResponse(first.Response)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	respBody := invokev1.NewChunkReader(first.Data, func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.Data, nil
+	}, cancel)
+	return resp, respBody, nil
+}
+
 func (d *directMessaging) addDestinationAppIDHeaderToMetadata(appID string, req *invokev1.InvokeMethodRequest) {
 	req.Metadata()[invokev1.DestinationIDHeader] = &internalv1pb.ListStringValue{
 		Values: []string{appID},
@@ -207,6 +375,22 @@ func (d *directMessaging) addForwardedHeadersToMetadata(req *invokev1.InvokeMeth
 	}
 }
 
+// forwardedHeadersPairs returns the forwarded and destination app id headers as gRPC metadata key value pairs
+func (d *directMessaging) forwardedHeadersPairs(appID string) []string {
+	req := invokev1.NewInvokeMethodRequest("")
+	req.WithMetadata(map[string][]string{})
+	d.addForwardedHeadersToMetadata(req)
+	d.addDestinationAppIDHeaderToMetadata(appID, req)
+
+	var pairs []string
+	for k, v := range req.Metadata() {
+		for _, val := range v.Values {
+			pairs = append(pairs, strings.ToLower(k), val)
+		}
+	}
+	return pairs
+}
+
 func (d *directMessaging) getRemoteApp(appID string) (remoteApp, error) {
 	id, namespace, err := d.requestAppIDAndNamespace(appID)
 	if err != nil {
@@ -214,14 +398,48 @@ func (d *directMessaging) getRemoteApp(appID string) (remoteApp, error) {
 	}
 
 	request := nr.ResolveRequest{ID: id, Namespace: namespace, Port: d.grpcPort}
-	address, err := d.resolver.ResolveID(request)
+	endpoints, err := nr_loader.ResolveEndpoints(d.resolver, request)
 	if err != nil {
 		return remoteApp{}, err
 	}
+	if id != d.appID || namespace != d.namespace {
+		d.closeRemovedConnections(id+"."+namespace, endpoints)
+	}
 
 	return remoteApp{
 		namespace: namespace,
 		id:        id,
-		address:   address,
+		endpoints: endpoints,
 	}, nil
 }
+
+// closeRemovedConnections closes the pooled connections to the endpoints of an app that are no longer resolved,
+// so the connections to instances that are gone don't keep reconnecting
+func (d *directMessaging) closeRemovedConnections(appKey string, endpoints []nr_loader.Endpoint) {
+	if d.connectionCloserFn == nil {
+		return
+	}
+
+	current := make(map[string]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		current[endpoint.Address] = true
+	}
+
+	d.addressesLock.Lock()
+	previous := d.addresses[appKey]
+	d.addresses[appKey] = current
+	d.addressesLock.Unlock()
+
+	for address := range previous {
+		if !current[address] {
+			log.Debugf("closing the connection to %s, which is no longer an endpoint of app %s", address, appKey)
+			d.closeConnection(address)
+		}
+	}
+}
+
+func (d *directMessaging) closeConnection(address string) {
+	if d.connectionCloserFn != nil {
+		d.connectionCloserFn(address)
+	}
+}
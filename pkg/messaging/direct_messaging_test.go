@@ -52,6 +52,25 @@ func TestForwardedHeaders(t *testing.T) {
 	})
 }
 
+func TestForwardedHeadersPairs(t *testing.T) {
+	t.Run("forwarded and destination headers present", func(t *testing.T) {
+		dm := newDirectMessaging()
+		dm.hostAddress = "1"
+		dm.hostName = "2"
+
+		pairs := dm.forwardedHeadersPairs("test1")
+
+		md := map[string]string{}
+		for i := 0; i < len(pairs); i += 2 {
+			md[pairs[i]] = pairs[i+1]
+		}
+		assert.Equal(t, "test1", md[invokev1.DestinationIDHeader])
+		assert.Equal(t, "1", md["x-forwarded-for"])
+		assert.Equal(t, "2", md["x-forwarded-host"])
+		assert.Equal(t, "for=1;by=1;host=2", md["forwarded"])
+	})
+}
+
 func TestKubernetesNamespace(t *testing.T) {
 	t.Run("no namespace", func(t *testing.T) {
 		appID := "app1"
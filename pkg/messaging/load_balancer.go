@@ -0,0 +1,126 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"sync"
+	"time"
+
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// defaultEjectionDuration is how long an endpoint that failed is not picked if not configured
+const defaultEjectionDuration = time.Second * 30
+
+// loadBalancer picks the endpoint of an app each invocation is sent to. Endpoints that failed are ejected
+// for a while and endpoints in the zone of the caller are preferred.
+type loadBalancer struct {
+	policy           string
+	zone             string
+	ejectionDuration time.Duration
+
+	lock *sync.Mutex
+	// next is the round robin position of each app
+	next map[string]int
+	// outstanding is the number of outstanding requests of each endpoint address
+	outstanding map[string]int
+	// ejectedUntil is the time until which each ejected endpoint address is not picked
+	ejectedUntil map[string]time.Time
+}
+
+func newLoadBalancer(spec config.LoadBalancingSpec, zone string) *loadBalancer {
+	return &loadBalancer{
+		policy:           spec.Policy,
+		zone:             zone,
+		ejectionDuration: spec.GetEjectionDuration(defaultEjectionDuration),
+		lock:             &sync.Mutex{},
+		next:             map[string]int{},
+		outstanding:      map[string]int{},
+		ejectedUntil:     map[string]time.Time{},
+	}
+}
+
+// pick returns the address of the endpoint of app the next invocation is sent to.
+// If all the endpoints were ejected, they are all candidates again.
+func (l *loadBalancer) pick(app remoteApp) string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	candidates := l.healthyEndpoints(app.endpoints)
+	if len(candidates) == 0 {
+		candidates = app.endpoints
+	}
+	if local := l.zoneEndpoints(candidates); len(local) > 0 {
+		candidates = local
+	}
+
+	key := app.id + "." + app.namespace
+	next := l.next[key]
+	l.next[key] = next + 1
+
+	picked := candidates[next%len(candidates)]
+	if l.policy == config.LeastRequestPolicy {
+		// starting from the round robin position spreads the invocations across endpoints with equal load
+		for i := 1; i < len(candidates); i++ {
+			endpoint := candidates[(next+i)%len(candidates)]
+			if l.outstanding[endpoint.Address] < l.outstanding[picked.Address] {
+				picked = endpoint
+			}
+		}
+	}
+	return picked.Address
+}
+
+func (l *loadBalancer) healthyEndpoints(endpoints []nr_loader.Endpoint) []nr_loader.Endpoint {
+	now := time.Now()
+	healthy := make([]nr_loader.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if until, ok := l.ejectedUntil[endpoint.Address]; ok {
+			if now.Before(until) {
+				continue
+			}
+			delete(l.ejectedUntil, endpoint.Address)
+		}
+		healthy = append(healthy, endpoint)
+	}
+	return healthy
+}
+
+func (l *loadBalancer) zoneEndpoints(endpoints []nr_loader.Endpoint) []nr_loader.Endpoint {
+	if l.zone == "" {
+		return nil
+	}
+	var local []nr_loader.Endpoint
+	for _, endpoint := range endpoints {
+		if endpoint.Zone == l.zone {
+			local = append(local, endpoint)
+		}
+	}
+	return local
+}
+
+// start records an outstanding invocation of address, returning the function that records its completion.
+func (l *loadBalancer) start(address string) func() {
+	l.lock.Lock()
+	l.outstanding[address]++
+	l.lock.Unlock()
+
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		if l.outstanding[address]--; l.outstanding[address] <= 0 {
+			delete(l.outstanding, address)
+		}
+	}
+}
+
+// eject stops picking address until the ejection duration has passed.
+func (l *loadBalancer) eject(address string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.ejectedUntil[address] = time.Now().Add(l.ejectionDuration)
+}
@@ -0,0 +1,221 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestRemoteApp(endpoints ...nr_loader.Endpoint) remoteApp {
+	return remoteApp{id: "app1", namespace: "default", endpoints: endpoints}
+}
+
+func TestLoadBalancerPick(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{}, "")
+		app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"}, nr_loader.Endpoint{Address: "b"}, nr_loader.Endpoint{Address: "c"})
+
+		picked := []string{lb.pick(app), lb.pick(app), lb.pick(app), lb.pick(app)}
+		assert.Equal(t, []string{"a", "b", "c", "a"}, picked)
+	})
+
+	t.Run("least request", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{Policy: config.LeastRequestPolicy}, "")
+		app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"}, nr_loader.Endpoint{Address: "b"})
+
+		doneA := lb.start("a")
+		lb.start("a")
+		lb.start("b")
+		assert.Equal(t, "b", lb.pick(app))
+		assert.Equal(t, "b", lb.pick(app))
+
+		doneA()
+		doneA()
+		assert.Equal(t, "a", lb.pick(app))
+	})
+
+	t.Run("ejected endpoints are skipped", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{EjectionDuration: "1h"}, "")
+		app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"}, nr_loader.Endpoint{Address: "b"})
+
+		lb.eject("a")
+		assert.Equal(t, "b", lb.pick(app))
+		assert.Equal(t, "b", lb.pick(app))
+	})
+
+	t.Run("ejection expires", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{}, "")
+		app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"})
+
+		lb.eject("a")
+		lb.ejectedUntil["a"] = time.Now().Add(-time.Second)
+		assert.Equal(t, "a", lb.pick(app))
+		assert.Empty(t, lb.ejectedUntil)
+	})
+
+	t.Run("all endpoints ejected", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{}, "")
+		app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"})
+
+		lb.eject("a")
+		assert.Equal(t, "a", lb.pick(app))
+	})
+
+	t.Run("endpoints in the zone of the caller are preferred", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{}, "zone1")
+		app := newTestRemoteApp(
+			nr_loader.Endpoint{Address: "a", Zone: "zone2"},
+			nr_loader.Endpoint{Address: "b", Zone: "zone1"},
+			nr_loader.Endpoint{Address: "c", Zone: "zone1"})
+
+		picked := []string{lb.pick(app), lb.pick(app), lb.pick(app)}
+		assert.Equal(t, []string{"b", "c", "b"}, picked)
+
+		lb.eject("b")
+		lb.eject("c")
+		assert.Equal(t, "a", lb.pick(app))
+	})
+}
+
+func TestInvokeWithRetryFailover(t *testing.T) {
+	dm := newDirectMessaging()
+	dm.loadBalancer = newLoadBalancer(config.LoadBalancingSpec{}, "")
+	dm.connectionCreatorFn = func(address, id string, namespace string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error) {
+		return nil, nil
+	}
+	var closed []string
+	dm.connectionCloserFn = func(address string) {
+		closed = append(closed, address)
+	}
+	app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"}, nr_loader.Endpoint{Address: "b"})
+
+	var addresses []string
+	fn := func(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+		addresses = append(addresses, appAddress)
+		if appAddress == "a" {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+		return invokev1.NewInvokeMethodResponse(200, "OK", nil), nil
+	}
+
+	_, err := dm.invokeWithRetry(context.Background(), 3, time.Millisecond, app, fn, invokev1.NewInvokeMethodRequest("method"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, addresses)
+	// the connection to the ejected endpoint is closed
+	assert.Equal(t, []string{"a"}, closed)
+
+	// the failed endpoint is ejected
+	addresses = nil
+	_, err = dm.invokeWithRetry(context.Background(), 3, time.Millisecond, app, fn, invokev1.NewInvokeMethodRequest("method"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, addresses)
+}
+
+// fakeEndpointsResolver resolves apps to the endpoints it is set with
+type fakeEndpointsResolver struct {
+	endpoints []nr_loader.Endpoint
+}
+
+func (r *fakeEndpointsResolver) Init(metadata nr.Metadata) error {
+	return nil
+}
+
+func (r *fakeEndpointsResolver) ResolveID(req nr.ResolveRequest) (string, error) {
+	return r.endpoints[0].Address, nil
+}
+
+func (r *fakeEndpointsResolver) ResolveEndpoints(req nr.ResolveRequest) ([]nr_loader.Endpoint, error) {
+	return append([]nr_loader.Endpoint(nil), r.endpoints...), nil
+}
+
+func TestRemovedEndpointConnectionsAreClosed(t *testing.T) {
+	resolver := &fakeEndpointsResolver{endpoints: []nr_loader.Endpoint{{Address: "a"}, {Address: "b"}}}
+	var closed []string
+	dm := NewDirectMessaging("app0", "default", 50002, "", nil, nil, func(address string) {
+		closed = append(closed, address)
+	}, resolver, config.TracingSpec{}, config.ServiceInvocationSpec{}).(*directMessaging)
+
+	_, err := dm.getRemoteApp("app1")
+	assert.NoError(t, err)
+	assert.Empty(t, closed)
+
+	resolver.endpoints = []nr_loader.Endpoint{{Address: "b"}, {Address: "c"}}
+	_, err = dm.getRemoteApp("app1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, closed)
+
+	// the endpoints of each app are tracked separately
+	closed = nil
+	resolver.endpoints = []nr_loader.Endpoint{{Address: "d"}}
+	_, err = dm.getRemoteApp("app2")
+	assert.NoError(t, err)
+	assert.Empty(t, closed)
+}
+
+// streamServer answers streamed invocations with an empty response body once the request stream ends
+type streamServer struct {
+	internalv1pb.UnimplementedServiceInvocationServer
+}
+
+func (s *streamServer) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error {
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	resp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+	return stream.Send(&internalv1pb.InternalInvokeResponseStream{Response: resp.Proto()})
+}
+
+func TestInvokeRemoteStreamIsOutstanding(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	internalv1pb.RegisterServiceInvocationServer(server, &streamServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	dm := newDirectMessaging()
+	dm.loadBalancer = newLoadBalancer(config.LoadBalancingSpec{Policy: config.LeastRequestPolicy}, "")
+	dm.connectionCreatorFn = func(address, id string, namespace string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error) {
+		return conn, nil
+	}
+	app := newTestRemoteApp(nr_loader.Endpoint{Address: "a"}, nr_loader.Endpoint{Address: "b"})
+
+	_, body, err := dm.invokeRemoteStream(context.Background(), app, invokev1.NewInvokeMethodRequest("method").WithMetadata(map[string][]string{}), strings.NewReader("data"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1}, dm.loadBalancer.outstanding)
+	// the next invocations are sent to the endpoint without outstanding invocations
+	assert.Equal(t, []string{"b", "b"}, []string{dm.loadBalancer.pick(app), dm.loadBalancer.pick(app)})
+
+	ioutil.ReadAll(body)
+	body.Close()
+	assert.Empty(t, dm.loadBalancer.outstanding)
+}
@@ -0,0 +1,77 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package v1
+
+import (
+	"io"
+)
+
+// StreamChunkSize is the maximum size of the body chunks sent in streaming service invocation
+const StreamChunkSize = 32 * 1024
+
+// chunkReader reads a body from the chunks received on a stream
+type chunkReader struct {
+	buf     []byte
+	recv    func() ([]byte, error)
+	closeFn func()
+	err     error
+}
+
+// NewChunkReader returns a reader of the body chunks starting with first and followed by the chunks
+// returned by recv, until recv returns an error. io.EOF from recv marks the end of the body.
+// closeFn, if given, is called when the reader is closed.
+func NewChunkReader(first []byte, recv func() ([]byte, error), closeFn func()) io.ReadCloser {
+	return &chunkReader{
+		buf:     first,
+		recv:    recv,
+		closeFn: closeFn,
+	}
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.err = r.recv()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *chunkReader) Close() error {
+	if r.err == nil {
+		r.err = io.ErrClosedPipe
+	}
+	if r.closeFn != nil {
+		r.closeFn()
+	}
+	return nil
+}
+
+// SendChunks reads r until io.EOF and calls send with each chunk read, of at most StreamChunkSize bytes.
+func SendChunks(r io.Reader, send func([]byte) error) error {
+	buf := make([]byte, StreamChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			// send a copy of the chunk, as buf is reused for the next read
+			chunk := make([]byte, n)
+			copy(chunk, buf[:n])
+			if sendErr := send(chunk); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkReader(t *testing.T) {
+	t.Run("reads all chunks", func(t *testing.T) {
+		chunks := [][]byte{[]byte("b"), {}, []byte("cd")}
+		closed := false
+		r := NewChunkReader([]byte("a"), func() ([]byte, error) {
+			if len(chunks) == 0 {
+				return nil, io.EOF
+			}
+			chunk := chunks[0]
+			chunks = chunks[1:]
+			return chunk, nil
+		}, func() {
+			closed = true
+		})
+
+		data, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "abcd", string(data))
+
+		assert.NoError(t, r.Close())
+		assert.True(t, closed)
+	})
+
+	t.Run("returns stream errors", func(t *testing.T) {
+		r := NewChunkReader(nil, func() ([]byte, error) {
+			return nil, errors.New("stream error")
+		}, nil)
+
+		_, err := ioutil.ReadAll(r)
+		assert.EqualError(t, err, "stream error")
+	})
+}
+
+func TestSendChunks(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), StreamChunkSize*2+1)
+	var chunks [][]byte
+	err := SendChunks(bytes.NewReader(data), func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(chunks))
+	assert.Equal(t, data, bytes.Join(chunks, nil))
+
+	err = SendChunks(bytes.NewReader(data), func(chunk []byte) error {
+		return errors.New("send error")
+	})
+	assert.EqualError(t, err, "send error")
+}
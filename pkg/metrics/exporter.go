@@ -17,6 +17,16 @@ const (
 	defaultMetricsPath     = "/"
 )
 
+// Metrics exporters
+const (
+	// PrometheusExporter serves the metrics to Prometheus on the metrics port
+	PrometheusExporter = "prometheus"
+	// OTLPExporter pushes the metrics to an OpenTelemetry collector
+	OTLPExporter = "otlp"
+	// StatsdExporter pushes the metrics to a StatsD server
+	StatsdExporter = "statsd"
+)
+
 // Exporter is the interface for metrics exporters
 type Exporter interface {
 	// Init intializes metrics exporter
@@ -25,16 +35,14 @@ type Exporter interface {
 	Options() *Options
 }
 
-// NewExporter creates new MetricsExporter instance
+// NewExporter creates new MetricsExporter instance. Init initializes the exporters selected in its options.
 func NewExporter(namespace string) Exporter {
-	// TODO: support multiple exporters
-	return &promMetricsExporter{
+	return &metricsExporters{
 		&exporter{
 			namespace: namespace,
 			options:   defaultMetricOptions(),
 			logger:    logger.NewLogger("dapr.metrics"),
 		},
-		nil,
 	}
 }
 
@@ -50,6 +58,39 @@ func (m *exporter) Options() *Options {
 	return m.options
 }
 
+// metricsExporters initializes the exporters selected in the options, which are active at once
+type metricsExporters struct {
+	*exporter
+}
+
+// Init initializes the selected exporters
+func (m *metricsExporters) Init() error {
+	if !m.exporter.Options().MetricsEnabled {
+		return nil
+	}
+
+	var exporters []Exporter
+	for _, name := range m.options.Exporters() {
+		switch name {
+		case PrometheusExporter:
+			exporters = append(exporters, &promMetricsExporter{m.exporter, nil})
+		case OTLPExporter:
+			exporters = append(exporters, &otlpMetricsExporter{exporter: m.exporter})
+		case StatsdExporter:
+			exporters = append(exporters, &statsdMetricsExporter{exporter: m.exporter})
+		default:
+			return errors.Errorf("unknown metrics exporter %s", name)
+		}
+	}
+
+	for _, e := range exporters {
+		if err := e.Init(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // promMetricsExporter is prometheus metric exporter
 type promMetricsExporter struct {
 	*exporter
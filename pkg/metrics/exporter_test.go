@@ -37,4 +37,16 @@ func TestMetricsExporter(t *testing.T) {
 		err := e.Init()
 		assert.NoError(t, err)
 	})
+
+	t.Run("return error for unknown exporter", func(t *testing.T) {
+		e := NewExporter("test")
+		e.Options().exporters = "prometheus,graphite"
+		assert.Error(t, e.Init())
+	})
+
+	t.Run("return error if otlp endpoint is missing", func(t *testing.T) {
+		e := NewExporter("test")
+		e.Options().exporters = OTLPExporter
+		assert.Error(t, e.Init())
+	})
 }
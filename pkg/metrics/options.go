@@ -7,11 +7,17 @@ package metrics
 
 import (
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
 	defaultMetricsPort    = "9090"
 	defaultMetricsEnabled = true
+	defaultExporters      = PrometheusExporter
+	defaultOTLPProtocol   = "grpc"
+	defaultStatsdAddress  = "localhost:8125"
+	defaultPushInterval   = "10s"
 )
 
 // Options defines the sets of options for Dapr logging
@@ -20,12 +26,26 @@ type Options struct {
 	MetricsEnabled bool
 
 	metricsPort string
+
+	// exporters is the comma separated list of the exporters of the metrics
+	exporters string
+	// otlpEndpoint and otlpProtocol are the address and protocol of the OpenTelemetry collector
+	otlpEndpoint string
+	otlpProtocol string
+	// statsdAddress is the UDP address of the StatsD server
+	statsdAddress string
+	// pushInterval is the interval at which the push exporters send the metrics
+	pushInterval string
 }
 
 func defaultMetricOptions() *Options {
 	return &Options{
 		metricsPort:    defaultMetricsPort,
 		MetricsEnabled: defaultMetricsEnabled,
+		exporters:      defaultExporters,
+		otlpProtocol:   defaultOTLPProtocol,
+		statsdAddress:  defaultStatsdAddress,
+		pushInterval:   defaultPushInterval,
 	}
 }
 
@@ -40,6 +60,43 @@ func (o *Options) MetricsPort() uint64 {
 	return port
 }
 
+// Exporters gets the names of the metrics exporters.
+func (o *Options) Exporters() []string {
+	var exporters []string
+	for _, name := range strings.Split(o.exporters, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			exporters = append(exporters, name)
+		}
+	}
+	return exporters
+}
+
+// OTLPEndpoint gets the address of the OpenTelemetry collector.
+func (o *Options) OTLPEndpoint() string {
+	return o.otlpEndpoint
+}
+
+// OTLPProtocol gets the protocol of the OpenTelemetry collector, grpc or http.
+func (o *Options) OTLPProtocol() string {
+	return strings.ToLower(o.otlpProtocol)
+}
+
+// StatsdAddress gets the address of the StatsD server.
+func (o *Options) StatsdAddress() string {
+	return o.statsdAddress
+}
+
+// PushInterval gets the interval at which the push exporters send the metrics.
+func (o *Options) PushInterval() time.Duration {
+	interval, err := time.ParseDuration(o.pushInterval)
+	if err != nil || interval <= 0 {
+		// Use default push interval as a fallback
+		interval, _ = time.ParseDuration(defaultPushInterval)
+	}
+
+	return interval
+}
+
 // AttachCmdFlags attaches metrics options to command flags
 func (o *Options) AttachCmdFlags(
 	stringVar func(p *string, name string, value string, usage string),
@@ -54,6 +111,7 @@ func (o *Options) AttachCmdFlags(
 		"enable-metrics",
 		defaultMetricsEnabled,
 		"Enable prometheus metric")
+	o.attachExporterCmdFlags(stringVar)
 }
 
 // AttachCmdFlag attaches single metrics option to command flags
@@ -64,4 +122,35 @@ func (o *Options) AttachCmdFlag(
 		"metrics-port",
 		defaultMetricsPort,
 		"The port for the metrics server")
+	o.attachExporterCmdFlags(stringVar)
+}
+
+// attachExporterCmdFlags attaches the options of the metrics exporters to command flags
+func (o *Options) attachExporterCmdFlags(
+	stringVar func(p *string, name string, value string, usage string)) {
+	stringVar(
+		&o.exporters,
+		"metrics-exporters",
+		defaultExporters,
+		"Comma separated list of the metrics exporters: prometheus, otlp, statsd")
+	stringVar(
+		&o.otlpEndpoint,
+		"metrics-otlp-endpoint",
+		"",
+		"Address of the OpenTelemetry collector: host:port for grpc, with an http:// scheme to disable TLS, or the URL of the metrics endpoint for http")
+	stringVar(
+		&o.otlpProtocol,
+		"metrics-otlp-protocol",
+		defaultOTLPProtocol,
+		"Protocol of the OpenTelemetry collector: grpc or http")
+	stringVar(
+		&o.statsdAddress,
+		"metrics-statsd-address",
+		defaultStatsdAddress,
+		"UDP address of the StatsD server")
+	stringVar(
+		&o.pushInterval,
+		"metrics-push-interval",
+		defaultPushInterval,
+		"Interval at which the otlp and statsd exporters send the metrics")
 }
@@ -8,6 +8,7 @@ package metrics
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -78,4 +79,36 @@ func TestOptions(t *testing.T) {
 		// assert
 		assert.True(t, metricsPortAsserted)
 	})
+
+	t.Run("attaching metrics exporters cmd flags", func(t *testing.T) {
+		o := defaultMetricOptions()
+
+		attached := map[string]string{}
+		testStringVarFn := func(p *string, name string, value string, usage string) {
+			attached[name] = value
+		}
+
+		o.AttachCmdFlag(testStringVarFn)
+
+		// assert
+		assert.Equal(t, defaultExporters, attached["metrics-exporters"])
+		assert.Equal(t, defaultOTLPProtocol, attached["metrics-otlp-protocol"])
+		assert.Equal(t, defaultStatsdAddress, attached["metrics-statsd-address"])
+		assert.Equal(t, defaultPushInterval, attached["metrics-push-interval"])
+		assert.Contains(t, attached, "metrics-otlp-endpoint")
+	})
+
+	t.Run("parse exporters", func(t *testing.T) {
+		o := Options{exporters: " Prometheus, statsd,,otlp "}
+
+		assert.Equal(t, []string{PrometheusExporter, StatsdExporter, OTLPExporter}, o.Exporters())
+	})
+
+	t.Run("parse push interval", func(t *testing.T) {
+		o := Options{pushInterval: "30s"}
+		assert.Equal(t, 30*time.Second, o.PushInterval())
+
+		o.pushInterval = "invalid"
+		assert.Equal(t, 10*time.Second, o.PushInterval())
+	})
 }
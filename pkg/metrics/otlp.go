@@ -0,0 +1,163 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package metrics
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/otlp"
+	collectormetricspb "github.com/dapr/dapr/pkg/otlp/proto/collector/metrics/v1"
+	metricspb "github.com/dapr/dapr/pkg/otlp/proto/metrics/v1"
+	resourcepb "github.com/dapr/dapr/pkg/otlp/proto/resource/v1"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats/view"
+)
+
+// otlpExportTimeout is the timeout of each export call to the collector
+const otlpExportTimeout = time.Second * 10
+
+// otlpMetricsExporter pushes the metrics to an OpenTelemetry collector with OTLP over gRPC or HTTP
+type otlpMetricsExporter struct {
+	*exporter
+	*viewPusher
+	client   *otlp.Client
+	resource *resourcepb.Resource
+}
+
+// Init connects to the collector and registers the exporter to view
+func (m *otlpMetricsExporter) Init() error {
+	endpoint := m.options.OTLPEndpoint()
+	if endpoint == "" {
+		return errors.New("metrics-otlp-endpoint is required by the otlp metrics exporter")
+	}
+
+	protocol := m.options.OTLPProtocol()
+	if protocol != otlp.GRPCProtocol && protocol != otlp.HTTPProtocol {
+		return errors.Errorf("invalid metrics otlp protocol %s, must be %s or %s", protocol, otlp.GRPCProtocol, otlp.HTTPProtocol)
+	}
+
+	// the scheme of a grpc endpoint selects whether the connection uses TLS
+	insecure := false
+	if protocol == otlp.GRPCProtocol {
+		if strings.HasPrefix(endpoint, "http://") {
+			insecure = true
+		}
+		endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
+	}
+
+	var err error
+	m.client, err = otlp.NewClient(otlp.ClientOptions{
+		EndpointAddress: endpoint,
+		Protocol:        protocol,
+		Insecure:        insecure,
+		TLSConfig:       &tls.Config{},
+		Timeout:         otlpExportTimeout,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create OTLP metrics exporter: %v", err)
+	}
+	m.resource = otlp.NewResource(filepath.Base(os.Args[0]))
+	m.viewPusher = newViewPusher(m.options.PushInterval(), m.push)
+
+	view.RegisterExporter(m)
+	m.exporter.logger.Infof("exporting metrics to otel collector %s every %s", m.options.OTLPEndpoint(), m.options.PushInterval())
+	return nil
+}
+
+func (m *otlpMetricsExporter) push(data []*view.Data) {
+	if err := m.client.Export(otlp.MetricsExportMethod, m.newRequest(data)); err != nil {
+		m.exporter.logger.Warnf("error exporting metrics to otel collector: %s", err)
+	}
+}
+
+// newRequest returns an ExportMetricsServiceRequest with the metrics of the views
+func (m *otlpMetricsExporter) newRequest(data []*view.Data) *collectormetricspb.ExportMetricsServiceRequest {
+	metrics := make([]*metricspb.Metric, 0, len(data))
+	for _, vd := range data {
+		metrics = append(metrics, m.newMetric(vd))
+	}
+
+	return &collectormetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			Resource: m.resource,
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Scope:   otlp.NewScope(),
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
+
+// newMetric returns the Metric of a view. Count and sum aggregations are converted to
+// monotonic sums, last value aggregations to gauges and distributions to histograms.
+func (m *otlpMetricsExporter) newMetric(vd *view.Data) *metricspb.Metric {
+	var points []*metricspb.NumberDataPoint
+	var histogramPoints []*metricspb.HistogramDataPoint
+	for _, row := range vd.Rows {
+		attributes := make(map[string]interface{}, len(row.Tags))
+		for _, t := range row.Tags {
+			attributes[t.Key.Name()] = t.Value
+		}
+
+		point := &metricspb.NumberDataPoint{
+			Attributes:        otlp.NewAttributes(attributes),
+			StartTimeUnixNano: uint64(vd.Start.UnixNano()),
+			TimeUnixNano:      uint64(vd.End.UnixNano()),
+		}
+		switch d := row.Data.(type) {
+		case *view.CountData:
+			point.Value = &metricspb.NumberDataPoint_AsInt{AsInt: d.Value}
+		case *view.SumData:
+			point.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: d.Value}
+		case *view.LastValueData:
+			point.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: d.Value}
+		case *view.DistributionData:
+			counts := make([]uint64, len(d.CountPerBucket))
+			for i, c := range d.CountPerBucket {
+				counts[i] = uint64(c)
+			}
+			histogramPoints = append(histogramPoints, &metricspb.HistogramDataPoint{
+				Attributes:        point.Attributes,
+				StartTimeUnixNano: point.StartTimeUnixNano,
+				TimeUnixNano:      point.TimeUnixNano,
+				Count:             uint64(d.Count),
+				XSum:              &metricspb.HistogramDataPoint_Sum{Sum: d.Mean * float64(d.Count)},
+				BucketCounts:      counts,
+				ExplicitBounds:    vd.View.Aggregation.Buckets,
+			})
+			continue
+		default:
+			continue
+		}
+		points = append(points, point)
+	}
+
+	metric := &metricspb.Metric{
+		Name:        metricName(m.namespace, vd.View.Name),
+		Description: vd.View.Description,
+		Unit:        vd.View.Measure.Unit(),
+	}
+	switch vd.View.Aggregation.Type {
+	case view.AggTypeLastValue:
+		metric.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: points}}
+	case view.AggTypeDistribution:
+		metric.Data = &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			DataPoints:             histogramPoints,
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}}
+	default:
+		metric.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			DataPoints:             points,
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		}}
+	}
+	return metric
+}
@@ -0,0 +1,77 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats/view"
+)
+
+// viewPusher keeps the latest data of each view reported by opencensus and pushes them
+// all at once at every interval, so that push exporters send a single request per interval.
+type viewPusher struct {
+	push func(data []*view.Data)
+
+	lock  sync.Mutex
+	views map[string]*view.Data
+}
+
+func newViewPusher(interval time.Duration, push func(data []*view.Data)) *viewPusher {
+	p := &viewPusher{
+		push:  push,
+		views: map[string]*view.Data{},
+	}
+	go func() {
+		for range time.Tick(interval) {
+			p.flush()
+		}
+	}()
+	return p
+}
+
+// ExportView implements the opencensus view exporter interface
+func (p *viewPusher) ExportView(vd *view.Data) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.views[vd.View.Name] = vd
+}
+
+// flush pushes the data reported since the last flush, sorted by view name
+func (p *viewPusher) flush() {
+	p.lock.Lock()
+	data := make([]*view.Data, 0, len(p.views))
+	for _, vd := range p.views {
+		data = append(data, vd)
+	}
+	p.views = map[string]*view.Data{}
+	p.lock.Unlock()
+
+	if len(data) == 0 {
+		return
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].View.Name < data[j].View.Name
+	})
+	p.push(data)
+}
+
+// metricName returns the name of the metric of a view, with the same format as the prometheus exporter
+func metricName(namespace, viewName string) string {
+	name := viewName
+	if namespace != "" {
+		name = namespace + "_" + viewName
+	}
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,172 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package metrics
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/logger"
+	"github.com/dapr/dapr/pkg/otlp"
+	collectormetricspb "github.com/dapr/dapr/pkg/otlp/proto/collector/metrics/v1"
+	metricspb "github.com/dapr/dapr/pkg/otlp/proto/metrics/v1"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+func testExporter(options *Options) *exporter {
+	return &exporter{
+		namespace: "dapr",
+		options:   options,
+		logger:    logger.NewLogger("dapr.metrics"),
+	}
+}
+
+func testViewData(count int64, latency float64) []*view.Data {
+	appID := tag.MustNewKey("app_id")
+	start := time.Unix(100, 0)
+	end := time.Unix(110, 0)
+	return []*view.Data{
+		{
+			View: &view.View{
+				Name:        "runtime/service_invocation/req_sent_total",
+				Description: "requests sent",
+				Measure:     stats.Int64("req_sent_total", "requests sent", stats.UnitDimensionless),
+				Aggregation: view.Count(),
+			},
+			Start: start,
+			End:   end,
+			Rows: []*view.Row{{
+				Tags: []tag.Tag{{Key: appID, Value: "app1"}},
+				Data: &view.CountData{Value: count},
+			}},
+		},
+		{
+			View: &view.View{
+				Name:        "runtime/service_invocation/latency",
+				Measure:     stats.Float64("latency", "latency", stats.UnitMilliseconds),
+				Aggregation: view.Distribution(10, 100),
+			},
+			Start: start,
+			End:   end,
+			Rows: []*view.Row{{
+				Tags: []tag.Tag{{Key: appID, Value: "app1"}},
+				Data: &view.DistributionData{Count: count, Mean: latency, CountPerBucket: []int64{count, 0, 0}},
+			}},
+		},
+	}
+}
+
+func TestMetricName(t *testing.T) {
+	assert.Equal(t, "dapr_runtime_service_invocation_req_sent_total", metricName("dapr", "runtime/service_invocation/req_sent_total"))
+	assert.Equal(t, "grpc_io_server_completed_rpcs", metricName("", "grpc.io/server/completed_rpcs"))
+}
+
+func TestStatsdExporter(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	options := defaultMetricOptions()
+	options.statsdAddress = conn.LocalAddr().String()
+	options.pushInterval = "1h"
+	e := &statsdMetricsExporter{exporter: testExporter(options)}
+	require.NoError(t, e.Init())
+	defer view.UnregisterExporter(e)
+
+	receive := func() []string {
+		buf := make([]byte, statsdMaxPacketSize)
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		return strings.Split(string(buf[:n]), "\n")
+	}
+
+	for _, vd := range testViewData(4, 5) {
+		e.ExportView(vd)
+	}
+	e.flush()
+	assert.Equal(t, []string{
+		"dapr_runtime_service_invocation_latency_count:4|c|#app_id:app1",
+		"dapr_runtime_service_invocation_latency_sum:20|c|#app_id:app1",
+		"dapr_runtime_service_invocation_req_sent_total:4|c|#app_id:app1",
+	}, receive())
+
+	// counters send the increment since the previous push
+	for _, vd := range testViewData(6, 5) {
+		e.ExportView(vd)
+	}
+	e.flush()
+	assert.Equal(t, []string{
+		"dapr_runtime_service_invocation_latency_count:2|c|#app_id:app1",
+		"dapr_runtime_service_invocation_latency_sum:10|c|#app_id:app1",
+		"dapr_runtime_service_invocation_req_sent_total:2|c|#app_id:app1",
+	}, receive())
+}
+
+func TestOTLPMetricsExporter(t *testing.T) {
+	requests := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, otlp.ContentType, r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- body
+	}))
+	defer server.Close()
+
+	options := defaultMetricOptions()
+	options.otlpEndpoint = server.URL + "/v1/metrics"
+	options.otlpProtocol = "http"
+	options.pushInterval = "1h"
+	e := &otlpMetricsExporter{exporter: testExporter(options)}
+	require.NoError(t, e.Init())
+	defer view.UnregisterExporter(e)
+
+	for _, vd := range testViewData(4, 5) {
+		e.ExportView(vd)
+	}
+	e.flush()
+
+	var req collectormetricspb.ExportMetricsServiceRequest
+	require.NoError(t, proto.Unmarshal(<-requests, &req))
+	require.Len(t, req.ResourceMetrics, 1)
+	require.Len(t, req.ResourceMetrics[0].ScopeMetrics, 1)
+	metrics := req.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	require.Len(t, metrics, 2)
+
+	// the distribution is exported as a histogram
+	assert.Equal(t, "dapr_runtime_service_invocation_latency", metrics[0].Name)
+	assert.Equal(t, "ms", metrics[0].Unit)
+	histogram := metrics[0].GetHistogram()
+	require.NotNil(t, histogram)
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, histogram.AggregationTemporality)
+	require.Len(t, histogram.DataPoints, 1)
+	point := histogram.DataPoints[0]
+	assert.Equal(t, uint64(4), point.Count)
+	assert.Equal(t, float64(20), point.GetSum())
+	assert.Len(t, point.BucketCounts, 3)
+	assert.Len(t, point.ExplicitBounds, 2)
+	require.Len(t, point.Attributes, 1)
+	assert.Equal(t, "app_id", point.Attributes[0].Key)
+
+	// the count is exported as a monotonic sum
+	assert.Equal(t, "dapr_runtime_service_invocation_req_sent_total", metrics[1].Name)
+	sum := metrics[1].GetSum()
+	require.NotNil(t, sum)
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.AggregationTemporality)
+	assert.True(t, sum.IsMonotonic)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(4), sum.DataPoints[0].GetAsInt())
+	assert.Equal(t, uint64(time.Unix(110, 0).UnixNano()), sum.DataPoints[0].TimeUnixNano)
+}
@@ -0,0 +1,124 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package metrics
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats/view"
+)
+
+// statsdMaxPacketSize keeps the packets under the MTU of most networks
+const statsdMaxPacketSize = 1432
+
+// statsdReplacer replaces the characters that are reserved by the StatsD line format
+var statsdReplacer = strings.NewReplacer(":", "_", "|", "_", ",", "_", "#", "_", "@", "_", "\n", "_")
+
+// statsdMetricsExporter pushes the metrics to a StatsD server over UDP. The tags of the views are
+// sent with the DogStatsD tag format. Since the views are cumulative, counters send the difference
+// with the value pushed at the previous interval.
+type statsdMetricsExporter struct {
+	*exporter
+	*viewPusher
+	conn net.Conn
+
+	// previous holds the cumulative values of the counters sent at the previous interval
+	previous map[string]float64
+}
+
+// Init connects to the StatsD server and registers the exporter to view
+func (m *statsdMetricsExporter) Init() error {
+	conn, err := net.Dial("udp", m.options.StatsdAddress())
+	if err != nil {
+		return errors.Errorf("failed to create StatsD exporter: %v", err)
+	}
+	m.conn = conn
+	m.previous = map[string]float64{}
+	m.viewPusher = newViewPusher(m.options.PushInterval(), m.push)
+
+	view.RegisterExporter(m)
+	m.exporter.logger.Infof("exporting metrics to statsd server %s every %s", m.options.StatsdAddress(), m.options.PushInterval())
+	return nil
+}
+
+func (m *statsdMetricsExporter) push(data []*view.Data) {
+	var packet bytes.Buffer
+	send := func() {
+		if packet.Len() == 0 {
+			return
+		}
+		if _, err := m.conn.Write(packet.Bytes()); err != nil {
+			m.exporter.logger.Warnf("error exporting metrics to statsd server: %s", err)
+		}
+		packet.Reset()
+	}
+
+	for _, line := range m.lines(data) {
+		if packet.Len() > 0 && packet.Len()+len(line)+1 > statsdMaxPacketSize {
+			send()
+		}
+		if packet.Len() > 0 {
+			packet.WriteByte('\n')
+		}
+		packet.WriteString(line)
+	}
+	send()
+}
+
+// lines returns the StatsD lines of the views. Count and sum aggregations are sent as counters,
+// last value aggregations as gauges and distributions as the count and sum counters of the values.
+func (m *statsdMetricsExporter) lines(data []*view.Data) []string {
+	var lines []string
+	for _, vd := range data {
+		name := metricName(m.namespace, vd.View.Name)
+		for _, row := range vd.Rows {
+			tags := make([]string, 0, len(row.Tags))
+			for _, t := range row.Tags {
+				tags = append(tags, statsdReplacer.Replace(t.Key.Name())+":"+statsdReplacer.Replace(t.Value))
+			}
+
+			switch d := row.Data.(type) {
+			case *view.CountData:
+				lines = m.appendCounter(lines, name, tags, float64(d.Value))
+			case *view.SumData:
+				lines = m.appendCounter(lines, name, tags, d.Value)
+			case *view.LastValueData:
+				lines = append(lines, statsdLine(name, d.Value, "g", tags))
+			case *view.DistributionData:
+				lines = m.appendCounter(lines, name+"_count", tags, float64(d.Count))
+				lines = m.appendCounter(lines, name+"_sum", tags, d.Mean*float64(d.Count))
+			}
+		}
+	}
+	return lines
+}
+
+// appendCounter appends the increment of a counter since the previous interval, if any
+func (m *statsdMetricsExporter) appendCounter(lines []string, name string, tags []string, value float64) []string {
+	key := name + "|" + strings.Join(tags, ",")
+	delta := value - m.previous[key]
+	if delta < 0 {
+		// the counter was reset
+		delta = value
+	}
+	m.previous[key] = value
+	if delta == 0 {
+		return lines
+	}
+	return append(lines, statsdLine(name, delta, "c", tags))
+}
+
+func statsdLine(name string, value float64, metricType string, tags []string) string {
+	line := name + ":" + strconv.FormatFloat(value, 'f', -1, 64) + "|" + metricType
+	if len(tags) > 0 {
+		line += "|#" + strings.Join(tags, ",")
+	}
+	return line
+}
@@ -0,0 +1,44 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Middleware is a gRPC middleware made of a unary and a stream server interceptor.
+// Either interceptor can be nil if the middleware doesn't apply to that kind of call.
+type Middleware struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+// Pipeline defines the gRPC middleware pipeline to be plugged into the Dapr sidecar
+type Pipeline struct {
+	Handlers []Middleware
+}
+
+// UnaryInterceptors returns the unary server interceptors of the pipeline in order
+func (p Pipeline) UnaryInterceptors() []grpc.UnaryServerInterceptor {
+	var interceptors []grpc.UnaryServerInterceptor
+	for _, h := range p.Handlers {
+		if h.Unary != nil {
+			interceptors = append(interceptors, h.Unary)
+		}
+	}
+	return interceptors
+}
+
+// StreamInterceptors returns the stream server interceptors of the pipeline in order
+func (p Pipeline) StreamInterceptors() []grpc.StreamServerInterceptor {
+	var interceptors []grpc.StreamServerInterceptor
+	for _, h := range p.Handlers {
+		if h.Stream != nil {
+			interceptors = append(interceptors, h.Stream)
+		}
+	}
+	return interceptors
+}
@@ -0,0 +1,60 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/dapr/components-contrib/middleware"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRequestsPerSecondKey = "maxRequestsPerSecond"
+
+	// Defaults
+	defaultMaxRequestsPerSecond = 100
+)
+
+// NewRateLimitMiddleware returns a gRPC middleware rejecting calls with ResourceExhausted
+// once more than maxRequestsPerSecond calls are made in a second, with the same metadata
+// as the ratelimit HTTP middleware.
+func NewRateLimitMiddleware(metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+	maxRequestsPerSecond := float64(defaultMaxRequestsPerSecond)
+	if val, ok := metadata.Properties[maxRequestsPerSecondKey]; ok {
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return grpc_middleware.Middleware{}, errors.Wrapf(err, "error parsing ratelimit middleware property %s", maxRequestsPerSecondKey)
+		}
+		if f <= 0 {
+			return grpc_middleware.Middleware{}, errors.Errorf("ratelimit middleware property %s must be a positive value", maxRequestsPerSecondKey)
+		}
+		maxRequestsPerSecond = f
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(maxRequestsPerSecond), int(math.Ceil(maxRequestsPerSecond)))
+	return grpc_middleware.Middleware{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if !limiter.Allow() {
+				return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+			}
+			return handler(ctx, req)
+		},
+		Stream: func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if !limiter.Allow() {
+				return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+			}
+			return handler(srv, stream)
+		},
+	}, nil
+}
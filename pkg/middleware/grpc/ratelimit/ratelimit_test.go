@@ -0,0 +1,49 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewRateLimitMiddleware(t *testing.T) {
+	t.Run("calls over the limit are rejected", func(t *testing.T) {
+		m, err := NewRateLimitMiddleware(middleware.Metadata{Properties: map[string]string{maxRequestsPerSecondKey: "2"}})
+		assert.NoError(t, err)
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return req, nil
+		}
+		for i := 0; i < 2; i++ {
+			resp, err := m.Unary(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, "req", resp)
+		}
+
+		_, err = m.Unary(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		err = m.Stream(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := NewRateLimitMiddleware(middleware.Metadata{Properties: map[string]string{maxRequestsPerSecondKey: "0"}})
+		assert.Error(t, err)
+
+		_, err = NewRateLimitMiddleware(middleware.Metadata{Properties: map[string]string{maxRequestsPerSecondKey: "a"}})
+		assert.Error(t, err)
+	})
+}
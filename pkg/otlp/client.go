@@ -0,0 +1,174 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// GRPCProtocol sends the requests to the collector with gRPC
+	GRPCProtocol = "grpc"
+	// HTTPProtocol sends the requests to the collector with HTTP/protobuf
+	HTTPProtocol = "http"
+
+	// ContentType is the content type of the requests sent with the http protocol
+	ContentType = "application/x-protobuf"
+
+	// TraceExportMethod is the gRPC method of the OTLP trace service
+	TraceExportMethod = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+	// MetricsExportMethod is the gRPC method of the OTLP metrics service
+	MetricsExportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+	// LogsExportMethod is the gRPC method of the OTLP logs service
+	LogsExportMethod = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+)
+
+// ClientOptions are the options of the connection to a collector
+type ClientOptions struct {
+	// EndpointAddress is the host:port of the collector for the grpc protocol or its URL for the http protocol
+	EndpointAddress string
+	Protocol        string
+	// Insecure disables TLS for the grpc protocol
+	Insecure  bool
+	Headers   map[string]string
+	TLSConfig *tls.Config
+	Timeout   time.Duration
+}
+
+// Client sends OTLP export requests to a collector over gRPC or HTTP
+type Client struct {
+	headers map[string]string
+	timeout time.Duration
+
+	// conn is the connection to the collector for the grpc protocol
+	conn *grpc.ClientConn
+	// client and url are used for the http protocol
+	client *http.Client
+	url    string
+}
+
+// NewClient returns a client of the collector at opts.EndpointAddress
+func NewClient(opts ClientOptions) (*Client, error) {
+	c := &Client{
+		headers: opts.Headers,
+		timeout: opts.Timeout,
+	}
+
+	if opts.Protocol == HTTPProtocol {
+		c.url = opts.EndpointAddress
+		c.client = &http.Client{
+			Timeout:   opts.Timeout,
+			Transport: &http.Transport{TLSClientConfig: opts.TLSConfig},
+		}
+		return c, nil
+	}
+
+	creds := grpc.WithTransportCredentials(credentials.NewTLS(opts.TLSConfig))
+	if opts.Insecure {
+		creds = grpc.WithInsecure()
+	}
+	conn, err := grpc.Dial(opts.EndpointAddress, creds)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	return c, nil
+}
+
+// Export sends an export request. method is the gRPC method of the request, the http protocol
+// posts the request to the URL of the collector.
+func (c *Client) Export(method string, req proto.Message) error {
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if c.conn != nil {
+		return c.exportGRPC(method, b)
+	}
+	return c.exportHTTP(b)
+}
+
+// Close closes the connection to the collector
+func (c *Client) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+func (c *Client) exportGRPC(method string, req []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	if len(c.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(c.headers))
+	}
+
+	var resp []byte
+	return c.conn.Invoke(ctx, method, &req, &resp, grpc.ForceCodec(RawCodec{}))
+}
+
+func (c *Client) exportHTTP(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ContentType)
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body so that the connection is reused
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// RawCodec sends and receives messages that are already encoded
+type RawCodec struct{}
+
+// Marshal returns the encoded message
+func (RawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, errors.Errorf("unexpected message type %T", v)
+	}
+	return *b, nil
+}
+
+// Unmarshal copies the encoded message
+func (RawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return errors.Errorf("unexpected message type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+// Name returns the name of the codec
+func (RawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,75 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package otlp
+
+import (
+	"fmt"
+	"sort"
+
+	commonpb "github.com/dapr/dapr/pkg/otlp/proto/common/v1"
+	resourcepb "github.com/dapr/dapr/pkg/otlp/proto/resource/v1"
+)
+
+const (
+	// ScopeName is the name of the instrumentation scope of the exported telemetry
+	ScopeName = "dapr"
+	// ServiceNameAttribute is the resource attribute with the name of the service
+	ServiceNameAttribute = "service.name"
+)
+
+// NewResource returns the Resource of a service
+func NewResource(serviceName string) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{NewKeyValue(ServiceNameAttribute, serviceName)},
+	}
+}
+
+// NewScope returns the InstrumentationScope of the exported telemetry
+func NewScope() *commonpb.InstrumentationScope {
+	return &commonpb.InstrumentationScope{Name: ScopeName}
+}
+
+// NewKeyValue returns a KeyValue. Values of unsupported types are converted to strings.
+func NewKeyValue(key string, value interface{}) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: NewAnyValue(value)}
+}
+
+// NewAnyValue returns an AnyValue. Values of unsupported types are converted to strings.
+func NewAnyValue(value interface{}) *commonpb.AnyValue {
+	switch v := value.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
+
+// NewAttributes returns the attributes as KeyValues, sorted by key
+func NewAttributes(attributes map[string]interface{}) []*commonpb.KeyValue {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	// sort the keys for stable requests
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kvs := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, NewKeyValue(k, attributes[k]))
+	}
+	return kvs
+}
@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/collector/logs/v1/logs_service.proto
+
+package logs
+
+import (
+	fmt "fmt"
+	v1 "github.com/dapr/dapr/pkg/otlp/proto/logs/v1"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ExportLogsServiceRequest struct {
+	ResourceLogs         []*v1.ResourceLogs `protobuf:"bytes,1,rep,name=resource_logs,json=resourceLogs,proto3" json:"resource_logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExportLogsServiceRequest) Reset()         { *m = ExportLogsServiceRequest{} }
+func (m *ExportLogsServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportLogsServiceRequest) ProtoMessage()    {}
+func (*ExportLogsServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e3bf87aaa43acd4, []int{0}
+}
+
+func (m *ExportLogsServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLogsServiceRequest.Unmarshal(m, b)
+}
+func (m *ExportLogsServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLogsServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportLogsServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLogsServiceRequest.Merge(m, src)
+}
+func (m *ExportLogsServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportLogsServiceRequest.Size(m)
+}
+func (m *ExportLogsServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLogsServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLogsServiceRequest proto.InternalMessageInfo
+
+func (m *ExportLogsServiceRequest) GetResourceLogs() []*v1.ResourceLogs {
+	if m != nil {
+		return m.ResourceLogs
+	}
+	return nil
+}
+
+type ExportLogsServiceResponse struct {
+	PartialSuccess       *ExportLogsPartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ExportLogsServiceResponse) Reset()         { *m = ExportLogsServiceResponse{} }
+func (m *ExportLogsServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportLogsServiceResponse) ProtoMessage()    {}
+func (*ExportLogsServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e3bf87aaa43acd4, []int{1}
+}
+
+func (m *ExportLogsServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLogsServiceResponse.Unmarshal(m, b)
+}
+func (m *ExportLogsServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLogsServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportLogsServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLogsServiceResponse.Merge(m, src)
+}
+func (m *ExportLogsServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportLogsServiceResponse.Size(m)
+}
+func (m *ExportLogsServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLogsServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLogsServiceResponse proto.InternalMessageInfo
+
+func (m *ExportLogsServiceResponse) GetPartialSuccess() *ExportLogsPartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return nil
+}
+
+type ExportLogsPartialSuccess struct {
+	RejectedLogRecords   int64    `protobuf:"varint,1,opt,name=rejected_log_records,json=rejectedLogRecords,proto3" json:"rejected_log_records,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportLogsPartialSuccess) Reset()         { *m = ExportLogsPartialSuccess{} }
+func (m *ExportLogsPartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportLogsPartialSuccess) ProtoMessage()    {}
+func (*ExportLogsPartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e3bf87aaa43acd4, []int{2}
+}
+
+func (m *ExportLogsPartialSuccess) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLogsPartialSuccess.Unmarshal(m, b)
+}
+func (m *ExportLogsPartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLogsPartialSuccess.Marshal(b, m, deterministic)
+}
+func (m *ExportLogsPartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLogsPartialSuccess.Merge(m, src)
+}
+func (m *ExportLogsPartialSuccess) XXX_Size() int {
+	return xxx_messageInfo_ExportLogsPartialSuccess.Size(m)
+}
+func (m *ExportLogsPartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLogsPartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLogsPartialSuccess proto.InternalMessageInfo
+
+func (m *ExportLogsPartialSuccess) GetRejectedLogRecords() int64 {
+	if m != nil {
+		return m.RejectedLogRecords
+	}
+	return 0
+}
+
+func (m *ExportLogsPartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExportLogsServiceRequest)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest")
+	proto.RegisterType((*ExportLogsServiceResponse)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsServiceResponse")
+	proto.RegisterType((*ExportLogsPartialSuccess)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsPartialSuccess")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/collector/logs/v1/logs_service.proto", fileDescriptor_8e3bf87aaa43acd4)
+}
+
+var fileDescriptor_8e3bf87aaa43acd4 = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xee, 0xd2, 0x40,
+	0x10, 0xc6, 0xdd, 0x3f, 0x09, 0x89, 0x0b, 0xa8, 0xd9, 0x78, 0xa8, 0x9c, 0x48, 0x0d, 0xa6, 0x5e,
+	0xb6, 0x82, 0x17, 0xa3, 0x07, 0x0d, 0xc6, 0x1b, 0x2a, 0x29, 0xc6, 0x83, 0x97, 0xa6, 0x2c, 0x93,
+	0x52, 0x2c, 0xcc, 0x32, 0xbb, 0x25, 0xfa, 0x00, 0x1e, 0x7d, 0x04, 0x2f, 0x1e, 0x7d, 0x4a, 0xb3,
+	0x5d, 0xc4, 0x92, 0x40, 0x82, 0x5e, 0xba, 0xd9, 0x99, 0xf9, 0x7e, 0xdf, 0x7c, 0x6d, 0xf9, 0x33,
+	0xd4, 0xb0, 0xb5, 0x50, 0xc2, 0x06, 0x2c, 0x7d, 0x8d, 0x35, 0xa1, 0xc5, 0x58, 0x61, 0x59, 0x82,
+	0xb2, 0x48, 0x71, 0x89, 0xb9, 0x89, 0xf7, 0xa3, 0xfa, 0x4c, 0x0d, 0xd0, 0xbe, 0x50, 0x20, 0xeb,
+	0x21, 0x31, 0x3c, 0x51, 0xfa, 0xa2, 0x3c, 0x2a, 0xa5, 0x53, 0xc8, 0xfd, 0xa8, 0xff, 0xe8, 0x9c,
+	0x41, 0x13, 0xeb, 0x95, 0xe1, 0x9a, 0x07, 0x6f, 0xbe, 0x68, 0x24, 0x3b, 0xc5, 0xdc, 0xcc, 0xbd,
+	0x53, 0x02, 0xbb, 0x0a, 0x8c, 0x15, 0xef, 0x78, 0x8f, 0xc0, 0x60, 0x45, 0x0a, 0x52, 0x27, 0x09,
+	0xd8, 0xa0, 0x15, 0x75, 0xc6, 0x8f, 0xe5, 0xb9, 0x15, 0x0e, 0xc6, 0x32, 0x39, 0x28, 0x1c, 0x2f,
+	0xe9, 0x52, 0xe3, 0x16, 0x7e, 0x63, 0xfc, 0xc1, 0x19, 0x33, 0xa3, 0x71, 0x6b, 0x40, 0xac, 0xf8,
+	0x5d, 0x9d, 0x91, 0x2d, 0xb2, 0x32, 0x35, 0x95, 0x52, 0x60, 0x9c, 0x1f, 0x8b, 0x3a, 0xe3, 0x97,
+	0xf2, 0xaa, 0xc8, 0xf2, 0x2f, 0x7a, 0xe6, 0x39, 0x73, 0x8f, 0x49, 0xee, 0xe8, 0x93, 0x7b, 0xb8,
+	0xe3, 0xc1, 0xa5, 0x59, 0xf1, 0x84, 0xdf, 0x27, 0x58, 0x83, 0xb2, 0xb0, 0x74, 0x99, 0x53, 0x02,
+	0x85, 0xb4, 0xf4, 0xab, 0xb4, 0x12, 0xf1, 0xa7, 0x37, 0xc5, 0x3c, 0xf1, 0x1d, 0xf1, 0x90, 0xf7,
+	0x80, 0x08, 0x29, 0xdd, 0x80, 0x31, 0x59, 0x0e, 0xc1, 0xcd, 0x80, 0x45, 0xb7, 0x93, 0x6e, 0x5d,
+	0x7c, 0xeb, 0x6b, 0xe3, 0x1f, 0x8c, 0x77, 0x1a, 0xa1, 0xc5, 0x77, 0xc6, 0xdb, 0x7e, 0x07, 0xf1,
+	0xef, 0xf1, 0x4e, 0x3f, 0x53, 0xff, 0xd5, 0xff, 0x03, 0xfc, 0xab, 0x0f, 0x6f, 0x4d, 0x7e, 0x32,
+	0x1e, 0x15, 0x78, 0x1d, 0x68, 0x72, 0xaf, 0xc1, 0x98, 0xb9, 0x99, 0x19, 0xfb, 0xf4, 0x3c, 0x2f,
+	0xec, 0xaa, 0x5a, 0x48, 0x85, 0x9b, 0x78, 0x99, 0x69, 0xf2, 0x0f, 0xfd, 0x39, 0x8f, 0xd1, 0x96,
+	0xfa, 0xd2, 0x0f, 0xfe, 0xc2, 0x9d, 0xbf, 0x6e, 0x86, 0xef, 0x35, 0x6c, 0x3f, 0x1c, 0x7d, 0x6b,
+	0xa6, 0x7c, 0x7d, 0xf4, 0x75, 0x76, 0xf2, 0xe3, 0x68, 0xd1, 0xae, 0x29, 0x4f, 0x7f, 0x0f, 0x00,
+	0x69, 0x55, 0xfd, 0xa2, 0x3d, 0x03, 0x00, 0x00,
+}
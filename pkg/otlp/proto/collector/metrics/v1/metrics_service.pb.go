@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/collector/metrics/v1/metrics_service.proto
+
+package metrics
+
+import (
+	fmt "fmt"
+	v1 "github.com/dapr/dapr/pkg/otlp/proto/metrics/v1"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ExportMetricsServiceRequest struct {
+	ResourceMetrics      []*v1.ResourceMetrics `protobuf:"bytes,1,rep,name=resource_metrics,json=resourceMetrics,proto3" json:"resource_metrics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ExportMetricsServiceRequest) Reset()         { *m = ExportMetricsServiceRequest{} }
+func (m *ExportMetricsServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportMetricsServiceRequest) ProtoMessage()    {}
+func (*ExportMetricsServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fb6015e6e64798, []int{0}
+}
+
+func (m *ExportMetricsServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMetricsServiceRequest.Unmarshal(m, b)
+}
+func (m *ExportMetricsServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMetricsServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportMetricsServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMetricsServiceRequest.Merge(m, src)
+}
+func (m *ExportMetricsServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportMetricsServiceRequest.Size(m)
+}
+func (m *ExportMetricsServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMetricsServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMetricsServiceRequest proto.InternalMessageInfo
+
+func (m *ExportMetricsServiceRequest) GetResourceMetrics() []*v1.ResourceMetrics {
+	if m != nil {
+		return m.ResourceMetrics
+	}
+	return nil
+}
+
+type ExportMetricsServiceResponse struct {
+	PartialSuccess       *ExportMetricsPartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ExportMetricsServiceResponse) Reset()         { *m = ExportMetricsServiceResponse{} }
+func (m *ExportMetricsServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportMetricsServiceResponse) ProtoMessage()    {}
+func (*ExportMetricsServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fb6015e6e64798, []int{1}
+}
+
+func (m *ExportMetricsServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMetricsServiceResponse.Unmarshal(m, b)
+}
+func (m *ExportMetricsServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMetricsServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportMetricsServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMetricsServiceResponse.Merge(m, src)
+}
+func (m *ExportMetricsServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportMetricsServiceResponse.Size(m)
+}
+func (m *ExportMetricsServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMetricsServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMetricsServiceResponse proto.InternalMessageInfo
+
+func (m *ExportMetricsServiceResponse) GetPartialSuccess() *ExportMetricsPartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return nil
+}
+
+type ExportMetricsPartialSuccess struct {
+	RejectedDataPoints   int64    `protobuf:"varint,1,opt,name=rejected_data_points,json=rejectedDataPoints,proto3" json:"rejected_data_points,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportMetricsPartialSuccess) Reset()         { *m = ExportMetricsPartialSuccess{} }
+func (m *ExportMetricsPartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportMetricsPartialSuccess) ProtoMessage()    {}
+func (*ExportMetricsPartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fb6015e6e64798, []int{2}
+}
+
+func (m *ExportMetricsPartialSuccess) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMetricsPartialSuccess.Unmarshal(m, b)
+}
+func (m *ExportMetricsPartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMetricsPartialSuccess.Marshal(b, m, deterministic)
+}
+func (m *ExportMetricsPartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMetricsPartialSuccess.Merge(m, src)
+}
+func (m *ExportMetricsPartialSuccess) XXX_Size() int {
+	return xxx_messageInfo_ExportMetricsPartialSuccess.Size(m)
+}
+func (m *ExportMetricsPartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMetricsPartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMetricsPartialSuccess proto.InternalMessageInfo
+
+func (m *ExportMetricsPartialSuccess) GetRejectedDataPoints() int64 {
+	if m != nil {
+		return m.RejectedDataPoints
+	}
+	return 0
+}
+
+func (m *ExportMetricsPartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExportMetricsServiceRequest)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceRequest")
+	proto.RegisterType((*ExportMetricsServiceResponse)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceResponse")
+	proto.RegisterType((*ExportMetricsPartialSuccess)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsPartialSuccess")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/collector/metrics/v1/metrics_service.proto", fileDescriptor_75fb6015e6e64798)
+}
+
+var fileDescriptor_75fb6015e6e64798 = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x4f, 0xf2, 0x30,
+	0x1c, 0xc6, 0xdf, 0x42, 0x42, 0xf2, 0x96, 0xf7, 0x85, 0x37, 0x7d, 0x3d, 0x10, 0xf0, 0x40, 0xe6,
+	0x65, 0x89, 0xa6, 0x13, 0x3c, 0x9a, 0x18, 0x83, 0xe2, 0x8d, 0xb8, 0x0c, 0xe3, 0x81, 0xcb, 0x52,
+	0xca, 0x3f, 0x38, 0x1d, 0x6b, 0x6d, 0x3b, 0x22, 0x5f, 0xc2, 0xbb, 0x5f, 0xc1, 0x78, 0xf1, 0x1b,
+	0x1a, 0xd6, 0x81, 0x59, 0x24, 0x84, 0xe8, 0x65, 0xe9, 0x9e, 0xfe, 0x9f, 0xdf, 0xf3, 0xac, 0xcd,
+	0xf0, 0x99, 0x90, 0x90, 0x18, 0x88, 0x61, 0x06, 0x46, 0x2d, 0x3c, 0xa9, 0x84, 0x11, 0x1e, 0x17,
+	0x71, 0x0c, 0xdc, 0x08, 0xe5, 0x2d, 0xd5, 0x88, 0x6b, 0x6f, 0xde, 0x59, 0x2d, 0x43, 0x0d, 0x6a,
+	0x1e, 0x71, 0xa0, 0xd9, 0x28, 0x71, 0x0b, 0x7e, 0x2b, 0xd2, 0xb5, 0x9f, 0xe6, 0x26, 0x3a, 0xef,
+	0x34, 0x8f, 0x36, 0x25, 0x7d, 0xe5, 0x5b, 0x84, 0xb3, 0xc0, 0xad, 0xfe, 0x93, 0x14, 0xca, 0x0c,
+	0xac, 0x3c, 0xb4, 0xa9, 0x01, 0x3c, 0xa6, 0xa0, 0x0d, 0x19, 0xe1, 0x7f, 0x0a, 0xb4, 0x48, 0x15,
+	0x87, 0x30, 0x37, 0x36, 0x50, 0xbb, 0xec, 0x56, 0xbb, 0x1e, 0xdd, 0xd4, 0xe8, 0xb3, 0x07, 0x0d,
+	0x72, 0x5f, 0x0e, 0x0e, 0xea, 0xaa, 0x28, 0x38, 0xcf, 0x08, 0xef, 0x6f, 0xce, 0xd6, 0x52, 0x24,
+	0x1a, 0x48, 0x82, 0xeb, 0x92, 0x29, 0x13, 0xb1, 0x38, 0xd4, 0x29, 0xe7, 0xa0, 0x97, 0xd9, 0xc8,
+	0xad, 0x76, 0xfb, 0x74, 0xd7, 0xd3, 0xa0, 0x85, 0x00, 0xdf, 0xd2, 0x86, 0x16, 0x16, 0xd4, 0x64,
+	0xe1, 0xdd, 0x31, 0xb8, 0xb5, 0x65, 0x9c, 0x1c, 0xe3, 0x3d, 0x05, 0xf7, 0xc0, 0x0d, 0x4c, 0xc2,
+	0x09, 0x33, 0x2c, 0x94, 0x22, 0x4a, 0x8c, 0xed, 0x54, 0x0e, 0xc8, 0x6a, 0xef, 0x92, 0x19, 0xe6,
+	0x67, 0x3b, 0xe4, 0x00, 0xff, 0x05, 0xa5, 0x84, 0x0a, 0x67, 0xa0, 0x35, 0x9b, 0x42, 0xa3, 0xd4,
+	0x46, 0xee, 0xef, 0xe0, 0x4f, 0x26, 0x0e, 0xac, 0xd6, 0x7d, 0x43, 0xb8, 0x56, 0x3c, 0x00, 0xf2,
+	0x82, 0x70, 0xc5, 0x36, 0x21, 0xdf, 0xfd, 0xd4, 0xe2, 0x3d, 0x36, 0xaf, 0x7e, 0x8a, 0xb1, 0x57,
+	0xe2, 0xfc, 0xea, 0xbd, 0x23, 0x7c, 0x18, 0x89, 0x9d, 0x71, 0xbd, 0xff, 0x45, 0x92, 0xbf, 0x9c,
+	0xf4, 0xd1, 0xe8, 0x7c, 0x1a, 0x99, 0xbb, 0x74, 0x4c, 0xb9, 0x98, 0x79, 0x13, 0x26, 0x95, 0x7d,
+	0xc8, 0x87, 0xa9, 0x27, 0x4c, 0x2c, 0xb7, 0xfc, 0x1f, 0xa7, 0xf9, 0xf2, 0xb5, 0xe4, 0x5e, 0x4b,
+	0x48, 0x6e, 0xd6, 0x1d, 0x32, 0x32, 0xbd, 0x58, 0x77, 0xc8, 0x73, 0xe9, 0x6d, 0x67, 0x5c, 0xc9,
+	0x70, 0x27, 0x1f, 0x03, 0x00, 0x05, 0x26, 0x7e, 0x17, 0x85, 0x03, 0x00, 0x00,
+}
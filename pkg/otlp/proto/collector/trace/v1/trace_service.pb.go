@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/collector/trace/v1/trace_service.proto
+
+package trace
+
+import (
+	fmt "fmt"
+	v1 "github.com/dapr/dapr/pkg/otlp/proto/trace/v1"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ExportTraceServiceRequest struct {
+	ResourceSpans        []*v1.ResourceSpans `protobuf:"bytes,1,rep,name=resource_spans,json=resourceSpans,proto3" json:"resource_spans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ExportTraceServiceRequest) Reset()         { *m = ExportTraceServiceRequest{} }
+func (m *ExportTraceServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTraceServiceRequest) ProtoMessage()    {}
+func (*ExportTraceServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{0}
+}
+
+func (m *ExportTraceServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTraceServiceRequest.Unmarshal(m, b)
+}
+func (m *ExportTraceServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTraceServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportTraceServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTraceServiceRequest.Merge(m, src)
+}
+func (m *ExportTraceServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTraceServiceRequest.Size(m)
+}
+func (m *ExportTraceServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTraceServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTraceServiceRequest proto.InternalMessageInfo
+
+func (m *ExportTraceServiceRequest) GetResourceSpans() []*v1.ResourceSpans {
+	if m != nil {
+		return m.ResourceSpans
+	}
+	return nil
+}
+
+type ExportTraceServiceResponse struct {
+	PartialSuccess       *ExportTracePartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ExportTraceServiceResponse) Reset()         { *m = ExportTraceServiceResponse{} }
+func (m *ExportTraceServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTraceServiceResponse) ProtoMessage()    {}
+func (*ExportTraceServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{1}
+}
+
+func (m *ExportTraceServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTraceServiceResponse.Unmarshal(m, b)
+}
+func (m *ExportTraceServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTraceServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportTraceServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTraceServiceResponse.Merge(m, src)
+}
+func (m *ExportTraceServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTraceServiceResponse.Size(m)
+}
+func (m *ExportTraceServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTraceServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTraceServiceResponse proto.InternalMessageInfo
+
+func (m *ExportTraceServiceResponse) GetPartialSuccess() *ExportTracePartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return nil
+}
+
+type ExportTracePartialSuccess struct {
+	RejectedSpans        int64    `protobuf:"varint,1,opt,name=rejected_spans,json=rejectedSpans,proto3" json:"rejected_spans,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTracePartialSuccess) Reset()         { *m = ExportTracePartialSuccess{} }
+func (m *ExportTracePartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportTracePartialSuccess) ProtoMessage()    {}
+func (*ExportTracePartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{2}
+}
+
+func (m *ExportTracePartialSuccess) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTracePartialSuccess.Unmarshal(m, b)
+}
+func (m *ExportTracePartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTracePartialSuccess.Marshal(b, m, deterministic)
+}
+func (m *ExportTracePartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTracePartialSuccess.Merge(m, src)
+}
+func (m *ExportTracePartialSuccess) XXX_Size() int {
+	return xxx_messageInfo_ExportTracePartialSuccess.Size(m)
+}
+func (m *ExportTracePartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTracePartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTracePartialSuccess proto.InternalMessageInfo
+
+func (m *ExportTracePartialSuccess) GetRejectedSpans() int64 {
+	if m != nil {
+		return m.RejectedSpans
+	}
+	return 0
+}
+
+func (m *ExportTracePartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExportTraceServiceRequest)(nil), "opentelemetry.proto.collector.trace.v1.ExportTraceServiceRequest")
+	proto.RegisterType((*ExportTraceServiceResponse)(nil), "opentelemetry.proto.collector.trace.v1.ExportTraceServiceResponse")
+	proto.RegisterType((*ExportTracePartialSuccess)(nil), "opentelemetry.proto.collector.trace.v1.ExportTracePartialSuccess")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/collector/trace/v1/trace_service.proto", fileDescriptor_192a962890318cf4)
+}
+
+var fileDescriptor_192a962890318cf4 = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0xbf, 0x69, 0xa1, 0xf0, 0x4d, 0xff, 0x88, 0x59, 0xb5, 0x5d, 0x95, 0x88, 0x25, 0x22,
+	0x4c, 0x68, 0xdd, 0xa9, 0x1b, 0x2b, 0x2e, 0xc5, 0x32, 0x2d, 0x2e, 0xdc, 0x94, 0x74, 0x7a, 0x89,
+	0xa9, 0x69, 0x66, 0x9c, 0x99, 0x14, 0x7d, 0x03, 0xb7, 0xbe, 0x82, 0x1b, 0xc1, 0xa7, 0x94, 0xcc,
+	0xd8, 0x90, 0x40, 0x0b, 0x45, 0x37, 0x61, 0xe6, 0x70, 0xcf, 0xf9, 0xdd, 0x93, 0x04, 0x9f, 0x73,
+	0x01, 0x89, 0x86, 0x18, 0x56, 0xa0, 0xe5, 0xab, 0x2f, 0x24, 0xd7, 0xdc, 0x67, 0x3c, 0x8e, 0x81,
+	0x69, 0x2e, 0x7d, 0x2d, 0x03, 0x06, 0xfe, 0x7a, 0x60, 0x0f, 0x33, 0x05, 0x72, 0x1d, 0x31, 0x20,
+	0x66, 0xcc, 0xe9, 0x97, 0xbc, 0x56, 0x24, 0xb9, 0x97, 0x18, 0x0b, 0x59, 0x0f, 0xba, 0xde, 0x36,
+	0x46, 0x39, 0xd9, 0x9a, 0x5d, 0x8e, 0x3b, 0x37, 0x2f, 0x82, 0x4b, 0x3d, 0xcd, 0xc4, 0x89, 0xa5,
+	0x51, 0x78, 0x4e, 0x41, 0x69, 0x87, 0xe2, 0x96, 0x04, 0xc5, 0x53, 0x99, 0x2d, 0x22, 0x82, 0x44,
+	0xb5, 0x51, 0xaf, 0xea, 0xd5, 0x87, 0xa7, 0x64, 0xdb, 0x1e, 0x1b, 0x3a, 0xa1, 0x3f, 0x9e, 0x49,
+	0x66, 0xa1, 0x4d, 0x59, 0xbc, 0xba, 0x6f, 0x08, 0x77, 0xb7, 0x11, 0x95, 0xe0, 0x89, 0x02, 0x67,
+	0x89, 0x0f, 0x44, 0x20, 0x75, 0x14, 0xc4, 0x33, 0x95, 0x32, 0x06, 0x2a, 0x63, 0x22, 0xaf, 0x3e,
+	0xbc, 0x22, 0xfb, 0x75, 0x27, 0x85, 0xf0, 0xb1, 0x4d, 0x9a, 0xd8, 0x20, 0xda, 0x12, 0xa5, 0xbb,
+	0x1b, 0xe2, 0xce, 0xce, 0x61, 0xe7, 0x38, 0xeb, 0xbe, 0x04, 0xa6, 0x61, 0x91, 0x77, 0x47, 0x5e,
+	0x95, 0x36, 0x37, 0xaa, 0xa9, 0xe3, 0x1c, 0xe1, 0x26, 0x48, 0xc9, 0xe5, 0x6c, 0x05, 0x4a, 0x05,
+	0x21, 0xb4, 0x2b, 0x3d, 0xe4, 0xfd, 0xa7, 0x0d, 0x23, 0xde, 0x5a, 0x6d, 0xf8, 0x81, 0x70, 0xa3,
+	0xd8, 0xd6, 0x79, 0x47, 0xb8, 0x66, 0xd1, 0xce, 0x6f, 0x7a, 0x95, 0x3f, 0x53, 0x77, 0xf4, 0x97,
+	0x08, 0xfb, 0xde, 0xdd, 0x7f, 0xa3, 0x4f, 0x84, 0x4f, 0x22, 0xbe, 0x67, 0xd4, 0xe8, 0xb0, 0x98,
+	0x32, 0xce, 0xa6, 0xc6, 0xe8, 0xe1, 0x32, 0x8c, 0xf4, 0x63, 0x3a, 0x27, 0x8c, 0xaf, 0xfc, 0x45,
+	0x20, 0xa4, 0x7d, 0x88, 0xa7, 0xd0, 0xe7, 0x3a, 0x16, 0x3b, 0x7f, 0xf5, 0x0b, 0x73, 0xf8, 0xaa,
+	0xf4, 0xef, 0x04, 0x24, 0xd3, 0x9c, 0x6d, 0x52, 0xc9, 0x75, 0xce, 0x36, 0x44, 0x72, 0x3f, 0x98,
+	0xd7, 0x4c, 0xd0, 0xd9, 0xf7, 0x00, 0x7f, 0x08, 0x49, 0x3a, 0x4a, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/common/v1/common.proto
+
+package common
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type AnyValue struct {
+	// Types that are valid to be assigned to Value:
+	//	*AnyValue_StringValue
+	//	*AnyValue_BoolValue
+	//	*AnyValue_IntValue
+	//	*AnyValue_DoubleValue
+	//	*AnyValue_ArrayValue
+	//	*AnyValue_KvlistValue
+	//	*AnyValue_BytesValue
+	Value                isAnyValue_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AnyValue) Reset()         { *m = AnyValue{} }
+func (m *AnyValue) String() string { return proto.CompactTextString(m) }
+func (*AnyValue) ProtoMessage()    {}
+func (*AnyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62ba46dcb97aa817, []int{0}
+}
+
+func (m *AnyValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnyValue.Unmarshal(m, b)
+}
+func (m *AnyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnyValue.Marshal(b, m, deterministic)
+}
+func (m *AnyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnyValue.Merge(m, src)
+}
+func (m *AnyValue) XXX_Size() int {
+	return xxx_messageInfo_AnyValue.Size(m)
+}
+func (m *AnyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnyValue proto.InternalMessageInfo
+
+type isAnyValue_Value interface {
+	isAnyValue_Value()
+}
+
+type AnyValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type AnyValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type AnyValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type AnyValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type AnyValue_ArrayValue struct {
+	ArrayValue *ArrayValue `protobuf:"bytes,5,opt,name=array_value,json=arrayValue,proto3,oneof"`
+}
+
+type AnyValue_KvlistValue struct {
+	KvlistValue *KeyValueList `protobuf:"bytes,6,opt,name=kvlist_value,json=kvlistValue,proto3,oneof"`
+}
+
+type AnyValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,7,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+func (*AnyValue_StringValue) isAnyValue_Value() {}
+
+func (*AnyValue_BoolValue) isAnyValue_Value() {}
+
+func (*AnyValue_IntValue) isAnyValue_Value() {}
+
+func (*AnyValue_DoubleValue) isAnyValue_Value() {}
+
+func (*AnyValue_ArrayValue) isAnyValue_Value() {}
+
+func (*AnyValue_KvlistValue) isAnyValue_Value() {}
+
+func (*AnyValue_BytesValue) isAnyValue_Value() {}
+
+func (m *AnyValue) GetValue() isAnyValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *AnyValue) GetStringValue() string {
+	if x, ok := m.GetValue().(*AnyValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (m *AnyValue) GetBoolValue() bool {
+	if x, ok := m.GetValue().(*AnyValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (m *AnyValue) GetIntValue() int64 {
+	if x, ok := m.GetValue().(*AnyValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (m *AnyValue) GetDoubleValue() float64 {
+	if x, ok := m.GetValue().(*AnyValue_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (m *AnyValue) GetArrayValue() *ArrayValue {
+	if x, ok := m.GetValue().(*AnyValue_ArrayValue); ok {
+		return x.ArrayValue
+	}
+	return nil
+}
+
+func (m *AnyValue) GetKvlistValue() *KeyValueList {
+	if x, ok := m.GetValue().(*AnyValue_KvlistValue); ok {
+		return x.KvlistValue
+	}
+	return nil
+}
+
+func (m *AnyValue) GetBytesValue() []byte {
+	if x, ok := m.GetValue().(*AnyValue_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AnyValue) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AnyValue_StringValue)(nil),
+		(*AnyValue_BoolValue)(nil),
+		(*AnyValue_IntValue)(nil),
+		(*AnyValue_DoubleValue)(nil),
+		(*AnyValue_ArrayValue)(nil),
+		(*AnyValue_KvlistValue)(nil),
+		(*AnyValue_BytesValue)(nil),
+	}
+}
+
+type ArrayValue struct {
+	Values               []*AnyValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ArrayValue) Reset()         { *m = ArrayValue{} }
+func (m *ArrayValue) String() string { return proto.CompactTextString(m) }
+func (*ArrayValue) ProtoMessage()    {}
+func (*ArrayValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62ba46dcb97aa817, []int{1}
+}
+
+func (m *ArrayValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayValue.Unmarshal(m, b)
+}
+func (m *ArrayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayValue.Marshal(b, m, deterministic)
+}
+func (m *ArrayValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayValue.Merge(m, src)
+}
+func (m *ArrayValue) XXX_Size() int {
+	return xxx_messageInfo_ArrayValue.Size(m)
+}
+func (m *ArrayValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayValue proto.InternalMessageInfo
+
+func (m *ArrayValue) GetValues() []*AnyValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type KeyValueList struct {
+	Values               []*KeyValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *KeyValueList) Reset()         { *m = KeyValueList{} }
+func (m *KeyValueList) String() string { return proto.CompactTextString(m) }
+func (*KeyValueList) ProtoMessage()    {}
+func (*KeyValueList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62ba46dcb97aa817, []int{2}
+}
+
+func (m *KeyValueList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyValueList.Unmarshal(m, b)
+}
+func (m *KeyValueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyValueList.Marshal(b, m, deterministic)
+}
+func (m *KeyValueList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValueList.Merge(m, src)
+}
+func (m *KeyValueList) XXX_Size() int {
+	return xxx_messageInfo_KeyValueList.Size(m)
+}
+func (m *KeyValueList) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValueList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValueList proto.InternalMessageInfo
+
+func (m *KeyValueList) GetValues() []*KeyValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type KeyValue struct {
+	Key                  string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                *AnyValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *KeyValue) Reset()         { *m = KeyValue{} }
+func (m *KeyValue) String() string { return proto.CompactTextString(m) }
+func (*KeyValue) ProtoMessage()    {}
+func (*KeyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62ba46dcb97aa817, []int{3}
+}
+
+func (m *KeyValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyValue.Unmarshal(m, b)
+}
+func (m *KeyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyValue.Marshal(b, m, deterministic)
+}
+func (m *KeyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValue.Merge(m, src)
+}
+func (m *KeyValue) XXX_Size() int {
+	return xxx_messageInfo_KeyValue.Size(m)
+}
+func (m *KeyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValue proto.InternalMessageInfo
+
+func (m *KeyValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *KeyValue) GetValue() *AnyValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type InstrumentationScope struct {
+	Name                   string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version                string      `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes             []*KeyValue `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32      `protobuf:"varint,4,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}    `json:"-"`
+	XXX_unrecognized       []byte      `json:"-"`
+	XXX_sizecache          int32       `json:"-"`
+}
+
+func (m *InstrumentationScope) Reset()         { *m = InstrumentationScope{} }
+func (m *InstrumentationScope) String() string { return proto.CompactTextString(m) }
+func (*InstrumentationScope) ProtoMessage()    {}
+func (*InstrumentationScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62ba46dcb97aa817, []int{4}
+}
+
+func (m *InstrumentationScope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstrumentationScope.Unmarshal(m, b)
+}
+func (m *InstrumentationScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstrumentationScope.Marshal(b, m, deterministic)
+}
+func (m *InstrumentationScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstrumentationScope.Merge(m, src)
+}
+func (m *InstrumentationScope) XXX_Size() int {
+	return xxx_messageInfo_InstrumentationScope.Size(m)
+}
+func (m *InstrumentationScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstrumentationScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstrumentationScope proto.InternalMessageInfo
+
+func (m *InstrumentationScope) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InstrumentationScope) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *InstrumentationScope) GetAttributes() []*KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *InstrumentationScope) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AnyValue)(nil), "opentelemetry.proto.common.v1.AnyValue")
+	proto.RegisterType((*ArrayValue)(nil), "opentelemetry.proto.common.v1.ArrayValue")
+	proto.RegisterType((*KeyValueList)(nil), "opentelemetry.proto.common.v1.KeyValueList")
+	proto.RegisterType((*KeyValue)(nil), "opentelemetry.proto.common.v1.KeyValue")
+	proto.RegisterType((*InstrumentationScope)(nil), "opentelemetry.proto.common.v1.InstrumentationScope")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/common/v1/common.proto", fileDescriptor_62ba46dcb97aa817)
+}
+
+var fileDescriptor_62ba46dcb97aa817 = []byte{
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0xee, 0x34, 0xdb, 0xbf, 0x93, 0x0a, 0x32, 0x88, 0xe4, 0xa6, 0x18, 0xeb, 0x85, 0x51, 0x21,
+	0xa1, 0xab, 0x17, 0x82, 0x88, 0xb4, 0x7b, 0x61, 0x65, 0x57, 0xb6, 0x44, 0xd9, 0x0b, 0xbd, 0x28,
+	0x49, 0x3b, 0xd4, 0xa1, 0xc9, 0x4c, 0x98, 0x4c, 0x02, 0x79, 0x02, 0xdf, 0xc5, 0x17, 0xf1, 0x35,
+	0x7c, 0x14, 0x99, 0x9f, 0xb6, 0xbb, 0x7b, 0xb1, 0xa5, 0x37, 0xe1, 0xcc, 0x77, 0xbe, 0x9f, 0x73,
+	0x98, 0x09, 0xbc, 0xe6, 0x05, 0x61, 0x92, 0x64, 0x24, 0x27, 0x52, 0x34, 0x51, 0x21, 0xb8, 0xe4,
+	0xd1, 0x8a, 0xe7, 0x39, 0x67, 0x51, 0x3d, 0xb1, 0x55, 0xa8, 0x61, 0x3c, 0xba, 0xc3, 0x35, 0x60,
+	0x68, 0x19, 0xf5, 0x64, 0xfc, 0xaf, 0x0d, 0xfd, 0x29, 0x6b, 0x6e, 0x92, 0xac, 0x22, 0xf8, 0x05,
+	0x0c, 0x4b, 0x29, 0x28, 0xdb, 0x2c, 0x6b, 0x75, 0xf6, 0x90, 0x8f, 0x82, 0xc1, 0xbc, 0x15, 0xbb,
+	0x06, 0x35, 0xa4, 0x67, 0x00, 0x29, 0xe7, 0x99, 0xa5, 0xb4, 0x7d, 0x14, 0xf4, 0xe7, 0xad, 0x78,
+	0xa0, 0x30, 0x43, 0x18, 0xc1, 0x80, 0x32, 0x69, 0xfb, 0x8e, 0x8f, 0x02, 0x67, 0xde, 0x8a, 0xfb,
+	0x94, 0xc9, 0x7d, 0xc8, 0x9a, 0x57, 0x69, 0x46, 0x2c, 0xe3, 0xcc, 0x47, 0x01, 0x52, 0x21, 0x06,
+	0x35, 0xa4, 0x2b, 0x70, 0x13, 0x21, 0x92, 0xc6, 0x72, 0x3a, 0x3e, 0x0a, 0xdc, 0xf3, 0x57, 0xe1,
+	0x83, 0xbb, 0x84, 0x53, 0xa5, 0xd0, 0xfa, 0x79, 0x2b, 0x86, 0x64, 0x7f, 0xc2, 0x0b, 0x18, 0x6e,
+	0xeb, 0x8c, 0x96, 0xbb, 0xa1, 0xba, 0xda, 0xee, 0xcd, 0x11, 0xbb, 0x4b, 0x62, 0xe4, 0x57, 0xb4,
+	0x94, 0x6a, 0x3e, 0x63, 0x61, 0x1c, 0x9f, 0x83, 0x9b, 0x36, 0x92, 0x94, 0xd6, 0xb0, 0xe7, 0xa3,
+	0x60, 0xa8, 0x42, 0x35, 0xa8, 0x29, 0xb3, 0x1e, 0x74, 0x74, 0x73, 0xfc, 0x15, 0xe0, 0x30, 0x19,
+	0xfe, 0x04, 0x5d, 0x0d, 0x97, 0x1e, 0xf2, 0x9d, 0xc0, 0x3d, 0x7f, 0x79, 0x6c, 0x29, 0x7b, 0x39,
+	0xb1, 0x95, 0x8d, 0xaf, 0x61, 0x78, 0x7b, 0xb2, 0x93, 0x0d, 0x2f, 0xc9, 0x3d, 0xc3, 0x9f, 0xd0,
+	0xdf, 0x61, 0xf8, 0x31, 0x38, 0x5b, 0xd2, 0x98, 0x8b, 0x8f, 0x55, 0x89, 0x3f, 0x42, 0xe7, 0x70,
+	0xd3, 0x27, 0x8c, 0x6b, 0x97, 0xff, 0x8b, 0xe0, 0xc9, 0x17, 0x56, 0x4a, 0x51, 0xe5, 0x84, 0xc9,
+	0x44, 0x52, 0xce, 0xbe, 0xad, 0x78, 0x41, 0x30, 0x86, 0x33, 0x96, 0xe4, 0xf6, 0x8d, 0xc5, 0xba,
+	0xc6, 0x1e, 0xf4, 0x6a, 0x22, 0x4a, 0xca, 0x99, 0x4e, 0x1b, 0xc4, 0xbb, 0x23, 0xfe, 0x0c, 0x90,
+	0x48, 0x29, 0x68, 0x5a, 0x49, 0x52, 0x7a, 0xce, 0x69, 0x8b, 0xde, 0x92, 0xe2, 0xf7, 0xe0, 0xad,
+	0x05, 0x2f, 0x0a, 0xb2, 0x5e, 0x1e, 0xd0, 0xe5, 0x8a, 0x57, 0x4c, 0xea, 0x97, 0xf8, 0x28, 0x7e,
+	0x6a, 0xfb, 0xd3, 0x7d, 0xfb, 0x42, 0x75, 0x67, 0xbf, 0x11, 0xf8, 0x94, 0x3f, 0x9c, 0x39, 0x73,
+	0x2f, 0x74, 0xb9, 0x50, 0xf0, 0x02, 0xfd, 0x78, 0xb7, 0xa1, 0xf2, 0x57, 0x95, 0x2a, 0x42, 0xb4,
+	0x4e, 0x0a, 0x61, 0x3e, 0xc5, 0x76, 0x13, 0x71, 0x99, 0x15, 0xf7, 0x7f, 0xdc, 0x0f, 0xa6, 0xfa,
+	0xd3, 0x1e, 0x5d, 0x17, 0x84, 0x7d, 0xdf, 0x87, 0x68, 0xb7, 0xd0, 0x38, 0x87, 0x37, 0x93, 0xb4,
+	0xab, 0x75, 0x6f, 0xff, 0x0f, 0x00, 0xad, 0xde, 0x53, 0x57, 0x07, 0x04, 0x00, 0x00,
+}
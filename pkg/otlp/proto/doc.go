@@ -0,0 +1,9 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+// Package proto holds the subpackages with the Go code generated by protoc-gen-go v1.3.3 from the
+// OpenTelemetry protocol definitions of github.com/open-telemetry/opentelemetry-proto v1.0.0,
+// with their go_package options set to the subpackages.
+package proto
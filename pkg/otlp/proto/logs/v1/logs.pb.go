@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/logs/v1/logs.proto
+
+package logs
+
+import (
+	fmt "fmt"
+	v11 "github.com/dapr/dapr/pkg/otlp/proto/common/v1"
+	v1 "github.com/dapr/dapr/pkg/otlp/proto/resource/v1"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SeverityNumber int32
+
+const (
+	SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED SeverityNumber = 0
+	SeverityNumber_SEVERITY_NUMBER_TRACE       SeverityNumber = 1
+	SeverityNumber_SEVERITY_NUMBER_TRACE2      SeverityNumber = 2
+	SeverityNumber_SEVERITY_NUMBER_TRACE3      SeverityNumber = 3
+	SeverityNumber_SEVERITY_NUMBER_TRACE4      SeverityNumber = 4
+	SeverityNumber_SEVERITY_NUMBER_DEBUG       SeverityNumber = 5
+	SeverityNumber_SEVERITY_NUMBER_DEBUG2      SeverityNumber = 6
+	SeverityNumber_SEVERITY_NUMBER_DEBUG3      SeverityNumber = 7
+	SeverityNumber_SEVERITY_NUMBER_DEBUG4      SeverityNumber = 8
+	SeverityNumber_SEVERITY_NUMBER_INFO        SeverityNumber = 9
+	SeverityNumber_SEVERITY_NUMBER_INFO2       SeverityNumber = 10
+	SeverityNumber_SEVERITY_NUMBER_INFO3       SeverityNumber = 11
+	SeverityNumber_SEVERITY_NUMBER_INFO4       SeverityNumber = 12
+	SeverityNumber_SEVERITY_NUMBER_WARN        SeverityNumber = 13
+	SeverityNumber_SEVERITY_NUMBER_WARN2       SeverityNumber = 14
+	SeverityNumber_SEVERITY_NUMBER_WARN3       SeverityNumber = 15
+	SeverityNumber_SEVERITY_NUMBER_WARN4       SeverityNumber = 16
+	SeverityNumber_SEVERITY_NUMBER_ERROR       SeverityNumber = 17
+	SeverityNumber_SEVERITY_NUMBER_ERROR2      SeverityNumber = 18
+	SeverityNumber_SEVERITY_NUMBER_ERROR3      SeverityNumber = 19
+	SeverityNumber_SEVERITY_NUMBER_ERROR4      SeverityNumber = 20
+	SeverityNumber_SEVERITY_NUMBER_FATAL       SeverityNumber = 21
+	SeverityNumber_SEVERITY_NUMBER_FATAL2      SeverityNumber = 22
+	SeverityNumber_SEVERITY_NUMBER_FATAL3      SeverityNumber = 23
+	SeverityNumber_SEVERITY_NUMBER_FATAL4      SeverityNumber = 24
+)
+
+var SeverityNumber_name = map[int32]string{
+	0:  "SEVERITY_NUMBER_UNSPECIFIED",
+	1:  "SEVERITY_NUMBER_TRACE",
+	2:  "SEVERITY_NUMBER_TRACE2",
+	3:  "SEVERITY_NUMBER_TRACE3",
+	4:  "SEVERITY_NUMBER_TRACE4",
+	5:  "SEVERITY_NUMBER_DEBUG",
+	6:  "SEVERITY_NUMBER_DEBUG2",
+	7:  "SEVERITY_NUMBER_DEBUG3",
+	8:  "SEVERITY_NUMBER_DEBUG4",
+	9:  "SEVERITY_NUMBER_INFO",
+	10: "SEVERITY_NUMBER_INFO2",
+	11: "SEVERITY_NUMBER_INFO3",
+	12: "SEVERITY_NUMBER_INFO4",
+	13: "SEVERITY_NUMBER_WARN",
+	14: "SEVERITY_NUMBER_WARN2",
+	15: "SEVERITY_NUMBER_WARN3",
+	16: "SEVERITY_NUMBER_WARN4",
+	17: "SEVERITY_NUMBER_ERROR",
+	18: "SEVERITY_NUMBER_ERROR2",
+	19: "SEVERITY_NUMBER_ERROR3",
+	20: "SEVERITY_NUMBER_ERROR4",
+	21: "SEVERITY_NUMBER_FATAL",
+	22: "SEVERITY_NUMBER_FATAL2",
+	23: "SEVERITY_NUMBER_FATAL3",
+	24: "SEVERITY_NUMBER_FATAL4",
+}
+
+var SeverityNumber_value = map[string]int32{
+	"SEVERITY_NUMBER_UNSPECIFIED": 0,
+	"SEVERITY_NUMBER_TRACE":       1,
+	"SEVERITY_NUMBER_TRACE2":      2,
+	"SEVERITY_NUMBER_TRACE3":      3,
+	"SEVERITY_NUMBER_TRACE4":      4,
+	"SEVERITY_NUMBER_DEBUG":       5,
+	"SEVERITY_NUMBER_DEBUG2":      6,
+	"SEVERITY_NUMBER_DEBUG3":      7,
+	"SEVERITY_NUMBER_DEBUG4":      8,
+	"SEVERITY_NUMBER_INFO":        9,
+	"SEVERITY_NUMBER_INFO2":       10,
+	"SEVERITY_NUMBER_INFO3":       11,
+	"SEVERITY_NUMBER_INFO4":       12,
+	"SEVERITY_NUMBER_WARN":        13,
+	"SEVERITY_NUMBER_WARN2":       14,
+	"SEVERITY_NUMBER_WARN3":       15,
+	"SEVERITY_NUMBER_WARN4":       16,
+	"SEVERITY_NUMBER_ERROR":       17,
+	"SEVERITY_NUMBER_ERROR2":      18,
+	"SEVERITY_NUMBER_ERROR3":      19,
+	"SEVERITY_NUMBER_ERROR4":      20,
+	"SEVERITY_NUMBER_FATAL":       21,
+	"SEVERITY_NUMBER_FATAL2":      22,
+	"SEVERITY_NUMBER_FATAL3":      23,
+	"SEVERITY_NUMBER_FATAL4":      24,
+}
+
+func (x SeverityNumber) String() string {
+	return proto.EnumName(SeverityNumber_name, int32(x))
+}
+
+func (SeverityNumber) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1c030a3ec7e961e, []int{0}
+}
+
+type LogRecordFlags int32
+
+const (
+	LogRecordFlags_LOG_RECORD_FLAGS_DO_NOT_USE       LogRecordFlags = 0
+	LogRecordFlags_LOG_RECORD_FLAGS_TRACE_FLAGS_MASK LogRecordFlags = 255
+)
+
+var LogRecordFlags_name = map[int32]string{
+	0:   "LOG_RECORD_FLAGS_DO_NOT_USE",
+	255: "LOG_RECORD_FLAGS_TRACE_FLAGS_MASK",
+}
+
+var LogRecordFlags_value = map[string]int32{
+	"LOG_RECORD_FLAGS_DO_NOT_USE":       0,
+	"LOG_RECORD_FLAGS_TRACE_FLAGS_MASK": 255,
+}
+
+func (x LogRecordFlags) String() string {
+	return proto.EnumName(LogRecordFlags_name, int32(x))
+}
+
+func (LogRecordFlags) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1c030a3ec7e961e, []int{1}
+}
+
+type LogsData struct {
+	ResourceLogs         []*ResourceLogs `protobuf:"bytes,1,rep,name=resource_logs,json=resourceLogs,proto3" json:"resource_logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LogsData) Reset()         { *m = LogsData{} }
+func (m *LogsData) String() string { return proto.CompactTextString(m) }
+func (*LogsData) ProtoMessage()    {}
+func (*LogsData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1c030a3ec7e961e, []int{0}
+}
+
+func (m *LogsData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsData.Unmarshal(m, b)
+}
+func (m *LogsData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsData.Marshal(b, m, deterministic)
+}
+func (m *LogsData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsData.Merge(m, src)
+}
+func (m *LogsData) XXX_Size() int {
+	return xxx_messageInfo_LogsData.Size(m)
+}
+func (m *LogsData) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsData proto.InternalMessageInfo
+
+func (m *LogsData) GetResourceLogs() []*ResourceLogs {
+	if m != nil {
+		return m.ResourceLogs
+	}
+	return nil
+}
+
+type ResourceLogs struct {
+	Resource             *v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ScopeLogs            []*ScopeLogs `protobuf:"bytes,2,rep,name=scope_logs,json=scopeLogs,proto3" json:"scope_logs,omitempty"`
+	SchemaUrl            string       `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ResourceLogs) Reset()         { *m = ResourceLogs{} }
+func (m *ResourceLogs) String() string { return proto.CompactTextString(m) }
+func (*ResourceLogs) ProtoMessage()    {}
+func (*ResourceLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1c030a3ec7e961e, []int{1}
+}
+
+func (m *ResourceLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceLogs.Unmarshal(m, b)
+}
+func (m *ResourceLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceLogs.Marshal(b, m, deterministic)
+}
+func (m *ResourceLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceLogs.Merge(m, src)
+}
+func (m *ResourceLogs) XXX_Size() int {
+	return xxx_messageInfo_ResourceLogs.Size(m)
+}
+func (m *ResourceLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceLogs proto.InternalMessageInfo
+
+func (m *ResourceLogs) GetResource() *v1.Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ResourceLogs) GetScopeLogs() []*ScopeLogs {
+	if m != nil {
+		return m.ScopeLogs
+	}
+	return nil
+}
+
+func (m *ResourceLogs) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type ScopeLogs struct {
+	Scope                *v11.InstrumentationScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	LogRecords           []*LogRecord              `protobuf:"bytes,2,rep,name=log_records,json=logRecords,proto3" json:"log_records,omitempty"`
+	SchemaUrl            string                    `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ScopeLogs) Reset()         { *m = ScopeLogs{} }
+func (m *ScopeLogs) String() string { return proto.CompactTextString(m) }
+func (*ScopeLogs) ProtoMessage()    {}
+func (*ScopeLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1c030a3ec7e961e, []int{2}
+}
+
+func (m *ScopeLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScopeLogs.Unmarshal(m, b)
+}
+func (m *ScopeLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScopeLogs.Marshal(b, m, deterministic)
+}
+func (m *ScopeLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLogs.Merge(m, src)
+}
+func (m *ScopeLogs) XXX_Size() int {
+	return xxx_messageInfo_ScopeLogs.Size(m)
+}
+func (m *ScopeLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeLogs proto.InternalMessageInfo
+
+func (m *ScopeLogs) GetScope() *v11.InstrumentationScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *ScopeLogs) GetLogRecords() []*LogRecord {
+	if m != nil {
+		return m.LogRecords
+	}
+	return nil
+}
+
+func (m *ScopeLogs) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type LogRecord struct {
+	TimeUnixNano           uint64          `protobuf:"fixed64,1,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	ObservedTimeUnixNano   uint64          `protobuf:"fixed64,11,opt,name=observed_time_unix_nano,json=observedTimeUnixNano,proto3" json:"observed_time_unix_nano,omitempty"`
+	SeverityNumber         SeverityNumber  `protobuf:"varint,2,opt,name=severity_number,json=severityNumber,proto3,enum=opentelemetry.proto.logs.v1.SeverityNumber" json:"severity_number,omitempty"`
+	SeverityText           string          `protobuf:"bytes,3,opt,name=severity_text,json=severityText,proto3" json:"severity_text,omitempty"`
+	Body                   *v11.AnyValue   `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Attributes             []*v11.KeyValue `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32          `protobuf:"varint,7,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
+	Flags                  uint32          `protobuf:"fixed32,8,opt,name=flags,proto3" json:"flags,omitempty"`
+	TraceId                []byte          `protobuf:"bytes,9,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId                 []byte          `protobuf:"bytes,10,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *LogRecord) Reset()         { *m = LogRecord{} }
+func (m *LogRecord) String() string { return proto.CompactTextString(m) }
+func (*LogRecord) ProtoMessage()    {}
+func (*LogRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1c030a3ec7e961e, []int{3}
+}
+
+func (m *LogRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogRecord.Unmarshal(m, b)
+}
+func (m *LogRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogRecord.Marshal(b, m, deterministic)
+}
+func (m *LogRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRecord.Merge(m, src)
+}
+func (m *LogRecord) XXX_Size() int {
+	return xxx_messageInfo_LogRecord.Size(m)
+}
+func (m *LogRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRecord proto.InternalMessageInfo
+
+func (m *LogRecord) GetTimeUnixNano() uint64 {
+	if m != nil {
+		return m.TimeUnixNano
+	}
+	return 0
+}
+
+func (m *LogRecord) GetObservedTimeUnixNano() uint64 {
+	if m != nil {
+		return m.ObservedTimeUnixNano
+	}
+	return 0
+}
+
+func (m *LogRecord) GetSeverityNumber() SeverityNumber {
+	if m != nil {
+		return m.SeverityNumber
+	}
+	return SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+}
+
+func (m *LogRecord) GetSeverityText() string {
+	if m != nil {
+		return m.SeverityText
+	}
+	return ""
+}
+
+func (m *LogRecord) GetBody() *v11.AnyValue {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *LogRecord) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *LogRecord) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+func (m *LogRecord) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *LogRecord) GetTraceId() []byte {
+	if m != nil {
+		return m.TraceId
+	}
+	return nil
+}
+
+func (m *LogRecord) GetSpanId() []byte {
+	if m != nil {
+		return m.SpanId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("opentelemetry.proto.logs.v1.SeverityNumber", SeverityNumber_name, SeverityNumber_value)
+	proto.RegisterEnum("opentelemetry.proto.logs.v1.LogRecordFlags", LogRecordFlags_name, LogRecordFlags_value)
+	proto.RegisterType((*LogsData)(nil), "opentelemetry.proto.logs.v1.LogsData")
+	proto.RegisterType((*ResourceLogs)(nil), "opentelemetry.proto.logs.v1.ResourceLogs")
+	proto.RegisterType((*ScopeLogs)(nil), "opentelemetry.proto.logs.v1.ScopeLogs")
+	proto.RegisterType((*LogRecord)(nil), "opentelemetry.proto.logs.v1.LogRecord")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/logs/v1/logs.proto", fileDescriptor_d1c030a3ec7e961e)
+}
+
+var fileDescriptor_d1c030a3ec7e961e = []byte{
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdf, 0x6e, 0x22, 0x37,
+	0x14, 0xc6, 0x77, 0x42, 0xf8, 0x77, 0x42, 0x58, 0xd7, 0xcd, 0x26, 0xb3, 0x89, 0xda, 0xa5, 0x69,
+	0x95, 0xd2, 0x54, 0x82, 0x06, 0xa8, 0x54, 0x69, 0xaf, 0x48, 0x18, 0x10, 0xbb, 0x2c, 0x44, 0x06,
+	0x52, 0xed, 0xde, 0x58, 0x03, 0xb8, 0xec, 0xa8, 0xc3, 0x78, 0xe4, 0xf1, 0xa0, 0xe4, 0xa2, 0x2f,
+	0xd4, 0x87, 0xe8, 0xcd, 0xbe, 0x4c, 0x7b, 0xdd, 0x07, 0x68, 0x65, 0x33, 0x50, 0x92, 0xce, 0x64,
+	0xf7, 0x86, 0xb1, 0xcf, 0xef, 0xfb, 0x3e, 0x1f, 0x8f, 0xc1, 0xc0, 0x19, 0xf7, 0x99, 0x27, 0x99,
+	0xcb, 0x16, 0x4c, 0x8a, 0xbb, 0xaa, 0x2f, 0xb8, 0xe4, 0x55, 0x97, 0xcf, 0x83, 0xea, 0xf2, 0x42,
+	0x3f, 0x2b, 0xba, 0x84, 0x4f, 0xee, 0xe9, 0x56, 0xc5, 0x8a, 0xe6, 0xcb, 0x8b, 0xe3, 0xf3, 0xb8,
+	0x90, 0x29, 0x5f, 0x2c, 0xb8, 0xa7, 0x62, 0x56, 0xa3, 0x95, 0xe7, 0xb8, 0x12, 0xa7, 0x15, 0x2c,
+	0xe0, 0xa1, 0x98, 0x32, 0xa5, 0x5e, 0x8f, 0x57, 0xfa, 0xd3, 0x77, 0x90, 0xeb, 0xf1, 0x79, 0xd0,
+	0xb2, 0xa5, 0x8d, 0xfb, 0xb0, 0xbf, 0xa6, 0x54, 0xad, 0x6d, 0x1a, 0xa5, 0x54, 0x79, 0xaf, 0xf6,
+	0x5d, 0xe5, 0x91, 0xe6, 0x2a, 0x24, 0x72, 0xa8, 0x14, 0x52, 0x10, 0x5b, 0xb3, 0xd3, 0x0f, 0x06,
+	0x14, 0xb6, 0x31, 0xb6, 0x20, 0xb7, 0x16, 0x98, 0x46, 0xc9, 0x48, 0xcc, 0xde, 0xf4, 0xb8, 0x95,
+	0x4f, 0x36, 0x56, 0x6c, 0x01, 0x04, 0x53, 0xee, 0x47, 0x4d, 0xee, 0xe8, 0x26, 0xcf, 0x1e, 0x6d,
+	0x72, 0xa8, 0xe4, 0xba, 0xc3, 0x7c, 0xb0, 0x1e, 0xe2, 0x2f, 0x54, 0xcc, 0x7b, 0xb6, 0xb0, 0x69,
+	0x28, 0x5c, 0x33, 0x55, 0x32, 0xca, 0x79, 0x92, 0x5f, 0x55, 0xc6, 0xc2, 0x7d, 0x95, 0xc9, 0xfd,
+	0x99, 0x45, 0x7f, 0x65, 0x4f, 0xff, 0x30, 0x20, 0xbf, 0xf1, 0xe3, 0x2e, 0xa4, 0x75, 0x42, 0xd4,
+	0x7f, 0x3d, 0x76, 0xd9, 0xe8, 0x44, 0x96, 0x17, 0x95, 0xae, 0x17, 0x48, 0x11, 0x2e, 0x98, 0x27,
+	0x6d, 0xe9, 0x70, 0x4f, 0xe7, 0x90, 0x55, 0x02, 0xee, 0xc0, 0x9e, 0xcb, 0xe7, 0x54, 0xb0, 0x29,
+	0x17, 0xb3, 0x4f, 0xdb, 0x47, 0x8f, 0xcf, 0x89, 0x96, 0x13, 0x70, 0xd7, 0xc3, 0x8f, 0x6d, 0xe4,
+	0xf4, 0xef, 0x14, 0xe4, 0x37, 0x46, 0xfc, 0x0d, 0x14, 0xa5, 0xb3, 0x60, 0x34, 0xf4, 0x9c, 0x5b,
+	0xea, 0xd9, 0x1e, 0xd7, 0x3b, 0xc9, 0x90, 0x82, 0xaa, 0x8e, 0x3d, 0xe7, 0xb6, 0x6f, 0x7b, 0x1c,
+	0xff, 0x08, 0x47, 0x7c, 0x12, 0x30, 0xb1, 0x64, 0x33, 0xfa, 0x40, 0xbe, 0xa7, 0xe5, 0x07, 0x6b,
+	0x3c, 0xda, 0xb6, 0x8d, 0xe0, 0x69, 0xc0, 0x96, 0x4c, 0x38, 0xf2, 0x8e, 0x7a, 0xe1, 0x62, 0xc2,
+	0x84, 0xb9, 0x53, 0x32, 0xca, 0xc5, 0xda, 0xf7, 0x8f, 0x1f, 0x4f, 0xe4, 0xe9, 0x6b, 0x0b, 0x29,
+	0x06, 0xf7, 0xe6, 0xf8, 0x6b, 0xd8, 0xdf, 0xa4, 0x4a, 0x76, 0x2b, 0xa3, 0x2d, 0x16, 0xd6, 0xc5,
+	0x11, 0xbb, 0x95, 0xf8, 0x25, 0xec, 0x4e, 0xf8, 0xec, 0xce, 0x4c, 0xeb, 0x73, 0xf9, 0xf6, 0x23,
+	0xe7, 0xd2, 0xf4, 0xee, 0x6e, 0x6c, 0x37, 0x64, 0x44, 0x9b, 0x70, 0x07, 0xc0, 0x96, 0x52, 0x38,
+	0x93, 0x50, 0xb2, 0xc0, 0xcc, 0x94, 0x52, 0x9f, 0x10, 0xf1, 0x9a, 0x45, 0x11, 0x5b, 0x56, 0xfc,
+	0x13, 0x98, 0x33, 0xc1, 0x7d, 0x9f, 0xcd, 0xe8, 0x7f, 0x55, 0x3a, 0xe5, 0xa1, 0x27, 0xcd, 0x6c,
+	0xc9, 0x28, 0xef, 0x93, 0xc3, 0x88, 0x37, 0x37, 0xf8, 0x4a, 0x51, 0x7c, 0x00, 0xe9, 0x5f, 0x5c,
+	0x7b, 0x1e, 0x98, 0xb9, 0x92, 0x51, 0xce, 0x92, 0xd5, 0x04, 0x3f, 0x87, 0x9c, 0x14, 0xf6, 0x94,
+	0x51, 0x67, 0x66, 0xe6, 0x4b, 0x46, 0xb9, 0x40, 0xb2, 0x7a, 0xde, 0x9d, 0xe1, 0x23, 0xc8, 0x06,
+	0xbe, 0xed, 0x29, 0x02, 0x9a, 0x64, 0xd4, 0xb4, 0x3b, 0x7b, 0xb5, 0x9b, 0xdb, 0x45, 0xe9, 0xf3,
+	0x0f, 0x69, 0x28, 0xde, 0x7f, 0xaf, 0xf8, 0x05, 0x9c, 0x0c, 0xad, 0x1b, 0x8b, 0x74, 0x47, 0x6f,
+	0x69, 0x7f, 0xfc, 0xe6, 0xd2, 0x22, 0x74, 0xdc, 0x1f, 0x5e, 0x5b, 0x57, 0xdd, 0x76, 0xd7, 0x6a,
+	0xa1, 0x27, 0xf8, 0x39, 0x3c, 0x7b, 0x28, 0x18, 0x91, 0xe6, 0x95, 0x85, 0x0c, 0x7c, 0x0c, 0x87,
+	0xb1, 0xa8, 0x86, 0x76, 0x12, 0x59, 0x1d, 0xa5, 0x12, 0x59, 0x03, 0xed, 0xc6, 0x2d, 0xd7, 0xb2,
+	0x2e, 0xc7, 0x1d, 0x94, 0x8e, 0xb3, 0x69, 0x54, 0x43, 0x99, 0x44, 0x56, 0x47, 0xd9, 0x44, 0xd6,
+	0x40, 0x39, 0x6c, 0xc2, 0xc1, 0x43, 0xd6, 0xed, 0xb7, 0x07, 0x28, 0x1f, 0xd7, 0x88, 0x22, 0x35,
+	0x04, 0x49, 0xa8, 0x8e, 0xf6, 0x92, 0x50, 0x03, 0x15, 0xe2, 0x96, 0xfa, 0xb9, 0x49, 0xfa, 0x68,
+	0x3f, 0xce, 0xa4, 0x48, 0x0d, 0x15, 0x93, 0x50, 0x1d, 0x3d, 0x4d, 0x42, 0x0d, 0x84, 0xe2, 0x90,
+	0x45, 0xc8, 0x80, 0xa0, 0xcf, 0xe2, 0x5e, 0x86, 0x46, 0x35, 0x84, 0x13, 0x59, 0x1d, 0x7d, 0x9e,
+	0xc8, 0x1a, 0xe8, 0x20, 0x6e, 0xb9, 0x76, 0x73, 0xd4, 0xec, 0xa1, 0x67, 0x71, 0x36, 0x8d, 0x6a,
+	0xe8, 0x30, 0x91, 0xd5, 0xd1, 0x51, 0x22, 0x6b, 0x20, 0xf3, 0xfc, 0x2d, 0x14, 0x37, 0x57, 0x57,
+	0x5b, 0xff, 0x22, 0x5e, 0xc0, 0x49, 0x6f, 0xd0, 0xa1, 0xc4, 0xba, 0x1a, 0x90, 0x16, 0x6d, 0xf7,
+	0x9a, 0x9d, 0x21, 0x6d, 0x0d, 0x68, 0x7f, 0x30, 0xa2, 0xe3, 0xa1, 0x85, 0x9e, 0xe0, 0x33, 0xf8,
+	0xea, 0x7f, 0x02, 0xfd, 0x95, 0x8b, 0xc6, 0x6f, 0x9a, 0xc3, 0xd7, 0xe8, 0x1f, 0xe3, 0xf2, 0x37,
+	0xf8, 0xd2, 0xe1, 0x8f, 0x5d, 0x4b, 0x97, 0xea, 0xd6, 0x0c, 0xae, 0x55, 0xe9, 0xda, 0x78, 0xf7,
+	0xc3, 0xdc, 0x91, 0xef, 0xc3, 0x89, 0xba, 0x00, 0xaa, 0x33, 0xdb, 0x17, 0xab, 0x0f, 0xff, 0xd7,
+	0x79, 0x95, 0x4b, 0xd7, 0xbf, 0xff, 0xcf, 0xfe, 0x52, 0x3d, 0x7f, 0xdf, 0x39, 0x19, 0xf8, 0xcc,
+	0x1b, 0x6d, 0xc2, 0x75, 0x92, 0xba, 0xc2, 0x83, 0xca, 0xcd, 0xc5, 0x24, 0xa3, 0x1d, 0xf5, 0x7f,
+	0x07, 0x00, 0x74, 0xc4, 0x28, 0x63, 0x22, 0x08, 0x00, 0x00,
+}
@@ -215,6 +215,110 @@ func (m *InternalInvokeResponse) GetMessage() *v1.InvokeResponse {
 	return nil
 }
 
+// InternalInvokeRequestStream is the message of the caller's request stream
+// for streaming service invocation. The first message of the stream carries
+// the request, the following ones carry the chunks of the request body.
+type InternalInvokeRequestStream struct {
+	// The request without body data. Set on the first message of the stream only.
+	Request *InternalInvokeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// The next chunk of the request body.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InternalInvokeRequestStream) Reset()         { *m = InternalInvokeRequestStream{} }
+func (m *InternalInvokeRequestStream) String() string { return proto.CompactTextString(m) }
+func (*InternalInvokeRequestStream) ProtoMessage()    {}
+func (*InternalInvokeRequestStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a1e51ba6ea480e4, []int{3}
+}
+
+func (m *InternalInvokeRequestStream) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalInvokeRequestStream.Unmarshal(m, b)
+}
+func (m *InternalInvokeRequestStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalInvokeRequestStream.Marshal(b, m, deterministic)
+}
+func (m *InternalInvokeRequestStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalInvokeRequestStream.Merge(m, src)
+}
+func (m *InternalInvokeRequestStream) XXX_Size() int {
+	return xxx_messageInfo_InternalInvokeRequestStream.Size(m)
+}
+func (m *InternalInvokeRequestStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalInvokeRequestStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalInvokeRequestStream proto.InternalMessageInfo
+
+func (m *InternalInvokeRequestStream) GetRequest() *InternalInvokeRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *InternalInvokeRequestStream) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// InternalInvokeResponseStream is the message of the callee's response stream
+// for streaming service invocation. The first message of the stream carries
+// the response, the following ones carry the chunks of the response body.
+type InternalInvokeResponseStream struct {
+	// The response without body data. Set on the first message of the stream only.
+	Response *InternalInvokeResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// The next chunk of the response body.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InternalInvokeResponseStream) Reset()         { *m = InternalInvokeResponseStream{} }
+func (m *InternalInvokeResponseStream) String() string { return proto.CompactTextString(m) }
+func (*InternalInvokeResponseStream) ProtoMessage()    {}
+func (*InternalInvokeResponseStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a1e51ba6ea480e4, []int{4}
+}
+
+func (m *InternalInvokeResponseStream) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalInvokeResponseStream.Unmarshal(m, b)
+}
+func (m *InternalInvokeResponseStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalInvokeResponseStream.Marshal(b, m, deterministic)
+}
+func (m *InternalInvokeResponseStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalInvokeResponseStream.Merge(m, src)
+}
+func (m *InternalInvokeResponseStream) XXX_Size() int {
+	return xxx_messageInfo_InternalInvokeResponseStream.Size(m)
+}
+func (m *InternalInvokeResponseStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalInvokeResponseStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalInvokeResponseStream proto.InternalMessageInfo
+
+func (m *InternalInvokeResponseStream) GetResponse() *InternalInvokeResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *InternalInvokeResponseStream) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // ListStringValue represents string value array
 type ListStringValue struct {
 	// The array of string.
@@ -228,7 +332,7 @@ func (m *ListStringValue) Reset()         { *m = ListStringValue{} }
 func (m *ListStringValue) String() string { return proto.CompactTextString(m) }
 func (*ListStringValue) ProtoMessage()    {}
 func (*ListStringValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a1e51ba6ea480e4, []int{3}
+	return fileDescriptor_6a1e51ba6ea480e4, []int{5}
 }
 
 func (m *ListStringValue) XXX_Unmarshal(b []byte) error {
@@ -263,6 +367,8 @@ func init() {
 	proto.RegisterType((*InternalInvokeResponse)(nil), "dapr.proto.internals.v1.InternalInvokeResponse")
 	proto.RegisterMapType((map[string]*ListStringValue)(nil), "dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry")
 	proto.RegisterMapType((map[string]*ListStringValue)(nil), "dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry")
+	proto.RegisterType((*InternalInvokeRequestStream)(nil), "dapr.proto.internals.v1.InternalInvokeRequestStream")
+	proto.RegisterType((*InternalInvokeResponseStream)(nil), "dapr.proto.internals.v1.InternalInvokeResponseStream")
 	proto.RegisterType((*ListStringValue)(nil), "dapr.proto.internals.v1.ListStringValue")
 }
 
@@ -271,41 +377,46 @@ func init() {
 }
 
 var fileDescriptor_6a1e51ba6ea480e4 = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0xa6, 0xcd, 0xfa, 0x77, 0xca, 0xaf, 0x25, 0x46, 0x89, 0x04, 0x94, 0xb2, 0x8b, 0x70, 0x93,
-	0xb0, 0xc2, 0x34, 0x04, 0x6c, 0x52, 0x41, 0x48, 0x44, 0x1a, 0x12, 0x4a, 0xa7, 0x0a, 0xb8, 0x99,
-	0xbc, 0xc4, 0xea, 0xac, 0xa6, 0x76, 0xb0, 0xdd, 0x48, 0xbd, 0xe7, 0x05, 0x78, 0x56, 0x5e, 0x00,
-	0xd9, 0x4e, 0x43, 0x3b, 0x2d, 0x48, 0xbd, 0x18, 0x77, 0x76, 0xce, 0xf7, 0x73, 0xec, 0xcf, 0x39,
-	0xf0, 0x22, 0xc1, 0x99, 0x08, 0x32, 0xc1, 0x15, 0x0f, 0x28, 0x53, 0x44, 0x30, 0x9c, 0xca, 0x20,
-	0xdf, 0x0f, 0x24, 0x11, 0x39, 0x8d, 0xc9, 0x19, 0x65, 0x39, 0x8f, 0xb1, 0xa2, 0x9c, 0xf9, 0x06,
-	0x85, 0x1e, 0x68, 0x86, 0x5d, 0xfb, 0x25, 0xc3, 0xcf, 0xf7, 0xdd, 0xa7, 0x6b, 0x52, 0x31, 0x9f,
-	0xcf, 0x39, 0xd3, 0x3a, 0x76, 0x65, 0xf1, 0xae, 0x57, 0xe5, 0x86, 0x33, 0x9a, 0x13, 0x21, 0x4b,
-	0x17, 0x77, 0xaf, 0xb2, 0x2f, 0x85, 0xd5, 0x42, 0x5a, 0xd4, 0x60, 0x04, 0x8d, 0x51, 0xac, 0xb8,
-	0x40, 0x8f, 0x00, 0xb0, 0x5e, 0x9c, 0xa9, 0x65, 0x46, 0x7a, 0xb5, 0x7e, 0xcd, 0xeb, 0x44, 0x1d,
-	0xf3, 0xe5, 0x74, 0x99, 0x11, 0xf4, 0x10, 0xda, 0xb6, 0x4c, 0x93, 0x5e, 0xdd, 0x14, 0x5b, 0x66,
-	0x1f, 0x26, 0x83, 0x9f, 0x0e, 0xdc, 0x0f, 0x0b, 0x83, 0x90, 0xe5, 0x7c, 0x46, 0x22, 0xf2, 0x63,
-	0x41, 0xa4, 0x42, 0x07, 0xe0, 0xe4, 0x44, 0x18, 0xb1, 0xdb, 0xc3, 0x67, 0x7e, 0xc5, 0xb1, 0xfd,
-	0xd1, 0x97, 0x70, 0x62, 0x5b, 0x8f, 0x34, 0x1e, 0x7d, 0x85, 0xf6, 0x9c, 0x28, 0x9c, 0x60, 0x85,
-	0x7b, 0xf5, 0xbe, 0xe3, 0x75, 0x87, 0xef, 0x2a, 0xb9, 0x57, 0x1a, 0xfb, 0x9f, 0x0b, 0xfa, 0x47,
-	0xa6, 0xc4, 0x32, 0x2a, 0xd5, 0xd0, 0x11, 0xb4, 0xe6, 0x44, 0x4a, 0x3c, 0x25, 0x3d, 0xa7, 0x5f,
-	0xf3, 0xba, 0x9b, 0x4d, 0x15, 0x17, 0x6d, 0x54, 0xd7, 0xd4, 0xa2, 0x15, 0x07, 0xbd, 0x82, 0x86,
-	0x39, 0x74, 0x6f, 0xc7, 0x90, 0x1f, 0x57, 0x9f, 0x48, 0xa3, 0x22, 0x0b, 0x76, 0x09, 0xdc, 0xda,
-	0xe8, 0x07, 0xdd, 0x05, 0x67, 0x46, 0x96, 0xc5, 0x1d, 0xeb, 0x25, 0x3a, 0x86, 0x46, 0x8e, 0xd3,
-	0x05, 0x31, 0x57, 0xdb, 0x1d, 0x7a, 0x95, 0xc2, 0x27, 0x54, 0xaa, 0xb1, 0x12, 0x94, 0x4d, 0x27,
-	0x1a, 0x1f, 0x59, 0xda, 0x9b, 0xfa, 0xeb, 0xda, 0xe0, 0xd7, 0x0e, 0xec, 0x5e, 0xbe, 0x0d, 0x99,
-	0x71, 0x26, 0x09, 0x3a, 0x84, 0xa6, 0x0d, 0xdd, 0x78, 0x76, 0x87, 0x4f, 0x2a, 0xf5, 0xc7, 0x06,
-	0x16, 0x15, 0x70, 0x34, 0x81, 0xd6, 0x05, 0xc1, 0x09, 0x11, 0x72, 0xeb, 0x20, 0xac, 0xb5, 0xff,
-	0xc9, 0xd2, 0x6d, 0x10, 0x2b, 0x31, 0xf4, 0x0d, 0xda, 0x4a, 0x60, 0x9a, 0x6a, 0x61, 0xc7, 0x08,
-	0x1f, 0x6d, 0x2b, 0x7c, 0x5a, 0xf0, 0x8b, 0x88, 0x57, 0x72, 0xe8, 0xf8, 0x6f, 0xc4, 0x36, 0xa5,
-	0xbd, 0x7f, 0x47, 0x6c, 0xe5, 0xca, 0x8c, 0xdd, 0x04, 0x6e, 0xae, 0xf7, 0x7c, 0x3d, 0x61, 0xe9,
-	0x37, 0xb1, 0x71, 0x80, 0x6b, 0x7a, 0x13, 0xcf, 0xe1, 0xce, 0xa5, 0x2a, 0xda, 0x85, 0xa6, 0xa9,
-	0xeb, 0xb7, 0xe0, 0x78, 0x9d, 0xa8, 0xd8, 0x0d, 0x7f, 0xd7, 0xe0, 0xde, 0xd8, 0x4e, 0xac, 0xb0,
-	0x1c, 0x58, 0x88, 0x41, 0xe7, 0x03, 0x4e, 0x53, 0x3b, 0x22, 0xfc, 0xed, 0xfe, 0x42, 0x37, 0xd8,
-	0x32, 0xd3, 0xc1, 0x8d, 0x95, 0xdf, 0x09, 0x8f, 0x71, 0xfa, 0x1f, 0xfc, 0xde, 0x1f, 0x7e, 0x3f,
-	0x98, 0x52, 0x75, 0xb1, 0x38, 0xd7, 0x2f, 0x23, 0x30, 0x13, 0xd3, 0x8e, 0xcd, 0xd9, 0xf4, 0x8a,
-	0xd1, 0xf9, 0xb6, 0xdc, 0x9c, 0x37, 0x4d, 0xf5, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc,
-	0x35, 0xd8, 0x19, 0xfe, 0x05, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0xe3, 0xb6, 0x49, 0x26, 0x85, 0xc2, 0x4a, 0x94, 0x60, 0xbe, 0x82, 0xe9, 0xc1, 0x5c,
+	0xec, 0x36, 0x34, 0x2a, 0x02, 0x5a, 0x29, 0x20, 0xa4, 0x46, 0x14, 0x09, 0x6d, 0xaa, 0x08, 0xb8,
+	0x54, 0xdb, 0x78, 0x95, 0x5a, 0x71, 0x6c, 0xb3, 0xde, 0x58, 0x8a, 0x38, 0x70, 0xe9, 0x1f, 0xe0,
+	0xef, 0x72, 0x42, 0xfb, 0x11, 0x93, 0x54, 0x31, 0x92, 0x0f, 0xe5, 0x62, 0xed, 0xc7, 0xbc, 0xf7,
+	0x66, 0xe7, 0xed, 0xac, 0x61, 0xcf, 0x27, 0x09, 0xf3, 0x12, 0x16, 0xf3, 0xd8, 0x0b, 0x22, 0x4e,
+	0x59, 0x44, 0xc2, 0xd4, 0xcb, 0xf6, 0xbd, 0x94, 0xb2, 0x2c, 0x18, 0xd2, 0xf3, 0x20, 0xca, 0xe2,
+	0x21, 0xe1, 0x41, 0x1c, 0xb9, 0x32, 0x0a, 0xdd, 0x17, 0x08, 0x35, 0x76, 0x73, 0x84, 0x9b, 0xed,
+	0x5b, 0xcf, 0x16, 0xa8, 0x86, 0xf1, 0x64, 0x12, 0x47, 0x82, 0x47, 0x8d, 0x54, 0xbc, 0xe5, 0x14,
+	0xa9, 0x91, 0x24, 0xc8, 0x28, 0x4b, 0x73, 0x15, 0x6b, 0xb7, 0x30, 0x2f, 0x4e, 0xf8, 0x34, 0x55,
+	0x51, 0x76, 0x17, 0x36, 0xba, 0x43, 0x1e, 0x33, 0xf4, 0x18, 0x80, 0x88, 0xc1, 0x39, 0x9f, 0x25,
+	0xb4, 0x69, 0xb4, 0x0c, 0xa7, 0x8e, 0xeb, 0x72, 0xe5, 0x6c, 0x96, 0x50, 0xf4, 0x00, 0x6a, 0x6a,
+	0x3b, 0xf0, 0x9b, 0x15, 0xb9, 0x59, 0x95, 0xf3, 0x9e, 0x6f, 0x5f, 0x99, 0x70, 0xaf, 0xa7, 0x05,
+	0x7a, 0x51, 0x16, 0x8f, 0x29, 0xa6, 0xdf, 0xa7, 0x34, 0xe5, 0xa8, 0x03, 0x66, 0x46, 0x99, 0x24,
+	0xbb, 0xdd, 0x7e, 0xee, 0x16, 0x1c, 0xdb, 0xed, 0x7e, 0xee, 0x0d, 0x54, 0xea, 0x58, 0xc4, 0xa3,
+	0x2f, 0x50, 0x9b, 0x50, 0x4e, 0x7c, 0xc2, 0x49, 0xb3, 0xd2, 0x32, 0x9d, 0x46, 0xfb, 0x6d, 0x21,
+	0x76, 0xa5, 0xb0, 0xfb, 0x49, 0xc3, 0x3f, 0x44, 0x9c, 0xcd, 0x70, 0xce, 0x86, 0x8e, 0xa0, 0x3a,
+	0xa1, 0x69, 0x4a, 0x46, 0xb4, 0x69, 0xb6, 0x0c, 0xa7, 0xb1, 0x9c, 0x94, 0x2e, 0xb4, 0x64, 0x5d,
+	0x60, 0xc3, 0x73, 0x0c, 0x3a, 0x80, 0x0d, 0x79, 0xe8, 0xe6, 0xba, 0x04, 0x3f, 0x29, 0x3e, 0x91,
+	0x88, 0xc2, 0x2a, 0xd8, 0xa2, 0x70, 0x6b, 0x29, 0x1f, 0x74, 0x07, 0xcc, 0x31, 0x9d, 0xe9, 0x1a,
+	0x8b, 0x21, 0x3a, 0x86, 0x8d, 0x8c, 0x84, 0x53, 0x2a, 0x4b, 0xdb, 0x68, 0x3b, 0x85, 0xc4, 0xa7,
+	0x41, 0xca, 0xfb, 0x9c, 0x05, 0xd1, 0x68, 0x20, 0xe2, 0xb1, 0x82, 0xbd, 0xae, 0xbc, 0x32, 0xec,
+	0x5f, 0xeb, 0xb0, 0x73, 0xbd, 0x1a, 0x69, 0x12, 0x47, 0x29, 0x45, 0x87, 0xb0, 0xa9, 0x4c, 0x97,
+	0x9a, 0x8d, 0xf6, 0xd3, 0x42, 0xfe, 0xbe, 0x0c, 0xc3, 0x3a, 0x1c, 0x0d, 0xa0, 0x7a, 0x49, 0x89,
+	0x4f, 0x59, 0x5a, 0xda, 0x08, 0x25, 0xed, 0x9e, 0x28, 0xb8, 0x32, 0x62, 0x4e, 0x86, 0xbe, 0x42,
+	0x8d, 0x33, 0x12, 0x84, 0x82, 0xd8, 0x94, 0xc4, 0x47, 0x65, 0x89, 0xcf, 0x34, 0x5e, 0x5b, 0x3c,
+	0xa7, 0x43, 0xc7, 0x7f, 0x2d, 0x56, 0x2e, 0xed, 0xfe, 0xdb, 0x62, 0x45, 0x97, 0x7b, 0x6c, 0xf9,
+	0xb0, 0xb5, 0x98, 0xf3, 0xcd, 0x98, 0x25, 0xee, 0xc4, 0xd2, 0x01, 0x6e, 0xe8, 0x4e, 0xfc, 0x80,
+	0x87, 0x2b, 0x1b, 0xa4, 0xcf, 0x19, 0x25, 0x13, 0x74, 0x02, 0x55, 0xa6, 0x16, 0xf4, 0xc5, 0x70,
+	0xcb, 0xf5, 0x19, 0x9e, 0xc3, 0x11, 0x82, 0x75, 0xdd, 0xae, 0x86, 0xb3, 0x85, 0xe5, 0xd8, 0xfe,
+	0x09, 0x8f, 0x56, 0x7b, 0xa7, 0xd5, 0x3f, 0x42, 0x8d, 0xe9, 0x15, 0x2d, 0xef, 0x95, 0xbc, 0x04,
+	0x38, 0x27, 0x58, 0x99, 0xc0, 0x0b, 0xd8, 0xbe, 0x56, 0x1b, 0xb4, 0x03, 0x9b, 0xb2, 0x3a, 0xa2,
+	0x13, 0x4c, 0xa7, 0x8e, 0xf5, 0xac, 0xfd, 0xbb, 0x02, 0x77, 0xfb, 0xea, 0xbd, 0xee, 0xe5, 0xcf,
+	0x35, 0x8a, 0xa0, 0xfe, 0x9e, 0x84, 0xa1, 0x7a, 0x20, 0x4b, 0xd6, 0xc6, 0x2a, 0x7b, 0x18, 0x7b,
+	0x6d, 0xae, 0x77, 0x1a, 0x0f, 0x49, 0xf8, 0x3f, 0xf4, 0xae, 0x0c, 0xd8, 0xce, 0x05, 0xb5, 0x2b,
+	0x07, 0xe5, 0x64, 0x15, 0xca, 0xea, 0x94, 0x14, 0x57, 0x30, 0x7b, 0xcd, 0x31, 0xf6, 0x8c, 0x77,
+	0x87, 0xdf, 0x3a, 0xa3, 0x80, 0x5f, 0x4e, 0x2f, 0x44, 0x7b, 0x7a, 0xf2, 0xb7, 0x25, 0x3f, 0xc9,
+	0x78, 0xb4, 0xe2, 0xff, 0xf5, 0x26, 0x9f, 0x5c, 0x6c, 0xca, 0xdd, 0x97, 0x7f, 0x06, 0x00, 0x1b,
+	0xc9, 0x31, 0xb9, 0x83, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -324,6 +435,9 @@ type ServiceInvocationClient interface {
 	CallActor(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (*InternalInvokeResponse, error)
 	// Invokes a method of the specific service.
 	CallLocal(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (*InternalInvokeResponse, error)
+	// Invokes a method of the specific service, streaming the request
+	// and response bodies in chunks.
+	CallLocalStream(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallLocalStreamClient, error)
 }
 
 type serviceInvocationClient struct {
@@ -352,12 +466,46 @@ func (c *serviceInvocationClient) CallLocal(ctx context.Context, in *InternalInv
 	return out, nil
 }
 
+func (c *serviceInvocationClient) CallLocalStream(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallLocalStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ServiceInvocation_serviceDesc.Streams[0], "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceInvocationCallLocalStreamClient{stream}
+	return x, nil
+}
+
+type ServiceInvocation_CallLocalStreamClient interface {
+	Send(*InternalInvokeRequestStream) error
+	Recv() (*InternalInvokeResponseStream, error)
+	grpc.ClientStream
+}
+
+type serviceInvocationCallLocalStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceInvocationCallLocalStreamClient) Send(m *InternalInvokeRequestStream) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallLocalStreamClient) Recv() (*InternalInvokeResponseStream, error) {
+	m := new(InternalInvokeResponseStream)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocationServer is the server API for ServiceInvocation service.
 type ServiceInvocationServer interface {
 	// Invokes a method of the specific actor.
 	CallActor(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error)
 	// Invokes a method of the specific service.
 	CallLocal(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error)
+	// Invokes a method of the specific service, streaming the request
+	// and response bodies in chunks.
+	CallLocalStream(ServiceInvocation_CallLocalStreamServer) error
 }
 
 // UnimplementedServiceInvocationServer can be embedded to have forward compatible implementations.
@@ -370,6 +518,9 @@ func (*UnimplementedServiceInvocationServer) CallActor(ctx context.Context, req
 func (*UnimplementedServiceInvocationServer) CallLocal(ctx context.Context, req *InternalInvokeRequest) (*InternalInvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallLocal not implemented")
 }
+func (*UnimplementedServiceInvocationServer) CallLocalStream(srv ServiceInvocation_CallLocalStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CallLocalStream not implemented")
+}
 
 func RegisterServiceInvocationServer(s *grpc.Server, srv ServiceInvocationServer) {
 	s.RegisterService(&_ServiceInvocation_serviceDesc, srv)
@@ -411,6 +562,32 @@ func _ServiceInvocation_CallLocal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceInvocation_CallLocalStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServiceInvocationServer).CallLocalStream(&serviceInvocationCallLocalStreamServer{stream})
+}
+
+type ServiceInvocation_CallLocalStreamServer interface {
+	Send(*InternalInvokeResponseStream) error
+	Recv() (*InternalInvokeRequestStream, error)
+	grpc.ServerStream
+}
+
+type serviceInvocationCallLocalStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceInvocationCallLocalStreamServer) Send(m *InternalInvokeResponseStream) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallLocalStreamServer) Recv() (*InternalInvokeRequestStream, error) {
+	m := new(InternalInvokeRequestStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _ServiceInvocation_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dapr.proto.internals.v1.ServiceInvocation",
 	HandlerType: (*ServiceInvocationServer)(nil),
@@ -424,6 +601,13 @@ var _ServiceInvocation_serviceDesc = grpc.ServiceDesc{
 			Handler:    _ServiceInvocation_CallLocal_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CallLocalStream",
+			Handler:       _ServiceInvocation_CallLocalStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "dapr/proto/internals/v1/service_invocation.proto",
 }
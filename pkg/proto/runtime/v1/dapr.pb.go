@@ -686,6 +686,126 @@ func (m *InvokeBindingResponse) GetMetadata() map[string]string {
 	return nil
 }
 
+// InvokeBindingStreamRequest is the message to stream data to output bindings.
+// name, metadata and operation are read from the first message of the stream only.
+type InvokeBindingStreamRequest struct {
+	// The name of the output binding to invoke.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The metadata passing to output binding components
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The name of the operation type for the binding to invoke
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The next chunk of data which will be sent to output binding.
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvokeBindingStreamRequest) Reset()         { *m = InvokeBindingStreamRequest{} }
+func (m *InvokeBindingStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingStreamRequest) ProtoMessage()    {}
+func (*InvokeBindingStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{11}
+}
+
+func (m *InvokeBindingStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingStreamRequest.Unmarshal(m, b)
+}
+func (m *InvokeBindingStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingStreamRequest.Merge(m, src)
+}
+func (m *InvokeBindingStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingStreamRequest.Size(m)
+}
+func (m *InvokeBindingStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingStreamRequest proto.InternalMessageInfo
+
+func (m *InvokeBindingStreamRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InvokeBindingStreamRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *InvokeBindingStreamRequest) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *InvokeBindingStreamRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// InvokeBindingStreamResponse is the message streamed back from an output binding invocation.
+// metadata is set on the first message of the stream only.
+type InvokeBindingStreamResponse struct {
+	// The metadata returned from an external system
+	Metadata map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The next chunk of data returned from output binding.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvokeBindingStreamResponse) Reset()         { *m = InvokeBindingStreamResponse{} }
+func (m *InvokeBindingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingStreamResponse) ProtoMessage()    {}
+func (*InvokeBindingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{12}
+}
+
+func (m *InvokeBindingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingStreamResponse.Unmarshal(m, b)
+}
+func (m *InvokeBindingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingStreamResponse.Merge(m, src)
+}
+func (m *InvokeBindingStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingStreamResponse.Size(m)
+}
+func (m *InvokeBindingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingStreamResponse proto.InternalMessageInfo
+
+func (m *InvokeBindingStreamResponse) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *InvokeBindingStreamResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // GetSecretRequest is the message to get secret from secret store.
 type GetSecretRequest struct {
 	// The name of secret store.
@@ -703,7 +823,7 @@ func (m *GetSecretRequest) Reset()         { *m = GetSecretRequest{} }
 func (m *GetSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSecretRequest) ProtoMessage()    {}
 func (*GetSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da511bac0105b1e5, []int{11}
+	return fileDescriptor_da511bac0105b1e5, []int{13}
 }
 
 func (m *GetSecretRequest) XXX_Unmarshal(b []byte) error {
@@ -759,7 +879,7 @@ func (m *GetSecretResponse) Reset()         { *m = GetSecretResponse{} }
 func (m *GetSecretResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSecretResponse) ProtoMessage()    {}
 func (*GetSecretResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da511bac0105b1e5, []int{12}
+	return fileDescriptor_da511bac0105b1e5, []int{14}
 }
 
 func (m *GetSecretResponse) XXX_Unmarshal(b []byte) error {
@@ -787,6 +907,146 @@ func (m *GetSecretResponse) GetData() map[string]string {
 	return nil
 }
 
+// GetBulkSecretRequest is the message to get all secrets from a secret store.
+type GetBulkSecretRequest struct {
+	// The name of secret store.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// The metadata which will be sent to secret store components.
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional. Only secrets whose names start with this prefix are returned.
+	Prefix               string   `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBulkSecretRequest) Reset()         { *m = GetBulkSecretRequest{} }
+func (m *GetBulkSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBulkSecretRequest) ProtoMessage()    {}
+func (*GetBulkSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{15}
+}
+
+func (m *GetBulkSecretRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBulkSecretRequest.Unmarshal(m, b)
+}
+func (m *GetBulkSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBulkSecretRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBulkSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBulkSecretRequest.Merge(m, src)
+}
+func (m *GetBulkSecretRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBulkSecretRequest.Size(m)
+}
+func (m *GetBulkSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBulkSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBulkSecretRequest proto.InternalMessageInfo
+
+func (m *GetBulkSecretRequest) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *GetBulkSecretRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *GetBulkSecretRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+// SecretResponse is a map of decrypted string/string values for a single secret.
+type SecretResponse struct {
+	Secrets              map[string]string `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SecretResponse) Reset()         { *m = SecretResponse{} }
+func (m *SecretResponse) String() string { return proto.CompactTextString(m) }
+func (*SecretResponse) ProtoMessage()    {}
+func (*SecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{16}
+}
+
+func (m *SecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SecretResponse.Unmarshal(m, b)
+}
+func (m *SecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SecretResponse.Marshal(b, m, deterministic)
+}
+func (m *SecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretResponse.Merge(m, src)
+}
+func (m *SecretResponse) XXX_Size() int {
+	return xxx_messageInfo_SecretResponse.Size(m)
+}
+func (m *SecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretResponse proto.InternalMessageInfo
+
+func (m *SecretResponse) GetSecrets() map[string]string {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+// GetBulkSecretResponse is the response message to convey all the secrets
+// the caller is allowed to access, keyed by secret name.
+type GetBulkSecretResponse struct {
+	Data                 map[string]*SecretResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetBulkSecretResponse) Reset()         { *m = GetBulkSecretResponse{} }
+func (m *GetBulkSecretResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBulkSecretResponse) ProtoMessage()    {}
+func (*GetBulkSecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{17}
+}
+
+func (m *GetBulkSecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBulkSecretResponse.Unmarshal(m, b)
+}
+func (m *GetBulkSecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBulkSecretResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBulkSecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBulkSecretResponse.Merge(m, src)
+}
+func (m *GetBulkSecretResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBulkSecretResponse.Size(m)
+}
+func (m *GetBulkSecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBulkSecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBulkSecretResponse proto.InternalMessageInfo
+
+func (m *GetBulkSecretResponse) GetData() map[string]*SecretResponse {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // TransactionalStateOperation is the message to execute a specified operation with a key-value pair.
 type TransactionalStateOperation struct {
 	// The type of operation to be executed
@@ -802,7 +1062,7 @@ func (m *TransactionalStateOperation) Reset()         { *m = TransactionalStateO
 func (m *TransactionalStateOperation) String() string { return proto.CompactTextString(m) }
 func (*TransactionalStateOperation) ProtoMessage()    {}
 func (*TransactionalStateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da511bac0105b1e5, []int{13}
+	return fileDescriptor_da511bac0105b1e5, []int{18}
 }
 
 func (m *TransactionalStateOperation) XXX_Unmarshal(b []byte) error {
@@ -854,7 +1114,7 @@ func (m *ExecuteStateTransactionRequest) Reset()         { *m = ExecuteStateTran
 func (m *ExecuteStateTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteStateTransactionRequest) ProtoMessage()    {}
 func (*ExecuteStateTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da511bac0105b1e5, []int{14}
+	return fileDescriptor_da511bac0105b1e5, []int{19}
 }
 
 func (m *ExecuteStateTransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -896,6 +1156,318 @@ func (m *ExecuteStateTransactionRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+// GetMetadataResponse is the message describing the running sidecar.
+type GetMetadataResponse struct {
+	// The app id of the sidecar.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The version of the Dapr runtime.
+	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// The name of the effective configuration.
+	ConfigurationName string `protobuf:"bytes,3,opt,name=configuration_name,json=configurationName,proto3" json:"configuration_name,omitempty"`
+	// The count of active actors of each actor type.
+	ActiveActorsCount []*ActiveActorsCount `protobuf:"bytes,4,rep,name=active_actors_count,json=activeActorsCount,proto3" json:"active_actors_count,omitempty"`
+	// The actor types hosted by the app and the placement table version.
+	ActorRuntime *ActorRuntime `protobuf:"bytes,5,opt,name=actor_runtime,json=actorRuntime,proto3" json:"actor_runtime,omitempty"`
+	// The components processed by the sidecar, without their metadata.
+	RegisteredComponents []*RegisteredComponent `protobuf:"bytes,6,rep,name=registered_components,json=registeredComponents,proto3" json:"registered_components,omitempty"`
+	// The pub/sub subscriptions of the app.
+	Subscriptions        []*PubsubSubscription `protobuf:"bytes,7,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetMetadataResponse) Reset()         { *m = GetMetadataResponse{} }
+func (m *GetMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataResponse) ProtoMessage()    {}
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{20}
+}
+
+func (m *GetMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadataResponse.Unmarshal(m, b)
+}
+func (m *GetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadataResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataResponse.Merge(m, src)
+}
+func (m *GetMetadataResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMetadataResponse.Size(m)
+}
+func (m *GetMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadataResponse proto.InternalMessageInfo
+
+func (m *GetMetadataResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetMetadataResponse) GetRuntimeVersion() string {
+	if m != nil {
+		return m.RuntimeVersion
+	}
+	return ""
+}
+
+func (m *GetMetadataResponse) GetConfigurationName() string {
+	if m != nil {
+		return m.ConfigurationName
+	}
+	return ""
+}
+
+func (m *GetMetadataResponse) GetActiveActorsCount() []*ActiveActorsCount {
+	if m != nil {
+		return m.ActiveActorsCount
+	}
+	return nil
+}
+
+func (m *GetMetadataResponse) GetActorRuntime() *ActorRuntime {
+	if m != nil {
+		return m.ActorRuntime
+	}
+	return nil
+}
+
+func (m *GetMetadataResponse) GetRegisteredComponents() []*RegisteredComponent {
+	if m != nil {
+		return m.RegisteredComponents
+	}
+	return nil
+}
+
+func (m *GetMetadataResponse) GetSubscriptions() []*PubsubSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+// ActiveActorsCount is the count of active actors of an actor type.
+type ActiveActorsCount struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveActorsCount) Reset()         { *m = ActiveActorsCount{} }
+func (m *ActiveActorsCount) String() string { return proto.CompactTextString(m) }
+func (*ActiveActorsCount) ProtoMessage()    {}
+func (*ActiveActorsCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{21}
+}
+
+func (m *ActiveActorsCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveActorsCount.Unmarshal(m, b)
+}
+func (m *ActiveActorsCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveActorsCount.Marshal(b, m, deterministic)
+}
+func (m *ActiveActorsCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveActorsCount.Merge(m, src)
+}
+func (m *ActiveActorsCount) XXX_Size() int {
+	return xxx_messageInfo_ActiveActorsCount.Size(m)
+}
+func (m *ActiveActorsCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveActorsCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveActorsCount proto.InternalMessageInfo
+
+func (m *ActiveActorsCount) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ActiveActorsCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// ActorRuntime describes the actor types hosted by the app and the placement table in use.
+type ActorRuntime struct {
+	HostedActorTypes      []string `protobuf:"bytes,1,rep,name=hosted_actor_types,json=hostedActorTypes,proto3" json:"hosted_actor_types,omitempty"`
+	PlacementTableVersion string   `protobuf:"bytes,2,opt,name=placement_table_version,json=placementTableVersion,proto3" json:"placement_table_version,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ActorRuntime) Reset()         { *m = ActorRuntime{} }
+func (m *ActorRuntime) String() string { return proto.CompactTextString(m) }
+func (*ActorRuntime) ProtoMessage()    {}
+func (*ActorRuntime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{22}
+}
+
+func (m *ActorRuntime) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActorRuntime.Unmarshal(m, b)
+}
+func (m *ActorRuntime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActorRuntime.Marshal(b, m, deterministic)
+}
+func (m *ActorRuntime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorRuntime.Merge(m, src)
+}
+func (m *ActorRuntime) XXX_Size() int {
+	return xxx_messageInfo_ActorRuntime.Size(m)
+}
+func (m *ActorRuntime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorRuntime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorRuntime proto.InternalMessageInfo
+
+func (m *ActorRuntime) GetHostedActorTypes() []string {
+	if m != nil {
+		return m.HostedActorTypes
+	}
+	return nil
+}
+
+func (m *ActorRuntime) GetPlacementTableVersion() string {
+	if m != nil {
+		return m.PlacementTableVersion
+	}
+	return ""
+}
+
+// RegisteredComponent describes a component processed by the sidecar.
+type RegisteredComponent struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The init status of the component: loaded, failed or pending.
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisteredComponent) Reset()         { *m = RegisteredComponent{} }
+func (m *RegisteredComponent) String() string { return proto.CompactTextString(m) }
+func (*RegisteredComponent) ProtoMessage()    {}
+func (*RegisteredComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{23}
+}
+
+func (m *RegisteredComponent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisteredComponent.Unmarshal(m, b)
+}
+func (m *RegisteredComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisteredComponent.Marshal(b, m, deterministic)
+}
+func (m *RegisteredComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredComponent.Merge(m, src)
+}
+func (m *RegisteredComponent) XXX_Size() int {
+	return xxx_messageInfo_RegisteredComponent.Size(m)
+}
+func (m *RegisteredComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredComponent proto.InternalMessageInfo
+
+func (m *RegisteredComponent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegisteredComponent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RegisteredComponent) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *RegisteredComponent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// PubsubSubscription is a subscription of the app to a pub/sub topic.
+type PubsubSubscription struct {
+	PubsubName           string   `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubName,proto3" json:"pubsub_name,omitempty"`
+	Topic                string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Route                string   `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PubsubSubscription) Reset()         { *m = PubsubSubscription{} }
+func (m *PubsubSubscription) String() string { return proto.CompactTextString(m) }
+func (*PubsubSubscription) ProtoMessage()    {}
+func (*PubsubSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da511bac0105b1e5, []int{24}
+}
+
+func (m *PubsubSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PubsubSubscription.Unmarshal(m, b)
+}
+func (m *PubsubSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PubsubSubscription.Marshal(b, m, deterministic)
+}
+func (m *PubsubSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubsubSubscription.Merge(m, src)
+}
+func (m *PubsubSubscription) XXX_Size() int {
+	return xxx_messageInfo_PubsubSubscription.Size(m)
+}
+func (m *PubsubSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubsubSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubsubSubscription proto.InternalMessageInfo
+
+func (m *PubsubSubscription) GetPubsubName() string {
+	if m != nil {
+		return m.PubsubName
+	}
+	return ""
+}
+
+func (m *PubsubSubscription) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *PubsubSubscription) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InvokeServiceRequest)(nil), "dapr.proto.runtime.v1.InvokeServiceRequest")
 	proto.RegisterType((*GetStateRequest)(nil), "dapr.proto.runtime.v1.GetStateRequest")
@@ -913,84 +1485,131 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.InvokeBindingRequest.MetadataEntry")
 	proto.RegisterType((*InvokeBindingResponse)(nil), "dapr.proto.runtime.v1.InvokeBindingResponse")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.InvokeBindingResponse.MetadataEntry")
+	proto.RegisterType((*InvokeBindingStreamRequest)(nil), "dapr.proto.runtime.v1.InvokeBindingStreamRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.InvokeBindingStreamRequest.MetadataEntry")
+	proto.RegisterType((*InvokeBindingStreamResponse)(nil), "dapr.proto.runtime.v1.InvokeBindingStreamResponse")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.InvokeBindingStreamResponse.MetadataEntry")
 	proto.RegisterType((*GetSecretRequest)(nil), "dapr.proto.runtime.v1.GetSecretRequest")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.GetSecretRequest.MetadataEntry")
 	proto.RegisterType((*GetSecretResponse)(nil), "dapr.proto.runtime.v1.GetSecretResponse")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.GetSecretResponse.DataEntry")
+	proto.RegisterType((*GetBulkSecretRequest)(nil), "dapr.proto.runtime.v1.GetBulkSecretRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.GetBulkSecretRequest.MetadataEntry")
+	proto.RegisterType((*SecretResponse)(nil), "dapr.proto.runtime.v1.SecretResponse")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.SecretResponse.SecretsEntry")
+	proto.RegisterType((*GetBulkSecretResponse)(nil), "dapr.proto.runtime.v1.GetBulkSecretResponse")
+	proto.RegisterMapType((map[string]*SecretResponse)(nil), "dapr.proto.runtime.v1.GetBulkSecretResponse.DataEntry")
 	proto.RegisterType((*TransactionalStateOperation)(nil), "dapr.proto.runtime.v1.TransactionalStateOperation")
 	proto.RegisterType((*ExecuteStateTransactionRequest)(nil), "dapr.proto.runtime.v1.ExecuteStateTransactionRequest")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.runtime.v1.ExecuteStateTransactionRequest.MetadataEntry")
+	proto.RegisterType((*GetMetadataResponse)(nil), "dapr.proto.runtime.v1.GetMetadataResponse")
+	proto.RegisterType((*ActiveActorsCount)(nil), "dapr.proto.runtime.v1.ActiveActorsCount")
+	proto.RegisterType((*ActorRuntime)(nil), "dapr.proto.runtime.v1.ActorRuntime")
+	proto.RegisterType((*RegisteredComponent)(nil), "dapr.proto.runtime.v1.RegisteredComponent")
+	proto.RegisterType((*PubsubSubscription)(nil), "dapr.proto.runtime.v1.PubsubSubscription")
 }
 
 func init() { proto.RegisterFile("dapr/proto/runtime/v1/dapr.proto", fileDescriptor_da511bac0105b1e5) }
 
 var fileDescriptor_da511bac0105b1e5 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x73, 0xdb, 0x44,
-	0x10, 0x8f, 0x64, 0x3b, 0xa9, 0xd7, 0x49, 0x09, 0xd7, 0x14, 0x3c, 0x4a, 0xa1, 0x46, 0x64, 0xa8,
-	0xa1, 0x8c, 0x3c, 0x76, 0xe9, 0xa4, 0x4d, 0xe1, 0x81, 0xfc, 0x21, 0xd3, 0x07, 0x4a, 0x50, 0x52,
-	0x1e, 0x98, 0x61, 0xd2, 0xb3, 0xbc, 0xb8, 0xaa, 0xf5, 0x0f, 0xe9, 0xa4, 0xc1, 0x2f, 0x7c, 0x0a,
-	0x3e, 0x04, 0xf0, 0xc4, 0x47, 0xe0, 0x3b, 0xf0, 0x35, 0x78, 0xe0, 0x0b, 0x30, 0xc3, 0xe8, 0x4e,
-	0x92, 0xe5, 0xd8, 0x52, 0x9c, 0x64, 0xfc, 0xe2, 0x39, 0xad, 0x6f, 0x77, 0x7f, 0xbb, 0xfb, 0xdb,
-	0xdb, 0x3b, 0x68, 0x0d, 0xa8, 0xe7, 0x77, 0x3c, 0xdf, 0x65, 0x6e, 0xc7, 0x0f, 0x1d, 0x66, 0xda,
-	0xd8, 0x89, 0xba, 0x9d, 0x58, 0xaa, 0x71, 0x29, 0xb9, 0x3b, 0x59, 0x6b, 0xc9, 0x0e, 0x2d, 0xea,
-	0x2a, 0xdb, 0x43, 0xd7, 0x1d, 0x5a, 0x28, 0x54, 0xfb, 0xe1, 0x8f, 0x1d, 0xb4, 0x3d, 0x36, 0x16,
-	0xfb, 0x94, 0x0f, 0x72, 0x56, 0x0d, 0xd7, 0xb6, 0x5d, 0x27, 0x36, 0x2a, 0x56, 0x62, 0x8b, 0x8a,
-	0xb0, 0xf5, 0xdc, 0x89, 0xdc, 0x11, 0x9e, 0xa2, 0x1f, 0x99, 0x06, 0xea, 0xf8, 0x53, 0x88, 0x01,
-	0x23, 0xb7, 0x41, 0x36, 0x07, 0x4d, 0xa9, 0x25, 0xb5, 0xeb, 0xba, 0x6c, 0x0e, 0xc8, 0x17, 0xb0,
-	0x66, 0x63, 0x10, 0xd0, 0x21, 0x36, 0x2b, 0x2d, 0xa9, 0xdd, 0xe8, 0x7d, 0xa8, 0xe5, 0x00, 0x25,
-	0x26, 0xa3, 0xae, 0x26, 0x8c, 0x25, 0x56, 0xf4, 0x54, 0x47, 0xfd, 0x4d, 0x86, 0xb7, 0x8e, 0x91,
-	0x9d, 0x32, 0xca, 0x32, 0x17, 0xef, 0x01, 0x04, 0xcc, 0xf5, 0xf1, 0xdc, 0xa1, 0x36, 0x26, 0xae,
-	0xea, 0x5c, 0xf2, 0x82, 0xda, 0x48, 0x36, 0xa1, 0x32, 0xc2, 0x71, 0x53, 0xe6, 0xf2, 0x78, 0x49,
-	0x5e, 0x42, 0xc3, 0x70, 0x9d, 0xc0, 0x0c, 0x18, 0x3a, 0xc6, 0x98, 0xe3, 0xb8, 0xdd, 0x7b, 0x34,
-	0x1f, 0x07, 0xf7, 0xf4, 0x8d, 0xc7, 0x4c, 0xd7, 0x09, 0xc4, 0xc7, 0xc1, 0x44, 0x55, 0xcf, 0xdb,
-	0x21, 0x27, 0x70, 0xcb, 0x46, 0x46, 0x07, 0x94, 0xd1, 0x66, 0xb5, 0x55, 0x69, 0x37, 0x7a, 0x9f,
-	0x69, 0x73, 0x93, 0xad, 0x5d, 0x88, 0x40, 0xfb, 0x3a, 0x51, 0x3b, 0x72, 0x98, 0x3f, 0xd6, 0x33,
-	0x2b, 0xca, 0x33, 0xd8, 0x98, 0xfa, 0x2b, 0x8d, 0x45, 0x9a, 0xc4, 0xb2, 0x05, 0xb5, 0x88, 0x5a,
-	0x21, 0x26, 0xf1, 0x89, 0x8f, 0x3d, 0xf9, 0x89, 0xa4, 0xfe, 0x27, 0xc1, 0x9d, 0x63, 0x64, 0xfb,
-	0xa1, 0x35, 0xba, 0x4a, 0xba, 0x08, 0x54, 0x47, 0x38, 0x0e, 0x9a, 0x72, 0xab, 0xd2, 0xae, 0xeb,
-	0x7c, 0x4d, 0x5a, 0xd0, 0xf0, 0xa8, 0x4f, 0x2d, 0x0b, 0x2d, 0x33, 0xb0, 0x79, 0xc2, 0x6a, 0x7a,
-	0x5e, 0x44, 0xce, 0x66, 0x62, 0x7f, 0x52, 0x1c, 0xfb, 0x45, 0x48, 0xcb, 0x89, 0x5f, 0x87, 0xad,
-	0x69, 0x5f, 0x81, 0xe7, 0x3a, 0x01, 0x92, 0x3d, 0xa8, 0x99, 0x0c, 0xed, 0xa0, 0x29, 0x71, 0x9c,
-	0x3b, 0x05, 0x38, 0x33, 0xc5, 0xe7, 0x0c, 0x6d, 0x5d, 0xa8, 0xa8, 0xe7, 0xb0, 0x31, 0x25, 0x9f,
-	0x03, 0x88, 0x40, 0x95, 0x67, 0x21, 0xc6, 0xb3, 0xae, 0xf3, 0x75, 0x2c, 0x43, 0x46, 0x87, 0x3c,
-	0x71, 0x75, 0x9d, 0xaf, 0x63, 0xe0, 0xe8, 0xfb, 0xae, 0xdf, 0xac, 0x0a, 0xe0, 0xfc, 0x43, 0xdd,
-	0x83, 0xcd, 0x09, 0x39, 0x12, 0xc0, 0xa9, 0x45, 0x69, 0x8e, 0x45, 0x79, 0x62, 0x51, 0xfd, 0x5d,
-	0x06, 0x72, 0x88, 0x16, 0x32, 0xbc, 0x59, 0x7b, 0xcc, 0x43, 0xfb, 0x39, 0xac, 0xb9, 0xa2, 0x09,
-	0x38, 0xde, 0x46, 0x4f, 0xbd, 0xbc, 0x5d, 0xf4, 0x54, 0x85, 0x9c, 0xe6, 0xd8, 0x51, 0xe3, 0x59,
-	0xdf, 0x2d, 0xc8, 0xfa, 0x2c, 0xfe, 0xe5, 0x90, 0xe3, 0x0d, 0x6c, 0x9e, 0xd2, 0xe8, 0x4a, 0x89,
-	0xda, 0x85, 0xd5, 0x20, 0xde, 0x2e, 0x5a, 0xa3, 0xd1, 0xbb, 0x5f, 0x92, 0x01, 0xce, 0x99, 0x64,
-	0xbb, 0xfa, 0x0a, 0xee, 0x9c, 0x84, 0x7d, 0xcb, 0x0c, 0x5e, 0x1f, 0x45, 0xe8, 0xb0, 0xd4, 0xdd,
-	0x7d, 0x68, 0x78, 0x61, 0x3f, 0x08, 0xfb, 0x79, 0x7f, 0x20, 0x44, 0xdc, 0xe1, 0x16, 0xd4, 0x98,
-	0xeb, 0x99, 0x46, 0x8a, 0x9e, 0x7f, 0x64, 0x6c, 0xa8, 0x4c, 0xd8, 0xa0, 0xfe, 0x2b, 0xa5, 0xa7,
-	0xef, 0xbe, 0xe9, 0x0c, 0x4c, 0x67, 0x98, 0xfa, 0x20, 0x50, 0xcd, 0x19, 0xe7, 0xeb, 0xb9, 0x04,
-	0x7d, 0x99, 0x2b, 0x50, 0x85, 0x47, 0xf7, 0xb4, 0xa0, 0x40, 0xf3, 0xdc, 0x14, 0x95, 0x88, 0xdc,
-	0x83, 0xba, 0xeb, 0xa1, 0x4f, 0x63, 0x16, 0x24, 0x3c, 0x9f, 0x08, 0x6e, 0x56, 0xc0, 0xbf, 0x24,
-	0xb8, 0x7b, 0x01, 0x4b, 0x49, 0xbb, 0x7c, 0x97, 0x8b, 0x4f, 0x54, 0x6f, 0x6f, 0xb1, 0xf8, 0x84,
-	0xcd, 0xe5, 0x70, 0xf0, 0x6f, 0x49, 0x34, 0x3b, 0x1a, 0x3e, 0xb2, 0x6b, 0x77, 0xeb, 0xb7, 0x33,
-	0xa5, 0x7b, 0x5c, 0x32, 0x75, 0xf2, 0xbe, 0x96, 0x13, 0xd5, 0xaf, 0x12, 0xbc, 0x9d, 0xf3, 0x94,
-	0x14, 0xe5, 0xab, 0xac, 0x28, 0x31, 0xc2, 0xde, 0xe5, 0x08, 0x93, 0xc4, 0x1f, 0x66, 0xf0, 0xb8,
-	0xbe, 0xb2, 0x0b, 0xf5, 0xc3, 0x6b, 0xc1, 0xfa, 0x05, 0xb6, 0xcf, 0x7c, 0xea, 0x04, 0xd4, 0x88,
-	0xb9, 0x47, 0xad, 0xe4, 0xa0, 0x4a, 0xb8, 0x48, 0x76, 0x60, 0x23, 0x23, 0xe6, 0xd9, 0xd8, 0x4b,
-	0x33, 0x3f, 0x2d, 0x24, 0x4f, 0x61, 0xcd, 0x17, 0xb9, 0xe3, 0x0e, 0x16, 0x38, 0x03, 0xd2, 0xfd,
-	0xea, 0x9f, 0x32, 0xbc, 0x7f, 0xf4, 0x33, 0x1a, 0x61, 0x72, 0xba, 0xe5, 0xc0, 0xa4, 0xa5, 0xbf,
-	0x07, 0x93, 0x42, 0xcf, 0x56, 0x5e, 0x07, 0xc8, 0xc0, 0xa4, 0x47, 0x50, 0x51, 0x1e, 0x4b, 0x22,
-	0xd5, 0x73, 0x56, 0xc8, 0xf9, 0x0c, 0x77, 0x0e, 0x0a, 0x2c, 0x96, 0x43, 0x5f, 0x0a, 0x93, 0x7a,
-	0xff, 0xac, 0x42, 0xf5, 0x90, 0x7a, 0x3e, 0x19, 0xc0, 0xc6, 0xd4, 0xdd, 0x92, 0x3c, 0x2c, 0x6d,
-	0xde, 0xe9, 0x1b, 0xa8, 0xb2, 0x53, 0x7e, 0xc1, 0x14, 0x44, 0x53, 0x57, 0xc8, 0x0f, 0x70, 0x2b,
-	0x1d, 0xbd, 0xe4, 0xa3, 0xc5, 0x2e, 0x6e, 0xca, 0x83, 0x4b, 0xf7, 0x65, 0xe6, 0x4d, 0x58, 0xcf,
-	0x5f, 0x47, 0xc8, 0x27, 0x8b, 0xdf, 0x8f, 0x94, 0x87, 0x0b, 0xed, 0xcd, 0x5c, 0xbd, 0x80, 0x7a,
-	0x36, 0xdc, 0x48, 0x11, 0xc4, 0x8b, 0xe3, 0x4f, 0x79, 0x47, 0x13, 0x4f, 0x00, 0x2d, 0x7d, 0x02,
-	0x68, 0x47, 0xf1, 0x13, 0x40, 0x5d, 0x21, 0x3a, 0x34, 0x72, 0x73, 0x99, 0x7c, 0xbc, 0xf0, 0xec,
-	0x2e, 0xb1, 0xf9, 0x06, 0xde, 0x2d, 0xe0, 0x14, 0x79, 0x7c, 0x2d, 0x0e, 0x96, 0xf8, 0x3a, 0x83,
-	0xf5, 0xfc, 0x00, 0x2e, 0x4c, 0xfd, 0x9c, 0x29, 0x5d, 0x62, 0xd5, 0x4a, 0x59, 0x99, 0x0c, 0x8b,
-	0x4b, 0x58, 0x39, 0x3d, 0x32, 0x95, 0x4f, 0xaf, 0x32, 0x7f, 0xd4, 0x15, 0xf2, 0x0a, 0xea, 0xd9,
-	0xe9, 0x48, 0x1e, 0x2c, 0x78, 0xc2, 0x2b, 0xed, 0x45, 0x0f, 0x5a, 0x75, 0x65, 0xdf, 0x04, 0x30,
-	0x5d, 0xb1, 0x3f, 0xea, 0xee, 0x43, 0xdc, 0x79, 0x27, 0xb1, 0x5e, 0xf0, 0x7d, 0x77, 0x68, 0xb2,
-	0xd7, 0x61, 0x3f, 0x6e, 0x1e, 0xfe, 0x92, 0x14, 0x3f, 0xde, 0x68, 0x38, 0xf3, 0xd0, 0x7c, 0x96,
-	0x2c, 0xff, 0x90, 0xb7, 0x63, 0x7d, 0xed, 0xc0, 0x32, 0xd1, 0x61, 0xda, 0x97, 0x21, 0x73, 0x87,
-	0xe8, 0x68, 0xc7, 0xbe, 0x67, 0x68, 0x51, 0xb7, 0xbf, 0xca, 0xf5, 0x1e, 0xfd, 0x1f, 0x00, 0x00,
-	0xff, 0xff, 0xda, 0x7d, 0xc6, 0xc8, 0xae, 0x0e, 0x00, 0x00,
+	// 1547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x8e, 0xec, 0xfc, 0xd4, 0xc7, 0x71, 0x9a, 0x6c, 0x92, 0xd6, 0xa3, 0x14, 0x1a, 0x44, 0xa1,
+	0xee, 0x0f, 0x0a, 0x76, 0xe9, 0x5f, 0x4a, 0x07, 0x9a, 0x1f, 0x4a, 0x19, 0x68, 0x83, 0x9c, 0x76,
+	0x18, 0xfe, 0x5c, 0x59, 0xde, 0xba, 0x6a, 0xac, 0x1f, 0xb4, 0x2b, 0x4f, 0x73, 0xc3, 0x05, 0xcf,
+	0xc0, 0x15, 0x4f, 0x00, 0x5c, 0x71, 0xc1, 0x03, 0x30, 0xc3, 0x2d, 0x77, 0x7d, 0x02, 0xde, 0x80,
+	0x07, 0x80, 0x19, 0x46, 0xbb, 0x2b, 0x59, 0xb2, 0x2d, 0xd9, 0x4e, 0x26, 0x37, 0x9e, 0xdd, 0xf5,
+	0x9e, 0x73, 0xbe, 0xf3, 0xed, 0xd9, 0xa3, 0x73, 0x16, 0xd6, 0x5b, 0xba, 0xeb, 0x6d, 0xb8, 0x9e,
+	0x43, 0x9d, 0x0d, 0xcf, 0xb7, 0xa9, 0x69, 0xe1, 0x8d, 0x6e, 0x75, 0x23, 0x58, 0x55, 0xd9, 0x2a,
+	0x5a, 0xed, 0x8d, 0x55, 0xb1, 0x43, 0xed, 0x56, 0xe5, 0xb5, 0xb6, 0xe3, 0xb4, 0x3b, 0x98, 0x8b,
+	0x36, 0xfd, 0x67, 0x1b, 0xd8, 0x72, 0xe9, 0x21, 0xdf, 0x27, 0xbf, 0x11, 0xd3, 0x6a, 0x38, 0x96,
+	0xe5, 0xd8, 0x81, 0x52, 0x3e, 0xe2, 0x5b, 0x14, 0x0c, 0x2b, 0x0f, 0xec, 0xae, 0x73, 0x80, 0xeb,
+	0xd8, 0xeb, 0x9a, 0x06, 0xd6, 0xf0, 0x77, 0x3e, 0x26, 0x14, 0x2d, 0x40, 0xce, 0x6c, 0x95, 0xa5,
+	0x75, 0xa9, 0x52, 0xd0, 0x72, 0x66, 0x0b, 0xdd, 0x85, 0x39, 0x0b, 0x13, 0xa2, 0xb7, 0x71, 0x39,
+	0xbf, 0x2e, 0x55, 0x8a, 0xb5, 0x37, 0xd5, 0x18, 0x20, 0xa1, 0xb2, 0x5b, 0x55, 0xb9, 0x32, 0xa1,
+	0x45, 0x0b, 0x65, 0x94, 0x9f, 0x73, 0x70, 0xfa, 0x3e, 0xa6, 0x75, 0xaa, 0xd3, 0xc8, 0xc4, 0x6b,
+	0x00, 0x84, 0x3a, 0x1e, 0x6e, 0xd8, 0xba, 0x85, 0x85, 0xa9, 0x02, 0x5b, 0x79, 0xa8, 0x5b, 0x18,
+	0x2d, 0x42, 0xfe, 0x00, 0x1f, 0x96, 0x73, 0x6c, 0x3d, 0x18, 0xa2, 0xc7, 0x50, 0x34, 0x1c, 0x9b,
+	0x98, 0x84, 0x62, 0xdb, 0x38, 0x64, 0x38, 0x16, 0x6a, 0xd7, 0x86, 0xe3, 0x60, 0x96, 0x1e, 0xb9,
+	0xd4, 0x74, 0x6c, 0xc2, 0x27, 0xdb, 0x3d, 0x51, 0x2d, 0xae, 0x07, 0xed, 0xc1, 0x29, 0x0b, 0x53,
+	0xbd, 0xa5, 0x53, 0xbd, 0x3c, 0xbd, 0x9e, 0xaf, 0x14, 0x6b, 0xef, 0xa9, 0x43, 0xc9, 0x56, 0xfb,
+	0x3c, 0x50, 0x3f, 0x13, 0x62, 0xbb, 0x36, 0xf5, 0x0e, 0xb5, 0x48, 0x8b, 0x7c, 0x07, 0x4a, 0x89,
+	0xbf, 0x42, 0x5f, 0xa4, 0x9e, 0x2f, 0x2b, 0x30, 0xd3, 0xd5, 0x3b, 0x3e, 0x16, 0xfe, 0xf1, 0xc9,
+	0x66, 0xee, 0x96, 0xa4, 0xfc, 0x27, 0xc1, 0xf2, 0x7d, 0x4c, 0xb7, 0xfc, 0xce, 0xc1, 0x24, 0x74,
+	0x21, 0x98, 0x3e, 0xc0, 0x87, 0xa4, 0x9c, 0x5b, 0xcf, 0x57, 0x0a, 0x1a, 0x1b, 0xa3, 0x75, 0x28,
+	0xba, 0xba, 0xa7, 0x77, 0x3a, 0xb8, 0x63, 0x12, 0x8b, 0x11, 0x36, 0xa3, 0xc5, 0x97, 0xd0, 0xfe,
+	0x80, 0xef, 0xb7, 0xd2, 0x7d, 0xef, 0x87, 0x74, 0x32, 0xfe, 0x6b, 0xb0, 0x92, 0xb4, 0x45, 0x5c,
+	0xc7, 0x26, 0x18, 0x6d, 0xc2, 0x8c, 0x49, 0xb1, 0x45, 0xca, 0x12, 0xc3, 0x79, 0x21, 0x05, 0x67,
+	0x24, 0xf8, 0x80, 0x62, 0x4b, 0xe3, 0x22, 0x4a, 0x03, 0x4a, 0x89, 0xf5, 0x21, 0x80, 0x10, 0x4c,
+	0x33, 0x16, 0x02, 0x3c, 0xf3, 0x1a, 0x1b, 0x07, 0x6b, 0x98, 0xea, 0x6d, 0x46, 0x5c, 0x41, 0x63,
+	0xe3, 0x00, 0x38, 0xf6, 0x3c, 0xc7, 0x2b, 0x4f, 0x73, 0xe0, 0x6c, 0xa2, 0x6c, 0xc2, 0x62, 0x2f,
+	0x38, 0x04, 0xe0, 0x50, 0xa3, 0x34, 0x44, 0x63, 0xae, 0xa7, 0x51, 0xf9, 0x25, 0x07, 0x68, 0x07,
+	0x77, 0x30, 0xc5, 0xc7, 0xbb, 0x1e, 0xc3, 0xd0, 0xbe, 0x0f, 0x73, 0x0e, 0xbf, 0x04, 0x0c, 0x6f,
+	0xb1, 0xa6, 0x8c, 0xbe, 0x2e, 0x5a, 0x28, 0x82, 0xea, 0xb1, 0xe8, 0x98, 0x61, 0xac, 0xdf, 0x4c,
+	0x61, 0x7d, 0x10, 0xff, 0xc9, 0x04, 0xc7, 0x0b, 0x58, 0xac, 0xeb, 0xdd, 0x89, 0x88, 0xba, 0x09,
+	0xb3, 0x24, 0xd8, 0xce, 0xaf, 0x46, 0xb1, 0x76, 0x3e, 0x83, 0x01, 0x16, 0x33, 0x62, 0xbb, 0xf2,
+	0x14, 0x96, 0xf7, 0xfc, 0x66, 0xc7, 0x24, 0xcf, 0x77, 0xbb, 0xd8, 0xa6, 0xa1, 0xb9, 0xf3, 0x50,
+	0x74, 0xfd, 0x26, 0xf1, 0x9b, 0x71, 0x7b, 0xc0, 0x97, 0x98, 0xc1, 0x15, 0x98, 0xa1, 0x8e, 0x6b,
+	0x1a, 0x21, 0x7a, 0x36, 0x89, 0xa2, 0x21, 0xdf, 0x8b, 0x06, 0xe5, 0x1f, 0x29, 0xcc, 0xbe, 0x5b,
+	0xa6, 0xdd, 0x32, 0xed, 0x76, 0x68, 0x03, 0xc1, 0x74, 0x4c, 0x39, 0x1b, 0x0f, 0x0d, 0xd0, 0xc7,
+	0xb1, 0x03, 0xca, 0x33, 0xef, 0x6e, 0xa7, 0x1c, 0xd0, 0x30, 0x33, 0x69, 0x47, 0x84, 0xce, 0x41,
+	0xc1, 0x71, 0xb1, 0xa7, 0x07, 0x51, 0x20, 0xe2, 0xbc, 0xb7, 0x70, 0xbc, 0x03, 0xfc, 0x43, 0x82,
+	0xd5, 0x3e, 0x2c, 0x19, 0xd7, 0xe5, 0x49, 0xcc, 0x3f, 0x7e, 0x7a, 0x9b, 0xe3, 0xf9, 0xc7, 0x75,
+	0x9e, 0x4c, 0x0c, 0xfe, 0x2b, 0x81, 0x9c, 0x30, 0x57, 0xa7, 0x1e, 0xd6, 0xad, 0xac, 0xb3, 0xfb,
+	0x6a, 0xc0, 0x8f, 0x0f, 0xc6, 0xf1, 0x23, 0xa1, 0x78, 0xbc, 0xd3, 0xca, 0xf7, 0x9d, 0x56, 0x44,
+	0xeb, 0x74, 0x8f, 0xd6, 0xe3, 0xb9, 0xff, 0x97, 0x04, 0x6b, 0x43, 0x51, 0x8a, 0x73, 0xfc, 0x3a,
+	0xe6, 0x2b, 0x4f, 0xd5, 0x1f, 0x4e, 0xe2, 0x6b, 0xf6, 0xc9, 0x0d, 0xbb, 0x05, 0xc7, 0x73, 0xe7,
+	0x95, 0xc4, 0x53, 0x37, 0x36, 0x3c, 0x4c, 0x8f, 0x9c, 0x7b, 0x3f, 0x1f, 0xb8, 0x88, 0xd7, 0x33,
+	0x6a, 0x88, 0xb8, 0xad, 0x93, 0x89, 0xd1, 0x1f, 0x25, 0x58, 0x8a, 0x59, 0x12, 0x47, 0xf3, 0x51,
+	0x74, 0xc5, 0x02, 0x84, 0xb5, 0xd1, 0x08, 0xc5, 0x61, 0xec, 0x44, 0xf0, 0x38, 0xe1, 0x37, 0xa1,
+	0xb0, 0x73, 0x24, 0x58, 0x7f, 0x4b, 0xbd, 0x8f, 0xfb, 0x24, 0x84, 0x3f, 0x1e, 0xb8, 0x3f, 0xb7,
+	0x47, 0x94, 0x29, 0xe3, 0x50, 0x8c, 0xce, 0xc0, 0xac, 0xeb, 0xe1, 0x67, 0xe6, 0x4b, 0x71, 0x6d,
+	0xc4, 0xec, 0x78, 0xd4, 0xff, 0x24, 0xc1, 0x42, 0x1f, 0xef, 0x9f, 0xc2, 0x1c, 0x61, 0x2b, 0x64,
+	0x04, 0xf5, 0x7d, 0xbc, 0xf3, 0x29, 0xe1, 0xb0, 0x43, 0x15, 0xf2, 0x26, 0xcc, 0xc7, 0xff, 0x98,
+	0x08, 0xdc, 0x9f, 0x12, 0xac, 0xf6, 0x51, 0x24, 0x30, 0x7e, 0x92, 0x88, 0x8d, 0x1b, 0xe3, 0xd1,
+	0x9b, 0x12, 0x1f, 0xdf, 0x66, 0xc7, 0xc7, 0x9d, 0x38, 0xbc, 0x62, 0xed, 0xad, 0xb1, 0xc8, 0x88,
+	0x7b, 0xf1, 0x3d, 0xac, 0xed, 0x7b, 0xba, 0x4d, 0x74, 0x23, 0x48, 0x71, 0x7a, 0x47, 0x54, 0x2f,
+	0x61, 0xca, 0xbb, 0x00, 0xa5, 0x28, 0xff, 0xed, 0x1f, 0xba, 0x61, 0x3c, 0x25, 0x17, 0xd1, 0x6d,
+	0x98, 0xf3, 0x78, 0x7c, 0x08, 0x1c, 0x23, 0x0b, 0x83, 0x70, 0xbf, 0xf2, 0x5b, 0x0e, 0x5e, 0xdf,
+	0x7d, 0x89, 0x0d, 0x5f, 0x94, 0x3c, 0x31, 0x30, 0x61, 0x40, 0x9f, 0x83, 0x5e, 0xf8, 0x0e, 0xc6,
+	0xb3, 0x06, 0x10, 0x81, 0x09, 0xeb, 0x92, 0xb4, 0x98, 0xc8, 0xf0, 0x54, 0x8b, 0x69, 0x41, 0x8d,
+	0x81, 0x14, 0xb4, 0x9d, 0xa2, 0x31, 0x1b, 0xfa, 0xc9, 0x24, 0xa4, 0x57, 0x79, 0xd6, 0xd5, 0x84,
+	0x0a, 0xa2, 0xb0, 0xeb, 0xef, 0x33, 0x2f, 0xc2, 0x69, 0x81, 0xb4, 0xd1, 0xc5, 0x1e, 0x09, 0x3e,
+	0x69, 0x5c, 0xd7, 0x82, 0x58, 0x7e, 0xc2, 0x57, 0xd1, 0x3b, 0x80, 0x0c, 0xc7, 0x7e, 0x66, 0xb6,
+	0x7d, 0x4e, 0x00, 0xcf, 0x1c, 0xfc, 0x1e, 0x2f, 0x25, 0xfe, 0x61, 0x8c, 0x7f, 0x01, 0xcb, 0x81,
+	0x97, 0x5d, 0xdc, 0xd0, 0x0d, 0xea, 0x78, 0xa4, 0x61, 0x38, 0xbe, 0x4d, 0x45, 0xcf, 0x53, 0x49,
+	0x21, 0xea, 0x1e, 0x93, 0xb8, 0xc7, 0x04, 0xb6, 0x83, 0xfd, 0xda, 0x92, 0xde, 0xbf, 0x84, 0x3e,
+	0x86, 0x12, 0x53, 0xd9, 0x10, 0x82, 0xe5, 0x99, 0xc1, 0xfe, 0x38, 0xa9, 0xd3, 0xf1, 0x34, 0x3e,
+	0xd7, 0xe6, 0xf5, 0xd8, 0x0c, 0x35, 0x60, 0xd5, 0xc3, 0xed, 0xa0, 0x2d, 0xf5, 0x70, 0xab, 0x61,
+	0x38, 0x96, 0xeb, 0xd8, 0xd8, 0xa6, 0xa4, 0x3c, 0xcb, 0x50, 0x5e, 0x4e, 0xd1, 0xa8, 0x45, 0x32,
+	0xdb, 0xa1, 0x88, 0xb6, 0xe2, 0x0d, 0x2e, 0x12, 0xf4, 0x08, 0x4a, 0xc4, 0x6f, 0x12, 0xc3, 0x33,
+	0x45, 0x4f, 0x30, 0xc7, 0x14, 0x5f, 0x4a, 0x51, 0xbc, 0xc7, 0x6a, 0xda, 0x7a, 0x4c, 0x42, 0x4b,
+	0xca, 0x2b, 0x77, 0x61, 0x69, 0x80, 0xa3, 0xe0, 0x13, 0x4d, 0x7b, 0xb7, 0x8e, 0x8d, 0x83, 0xc0,
+	0xe0, 0x84, 0xe7, 0x58, 0x0f, 0xca, 0x27, 0x0a, 0x85, 0xf9, 0x38, 0x1d, 0xe8, 0x2a, 0xa0, 0xe7,
+	0x0e, 0xa1, 0xb8, 0xc5, 0x0f, 0xa9, 0x11, 0x88, 0xf2, 0x94, 0x59, 0xd0, 0x16, 0xf9, 0x3f, 0x6c,
+	0x7f, 0x70, 0x7f, 0x09, 0xba, 0x01, 0x67, 0xdd, 0x8e, 0x6e, 0x60, 0x0b, 0xdb, 0xb4, 0x41, 0xf5,
+	0x66, 0xa7, 0x3f, 0x64, 0x56, 0xa3, 0xbf, 0xf7, 0x83, 0x7f, 0x45, 0xe4, 0x28, 0x0e, 0x2c, 0x0f,
+	0xa1, 0x2c, 0xad, 0xe6, 0x66, 0xae, 0xe4, 0x62, 0xae, 0x94, 0x61, 0x2e, 0x34, 0xc3, 0xa3, 0x2d,
+	0x9c, 0x06, 0x9f, 0x13, 0x42, 0x75, 0xea, 0x13, 0x51, 0x33, 0x8b, 0x99, 0xa2, 0x03, 0x1a, 0xa4,
+	0xf2, 0xa8, 0x7d, 0xc4, 0x0a, 0xcc, 0x78, 0x8e, 0x4f, 0xc3, 0x50, 0xe7, 0x93, 0xda, 0xef, 0x05,
+	0x98, 0xde, 0xd1, 0x5d, 0x0f, 0xb5, 0xa0, 0x94, 0x78, 0xcf, 0x41, 0x57, 0x32, 0x8b, 0xaf, 0xe4,
+	0xab, 0x8f, 0x7c, 0x21, 0xfb, 0x51, 0x87, 0xdf, 0x59, 0x65, 0x0a, 0x7d, 0x03, 0xa7, 0xc2, 0x76,
+	0x17, 0xbd, 0x3d, 0xde, 0x63, 0x89, 0x7c, 0x71, 0xe4, 0xbe, 0x48, 0xbd, 0x09, 0xf3, 0xf1, 0x27,
+	0x00, 0x74, 0x79, 0xfc, 0x37, 0x09, 0xf9, 0xca, 0x58, 0x7b, 0x23, 0x53, 0x0f, 0xa1, 0x10, 0x35,
+	0x94, 0x28, 0x0d, 0x62, 0x7f, 0xcb, 0x29, 0x9f, 0x51, 0xf9, 0xb3, 0x9b, 0x1a, 0x3e, 0xbb, 0xa9,
+	0xbb, 0xc1, 0xb3, 0x9b, 0x32, 0x85, 0x34, 0x28, 0xc6, 0x7a, 0x61, 0x74, 0x69, 0xec, 0x7e, 0x39,
+	0x43, 0xe7, 0x0b, 0x38, 0x9b, 0x92, 0xb2, 0xd1, 0xf5, 0x23, 0xa5, 0xf8, 0x0c, 0x5b, 0xfb, 0x30,
+	0x1f, 0x6f, 0x7a, 0x53, 0xa9, 0x1f, 0xd2, 0x19, 0x67, 0x68, 0xed, 0x40, 0x29, 0x51, 0xec, 0x8f,
+	0x88, 0xca, 0x64, 0x9b, 0x2a, 0x5f, 0x9d, 0xa4, 0xe7, 0x53, 0xa6, 0xd0, 0x0f, 0x12, 0x2c, 0x0f,
+	0xe9, 0x2d, 0x50, 0x75, 0xe2, 0x9e, 0x4b, 0xae, 0x4d, 0xde, 0xba, 0x28, 0x53, 0x15, 0xe9, 0x5d,
+	0x09, 0x3d, 0x85, 0x42, 0x54, 0x48, 0xa3, 0x8b, 0x63, 0x36, 0x03, 0x72, 0x65, 0xdc, 0x9a, 0x9c,
+	0x93, 0x9a, 0x28, 0xc7, 0xd0, 0x95, 0x09, 0x6a, 0x62, 0xf9, 0xea, 0x78, 0x9b, 0x23, 0x6b, 0x75,
+	0x28, 0xc6, 0xbe, 0xdf, 0x28, 0xe5, 0xac, 0xe5, 0x8c, 0xab, 0xda, 0xff, 0xed, 0x57, 0xa6, 0xb6,
+	0x4c, 0x00, 0xd3, 0xe1, 0x12, 0xdd, 0xea, 0x16, 0x04, 0x19, 0x6c, 0x2f, 0x90, 0x24, 0x5f, 0x56,
+	0xdb, 0x26, 0x7d, 0xee, 0x37, 0x83, 0x24, 0xc4, 0x5e, 0xc1, 0xf9, 0x8f, 0x7b, 0xd0, 0x1e, 0x78,
+	0x24, 0xbf, 0x23, 0x86, 0xbf, 0xe6, 0xd6, 0x02, 0x79, 0x75, 0xbb, 0x63, 0x62, 0x9b, 0xaa, 0xf7,
+	0x7c, 0xea, 0xb4, 0xb1, 0xad, 0xde, 0xf7, 0x5c, 0x43, 0xed, 0x56, 0x9b, 0xb3, 0x4c, 0xee, 0xda,
+	0xff, 0x03, 0x00, 0xe1, 0x3f, 0xc5, 0x77, 0x6a, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1021,8 +1640,14 @@ type DaprClient interface {
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
+	// Invokes output bindings with payloads streamed in chunks
+	InvokeBindingStream(ctx context.Context, opts ...grpc.CallOption) (Dapr_InvokeBindingStreamClient, error)
 	// Gets secrets from secret stores.
 	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+	// Gets all secrets exposed by a secret store.
+	GetBulkSecret(ctx context.Context, in *GetBulkSecretRequest, opts ...grpc.CallOption) (*GetBulkSecretResponse, error)
+	// Gets metadata describing the running sidecar.
+	GetMetadata(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetMetadataResponse, error)
 }
 
 type daprClient struct {
@@ -1105,6 +1730,37 @@ func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest
 	return out, nil
 }
 
+func (c *daprClient) InvokeBindingStream(ctx context.Context, opts ...grpc.CallOption) (Dapr_InvokeBindingStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dapr_serviceDesc.Streams[0], "/dapr.proto.runtime.v1.Dapr/InvokeBindingStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprInvokeBindingStreamClient{stream}
+	return x, nil
+}
+
+type Dapr_InvokeBindingStreamClient interface {
+	Send(*InvokeBindingStreamRequest) error
+	Recv() (*InvokeBindingStreamResponse, error)
+	grpc.ClientStream
+}
+
+type daprInvokeBindingStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *daprInvokeBindingStreamClient) Send(m *InvokeBindingStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daprInvokeBindingStreamClient) Recv() (*InvokeBindingStreamResponse, error) {
+	m := new(InvokeBindingStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daprClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
 	out := new(GetSecretResponse)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/GetSecret", in, out, opts...)
@@ -1114,6 +1770,24 @@ func (c *daprClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts .
 	return out, nil
 }
 
+func (c *daprClient) GetBulkSecret(ctx context.Context, in *GetBulkSecretRequest, opts ...grpc.CallOption) (*GetBulkSecretResponse, error) {
+	out := new(GetBulkSecretResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/GetBulkSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetMetadata(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaprServer is the server API for Dapr service.
 type DaprServer interface {
 	// Invokes a method on a remote Dapr app.
@@ -1132,8 +1806,14 @@ type DaprServer interface {
 	PublishEvent(context.Context, *PublishEventRequest) (*empty.Empty, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
+	// Invokes output bindings with payloads streamed in chunks
+	InvokeBindingStream(Dapr_InvokeBindingStreamServer) error
 	// Gets secrets from secret stores.
 	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	// Gets all secrets exposed by a secret store.
+	GetBulkSecret(context.Context, *GetBulkSecretRequest) (*GetBulkSecretResponse, error)
+	// Gets metadata describing the running sidecar.
+	GetMetadata(context.Context, *empty.Empty) (*GetMetadataResponse, error)
 }
 
 // UnimplementedDaprServer can be embedded to have forward compatible implementations.
@@ -1164,9 +1844,18 @@ func (*UnimplementedDaprServer) PublishEvent(ctx context.Context, req *PublishEv
 func (*UnimplementedDaprServer) InvokeBinding(ctx context.Context, req *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
+func (*UnimplementedDaprServer) InvokeBindingStream(srv Dapr_InvokeBindingStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeBindingStream not implemented")
+}
 func (*UnimplementedDaprServer) GetSecret(ctx context.Context, req *GetSecretRequest) (*GetSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
 }
+func (*UnimplementedDaprServer) GetBulkSecret(ctx context.Context, req *GetBulkSecretRequest) (*GetBulkSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkSecret not implemented")
+}
+func (*UnimplementedDaprServer) GetMetadata(ctx context.Context, req *empty.Empty) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
 
 func RegisterDaprServer(s *grpc.Server, srv DaprServer) {
 	s.RegisterService(&_Dapr_serviceDesc, srv)
@@ -1316,6 +2005,32 @@ func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_InvokeBindingStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaprServer).InvokeBindingStream(&daprInvokeBindingStreamServer{stream})
+}
+
+type Dapr_InvokeBindingStreamServer interface {
+	Send(*InvokeBindingStreamResponse) error
+	Recv() (*InvokeBindingStreamRequest, error)
+	grpc.ServerStream
+}
+
+type daprInvokeBindingStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *daprInvokeBindingStreamServer) Send(m *InvokeBindingStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daprInvokeBindingStreamServer) Recv() (*InvokeBindingStreamRequest, error) {
+	m := new(InvokeBindingStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Dapr_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSecretRequest)
 	if err := dec(in); err != nil {
@@ -1334,6 +2049,42 @@ func _Dapr_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_GetBulkSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetBulkSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/GetBulkSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetBulkSecret(ctx, req.(*GetBulkSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetMetadata(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dapr_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dapr.proto.runtime.v1.Dapr",
 	HandlerType: (*DaprServer)(nil),
@@ -1374,7 +2125,22 @@ var _Dapr_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSecret",
 			Handler:    _Dapr_GetSecret_Handler,
 		},
+		{
+			MethodName: "GetBulkSecret",
+			Handler:    _Dapr_GetBulkSecret_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _Dapr_GetMetadata_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InvokeBindingStream",
+			Handler:       _Dapr_InvokeBindingStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dapr/proto/runtime/v1/dapr.proto",
 }
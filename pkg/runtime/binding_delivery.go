@@ -0,0 +1,182 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/dapr/pkg/concurrency"
+)
+
+const (
+	// input binding delivery settings, read from the binding component metadata
+	bindingDeliveryMaxConcurrency = "deliveryMaxConcurrency"
+	bindingDeliveryMaxRetries     = "deliveryMaxRetries"
+	bindingDeliveryRetryInterval  = "deliveryRetryInterval"
+	bindingDeliveryAsync          = "deliveryAsync"
+	bindingDeadLetter             = "deadLetterBinding"
+
+	defaultBindingDeliveryRetryInterval = time.Second
+	maxBindingDeliveryRetryInterval     = time.Minute
+
+	// metadata added to events sent to a dead-letter binding
+	deadLetterSourceBinding = "sourceBinding"
+	deadLetterDeliveryError = "deliveryError"
+)
+
+// bindingDeliveryPolicy controls how events read from an input binding are delivered to the app.
+type bindingDeliveryPolicy struct {
+	// limiter bounds the number of events delivered to the app at the same time. nil means no limit.
+	limiter *concurrency.Limiter
+	// async acknowledges events to the binding as soon as a delivery slot is available, so bindings that read
+	// serially can have up to the concurrency limit of events in flight. Requires a concurrency limit.
+	async         bool
+	maxRetries    int
+	retryInterval time.Duration
+	// deadLetterBinding is the output binding events are sent to once all retries are exhausted.
+	deadLetterBinding string
+}
+
+func newBindingDeliveryPolicy(name string, props map[string]string) *bindingDeliveryPolicy {
+	policy := &bindingDeliveryPolicy{
+		retryInterval:     defaultBindingDeliveryRetryInterval,
+		deadLetterBinding: props[bindingDeadLetter],
+	}
+
+	if val := props[bindingDeliveryMaxConcurrency]; val != "" {
+		if limit, err := strconv.Atoi(val); err != nil || limit <= 0 {
+			log.Warnf("invalid %s %q for input binding %s, ignoring", bindingDeliveryMaxConcurrency, val, name)
+		} else {
+			policy.limiter = concurrency.NewLimiter(limit)
+		}
+	}
+
+	if val := props[bindingDeliveryAsync]; val != "" {
+		if async, err := strconv.ParseBool(val); err != nil {
+			log.Warnf("invalid %s %q for input binding %s, ignoring", bindingDeliveryAsync, val, name)
+		} else if async && policy.limiter == nil {
+			log.Warnf("%s requires %s for input binding %s, ignoring", bindingDeliveryAsync, bindingDeliveryMaxConcurrency, name)
+		} else {
+			policy.async = async
+		}
+	}
+
+	if val := props[bindingDeliveryMaxRetries]; val != "" {
+		if retries, err := strconv.Atoi(val); err != nil || retries < 0 {
+			log.Warnf("invalid %s %q for input binding %s, ignoring", bindingDeliveryMaxRetries, val, name)
+		} else {
+			policy.maxRetries = retries
+		}
+	}
+
+	if val := props[bindingDeliveryRetryInterval]; val != "" {
+		if interval, err := time.ParseDuration(val); err != nil || interval <= 0 {
+			log.Warnf("invalid %s %q for input binding %s, ignoring", bindingDeliveryRetryInterval, val, name)
+		} else {
+			policy.retryInterval = interval
+		}
+	}
+
+	return policy
+}
+
+// setBindingDeliveryPolicy sets the delivery policy of an input binding, replacing the policy of a re-initialized binding
+func (a *DaprRuntime) setBindingDeliveryPolicy(name string, policy *bindingDeliveryPolicy) {
+	a.inputBindingDeliveryPoliciesLock.Lock()
+	defer a.inputBindingDeliveryPoliciesLock.Unlock()
+	a.inputBindingDeliveryPolicies[name] = policy
+}
+
+// getBindingDeliveryPolicy returns the current delivery policy of an input binding
+func (a *DaprRuntime) getBindingDeliveryPolicy(name string) *bindingDeliveryPolicy {
+	a.inputBindingDeliveryPoliciesLock.RLock()
+	defer a.inputBindingDeliveryPoliciesLock.RUnlock()
+	if policy, ok := a.inputBindingDeliveryPolicies[name]; ok {
+		return policy
+	}
+	return &bindingDeliveryPolicy{}
+}
+
+// deliverBindingEvent sends an input binding event to the app according to the binding's delivery policy.
+// When a concurrency limit is set, the call blocks until a delivery slot is available, applying backpressure
+// to the binding. It returns the delivery result so the binding can acknowledge or reject the event, unless
+// the policy is async, in which case the event is acknowledged once its delivery has started.
+func (a *DaprRuntime) deliverBindingEvent(name string, resp *bindings.ReadResponse, policy *bindingDeliveryPolicy) error {
+	if policy.limiter == nil {
+		return a.sendBindingEventWithRetry(name, resp, policy)
+	}
+
+	if policy.async {
+		policy.limiter.Execute(func(param interface{}) {
+			if err := a.sendBindingEventWithRetry(name, param.(*bindings.ReadResponse), policy); err != nil {
+				log.Errorf("error delivering event from input binding %s: %s", name, err)
+			}
+		}, resp)
+		return nil
+	}
+
+	done := make(chan error, 1)
+	policy.limiter.Execute(func(param interface{}) {
+		done <- a.sendBindingEventWithRetry(name, param.(*bindings.ReadResponse), policy)
+	}, resp)
+	return <-done
+}
+
+// sendBindingEventWithRetry retries failed deliveries with an exponential backoff, doubling the retry interval
+// after each attempt up to maxBindingDeliveryRetryInterval, and forwards the event to the dead-letter binding,
+// if any, once all retries are exhausted. Retries stop when the runtime shuts down.
+func (a *DaprRuntime) sendBindingEventWithRetry(name string, resp *bindings.ReadResponse, policy *bindingDeliveryPolicy) error {
+	interval := policy.retryInterval
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = a.sendBindingEventToApp(name, resp.Data, resp.Metadata)
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.maxRetries {
+			break
+		}
+
+		log.Debugf("error from app consumer for binding [%s], retrying in %s: %s", name, interval, err)
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-a.ctx.Done():
+			timer.Stop()
+			return err
+		}
+		if interval *= 2; interval > maxBindingDeliveryRetryInterval {
+			interval = maxBindingDeliveryRetryInterval
+		}
+	}
+
+	if policy.deadLetterBinding == "" {
+		return err
+	}
+
+	metadata := make(map[string]string, len(resp.Metadata)+2)
+	for k, v := range resp.Metadata {
+		metadata[k] = v
+	}
+	metadata[deadLetterSourceBinding] = name
+	metadata[deadLetterDeliveryError] = err.Error()
+
+	// the dead-letter binding is invoked on behalf of the app, so it's subject to the app's bindings configuration
+	_, dlErr := a.sendToOutputBindingAsApp(policy.deadLetterBinding, &bindings.InvokeRequest{
+		Data:      resp.Data,
+		Metadata:  metadata,
+		Operation: bindings.CreateOperation,
+	})
+	if dlErr != nil {
+		log.Errorf("error sending event from input binding %s to dead-letter binding %s: %s", name, policy.deadLetterBinding, dlErr)
+		return err
+	}
+
+	log.Warnf("event from input binding %s could not be delivered after %v retries and was sent to dead-letter binding %s: %s", name, policy.maxRetries, policy.deadLetterBinding, err)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	global_config "github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/diagnostics"
@@ -43,6 +44,15 @@ func FromFlags() (*DaprRuntime, error) {
 	runtimeVersion := flag.Bool("version", false, "Prints the runtime version")
 	appMaxConcurrency := flag.Int("app-max-concurrency", -1, "Controls the concurrency level when forwarding requests to user code")
 	enableMTLS := flag.Bool("enable-mtls", false, "Enables automatic mTLS for daprd to daprd communication channels")
+	appSSL := flag.Bool("app-ssl", false, "Connects to the application over HTTPS or gRPC with TLS")
+	appCACert := flag.String("app-ca-cert", "", "Path to the PEM encoded CA certificate used to verify the application certificate. If empty, the certificate is not verified")
+	appUnixSocket := flag.String("app-unix-socket", "", "Path to the Unix domain socket the application is listening on, used instead of app-port")
+	unixDomainSocket := flag.String("unix-domain-socket", "", "Path to a directory in which the Dapr HTTP and gRPC APIs listen on Unix domain sockets instead of their ports")
+	enableAppHealthCheck := flag.Bool("enable-app-health-check", false, "Probes the health of the app, pausing pub/sub subscriptions and input bindings while it's unhealthy")
+	appHealthCheckPath := flag.String("app-health-check-path", DefaultAppHealthCheckPath, "Path of the health endpoint of HTTP apps. gRPC apps are probed with the gRPC health protocol")
+	appHealthProbeInterval := flag.Int("app-health-probe-interval", DefaultAppHealthProbeInterval, "Interval between the health probes of the app, in seconds")
+	appHealthProbeTimeout := flag.Int("app-health-probe-timeout", DefaultAppHealthProbeTimeout, "Timeout of the health probes of the app, in milliseconds")
+	appHealthThreshold := flag.Int("app-health-threshold", DefaultAppHealthThreshold, "Number of consecutive failed health probes after which the app is unhealthy")
 
 	// deprecate in v1.0 release
 	placementServiceAddress := flag.String("placement-address", "", "[Deprecated] Address for the Dapr placement service")
@@ -54,7 +64,7 @@ func FromFlags() (*DaprRuntime, error) {
 
 	metricsExporter := metrics.NewExporter(metrics.DefaultMetricNamespace)
 
-	// attaching only metrics-port option
+	// attaching the metrics-port and exporters options
 	metricsExporter.Options().AttachCmdFlag(flag.StringVar)
 
 	flag.Parse()
@@ -82,10 +92,6 @@ func FromFlags() (*DaprRuntime, error) {
 		log.Fatal(err)
 	}
 
-	if err := diagnostics.InitMetrics(*appID); err != nil {
-		log.Fatal(err)
-	}
-
 	daprHTTP, err := strconv.Atoi(*daprHTTPPort)
 	if err != nil {
 		return nil, errors.Wrap(err, "error parsing dapr-http-port flag")
@@ -146,6 +152,22 @@ func FromFlags() (*DaprRuntime, error) {
 	runtimeConfig := NewRuntimeConfig(*appID, placementAddress, *controlPlaneAddress, *allowedOrigins, *config, *componentsPath,
 		appPrtcl, *mode, daprHTTP, daprInternalGRPC, daprAPIGRPC, applicationPort, profPort, *enableProfiling, concurrency, *enableMTLS, *sentryAddress)
 
+	runtimeConfig.AppSSL = *appSSL
+	runtimeConfig.AppCACert = *appCACert
+	runtimeConfig.AppUnixSocket = *appUnixSocket
+	runtimeConfig.UnixDomainSocket = *unixDomainSocket
+
+	if *enableAppHealthCheck {
+		if *appHealthProbeInterval <= 0 || *appHealthProbeTimeout <= 0 || *appHealthThreshold <= 0 {
+			return nil, errors.New("app-health-probe-interval, app-health-probe-timeout and app-health-threshold must be positive")
+		}
+		runtimeConfig.EnableAppHealthCheck = true
+		runtimeConfig.AppHealthCheckPath = *appHealthCheckPath
+		runtimeConfig.AppHealthProbeInterval = time.Duration(*appHealthProbeInterval) * time.Second
+		runtimeConfig.AppHealthProbeTimeout = time.Duration(*appHealthProbeTimeout) * time.Millisecond
+		runtimeConfig.AppHealthThreshold = *appHealthThreshold
+	}
+
 	var globalConfig *global_config.Configuration
 	var configErr error
 
@@ -186,6 +208,10 @@ func FromFlags() (*DaprRuntime, error) {
 		globalConfig = global_config.LoadDefaultConfiguration()
 	}
 
+	if err := diagnostics.InitMetrics(*appID, globalConfig.Spec.MetricSpec); err != nil {
+		log.Fatal(err)
+	}
+
 	accessControlList, err = global_config.ParseAccessControlSpec(globalConfig.Spec.AccessControlSpec)
 	if err != nil {
 		log.Fatalf(err.Error())
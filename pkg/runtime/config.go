@@ -6,6 +6,8 @@
 package runtime
 
 import (
+	"time"
+
 	config "github.com/dapr/dapr/pkg/config/modes"
 	"github.com/dapr/dapr/pkg/credentials"
 	"github.com/dapr/dapr/pkg/modes"
@@ -29,6 +31,14 @@ const (
 	DefaultMetricsPort = 9090
 	// DefaultAllowedOrigins is the default origins allowed for the Dapr HTTP servers
 	DefaultAllowedOrigins = "*"
+	// DefaultAppHealthCheckPath is the default path of the health endpoint of HTTP apps
+	DefaultAppHealthCheckPath = "/healthz"
+	// DefaultAppHealthProbeInterval is the default interval between the health probes of the app, in seconds
+	DefaultAppHealthProbeInterval = 5
+	// DefaultAppHealthProbeTimeout is the default timeout of the health probes of the app, in milliseconds
+	DefaultAppHealthProbeTimeout = 500
+	// DefaultAppHealthThreshold is the default number of consecutive failed probes after which the app is unhealthy
+	DefaultAppHealthThreshold = 3
 )
 
 // Config holds the Dapr Runtime configuration
@@ -51,6 +61,25 @@ type Config struct {
 	mtlsEnabled             bool
 	SentryServiceAddress    string
 	CertChain               *credentials.CertChain
+	// AppSSL connects to the app over HTTPS or gRPC with TLS
+	AppSSL bool
+	// AppCACert is the path of the CA certificate used to verify the certificate of the app
+	AppCACert string
+	// AppUnixSocket is the path of the Unix domain socket the app listens on instead of the app port
+	AppUnixSocket string
+	// UnixDomainSocket is the directory in which the Dapr HTTP and gRPC APIs listen on Unix domain sockets
+	// instead of their ports
+	UnixDomainSocket string
+	// EnableAppHealthCheck probes the health of the app, pausing the delivery of events to the app while it's unhealthy
+	EnableAppHealthCheck bool
+	// AppHealthCheckPath is the path of the health endpoint of HTTP apps. gRPC apps are probed with the gRPC health protocol
+	AppHealthCheckPath string
+	// AppHealthProbeInterval is the interval between the health probes of the app
+	AppHealthProbeInterval time.Duration
+	// AppHealthProbeTimeout is the timeout of the health probes of the app
+	AppHealthProbeTimeout time.Duration
+	// AppHealthThreshold is the number of consecutive failed probes after which the app is unhealthy
+	AppHealthThreshold int
 }
 
 // NewRuntimeConfig returns a new runtime config
@@ -0,0 +1,25 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package pubsub
+
+import (
+	"fmt"
+)
+
+// NotAllowedError is returned when the app isn't allowed to publish to a topic, either by the scopes of
+// the pub/sub or by the access control policies
+type NotAllowedError struct {
+	msg string
+}
+
+// NewNotAllowedError returns a NotAllowedError with a message formatted from format and args.
+func NewNotAllowedError(format string, args ...interface{}) *NotAllowedError {
+	return &NotAllowedError{msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *NotAllowedError) Error() string {
+	return e.msg
+}
@@ -9,11 +9,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	nethttp "net/http"
@@ -32,6 +37,7 @@ import (
 	"github.com/dapr/dapr/pkg/components"
 	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
 	exporter_loader "github.com/dapr/dapr/pkg/components/exporters"
+	grpc_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
 	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
@@ -39,12 +45,15 @@ import (
 	state_loader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	trace_exporters "github.com/dapr/dapr/pkg/diagnostics/exporters"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/grpc"
+	"github.com/dapr/dapr/pkg/health"
 	"github.com/dapr/dapr/pkg/http"
 	"github.com/dapr/dapr/pkg/logger"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
 	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
@@ -66,11 +75,27 @@ import (
 const (
 	appConfigEndpoint = "dapr/config"
 	actorStateStore   = "actorStateStore"
+	secretCacheTTL    = "secretCacheTTL"
+	// maxStreamBufferSize limits the payload buffered for streamed invocations of bindings that can't stream
+	maxStreamBufferSize = "maxStreamBufferSize"
 
 	// output bindings concurrency
 	bindingsConcurrnecyParallel   = "parallel"
 	bindingsConcurrnecySequential = "sequential"
 	pubsubName                    = "pubsubName"
+
+	// W3C trace context keys in binding metadata
+	traceparentMetadata = "traceparent"
+	tracestateMetadata  = "tracestate"
+
+	// defaultAPITokensRefreshInterval is how often the api tokens are reloaded if not configured
+	defaultAPITokensRefreshInterval = time.Second * 30
+	// defaultComponentProbeInterval is how often the components are probed if not configured
+	defaultComponentProbeInterval = time.Second * 15
+	// defaultComponentProbeTimeout is how long a component has to answer a probe if not configured
+	defaultComponentProbeTimeout = time.Second * 5
+	// componentProbeStateKey is the key read to probe the state stores that can't be pinged
+	componentProbeStateKey = "dapr-component-health-probe"
 )
 
 type ComponentCategory string
@@ -99,9 +124,16 @@ type TopicRoute struct {
 
 // DaprRuntime holds all the core components of the runtime
 type DaprRuntime struct {
+	// ctx is canceled when the runtime is stopped
+	ctx                    context.Context
+	cancel                 context.CancelFunc
 	runtimeConfig          *Config
 	globalConfig           *config.Configuration
 	accessControlList      *config.AccessControlList
+	apiTokens              *security.APITokenStore
+	jwtAuthenticator       *security.JWTAuthenticator
+	traceExporters         []trace_exporters.TraceExporter
+	componentsLock         sync.RWMutex
 	components             []components_v1alpha1.Component
 	grpc                   *grpc.Manager
 	appChannel             channel.AppChannel
@@ -123,6 +155,7 @@ type DaprRuntime struct {
 	nameResolver           nr.Resolver
 	json                   jsoniter.API
 	httpMiddlewareRegistry http_middleware_loader.Registry
+	grpcMiddlewareRegistry grpc_middleware_loader.Registry
 	hostAddress            string
 	actorStateStoreName    string
 	actorStateStoreCount   int
@@ -133,12 +166,23 @@ type DaprRuntime struct {
 	allowedTopics          map[string][]string
 	daprHTTPAPI            http.API
 	operatorClient         operatorv1pb.OperatorClient
+	topicRoutesLock        sync.RWMutex
 	topicRoutes            map[string]TopicRoute
 
-	secretsConfiguration map[string]config.SecretsScope
+	secretsConfiguration  map[string]config.SecretsScope
+	bindingsConfiguration map[string]config.BindingsScope
+
+	inputBindingDeliveryPoliciesLock sync.RWMutex
+	inputBindingDeliveryPolicies     map[string]*bindingDeliveryPolicy
+	outputBindingStreamBufferSizes   map[string]int64
 
 	pendingComponents          chan components_v1alpha1.Component
 	pendingComponentDependents map[string][]components_v1alpha1.Component
+	componentStatusesLock      sync.RWMutex
+	componentStatuses          map[string]components.RegisteredComponent
+
+	componentsHealth *health.ComponentsHealth
+	appHealth        *health.AppHealth
 }
 
 type componentPreprocessRes struct {
@@ -147,7 +191,10 @@ type componentPreprocessRes struct {
 
 // NewDaprRuntime returns a new runtime with the given runtime config and global config
 func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration, accessControlList *config.AccessControlList) *DaprRuntime {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &DaprRuntime{
+		ctx:                    ctx,
+		cancel:                 cancel,
 		runtimeConfig:          runtimeConfig,
 		globalConfig:           globalConfig,
 		accessControlList:      accessControlList,
@@ -165,15 +212,26 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration, a
 		exporterRegistry:       exporter_loader.NewRegistry(),
 		nameResolutionRegistry: nr_loader.NewRegistry(),
 		httpMiddlewareRegistry: http_middleware_loader.NewRegistry(),
+		grpcMiddlewareRegistry: grpc_middleware_loader.NewRegistry(),
 
 		scopedSubscriptions: map[string][]string{},
 		scopedPublishings:   map[string][]string{},
 		allowedTopics:       map[string][]string{},
 
-		secretsConfiguration: map[string]config.SecretsScope{},
+		secretsConfiguration:  map[string]config.SecretsScope{},
+		bindingsConfiguration: map[string]config.BindingsScope{},
+
+		inputBindingDeliveryPolicies:   map[string]*bindingDeliveryPolicy{},
+		outputBindingStreamBufferSizes: map[string]int64{},
 
 		pendingComponents:          make(chan components_v1alpha1.Component),
 		pendingComponentDependents: map[string][]components_v1alpha1.Component{},
+		componentStatuses:          map[string]components.RegisteredComponent{},
+
+		componentsHealth: health.NewComponentsHealth(
+			globalConfig.Spec.ComponentHealth.GetProbeInterval(defaultComponentProbeInterval),
+			globalConfig.Spec.ComponentHealth.GetProbeTimeout(defaultComponentProbeTimeout),
+			globalConfig.Spec.ComponentHealth.RequiredComponents),
 	}
 }
 
@@ -237,9 +295,19 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 		return errors.Wrap(err, "failed to determine host address")
 	}
 
+	// attach the trace and span IDs of requests, including fasthttp requests, to the log lines of logger.WithContext
+	logger.SetSpanFromContext(diag_utils.SpanFromContext)
+
 	if a.globalConfig.Spec.TracingSpec.Stdout {
 		trace.RegisterExporter(&diag_utils.StdoutExporter{})
 	}
+	a.traceExporters, err = trace_exporters.NewTraceExporters(a.runtimeConfig.ID, a.hostAddress, a.globalConfig.Spec.TracingSpec)
+	if err != nil {
+		log.Warnf("failed to create trace exporters: %s", err)
+	}
+	for _, e := range a.traceExporters {
+		trace.RegisterExporter(e)
+	}
 
 	err = a.createAppChannel()
 	if err != nil {
@@ -247,6 +315,7 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	}
 
 	a.loadAppConfiguration()
+	a.initAppHealth()
 
 	// Register and initialize name resolution for service discovery.
 	a.nameResolutionRegistry.Register(opts.nameResolutions...)
@@ -274,6 +343,7 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	}
 
 	a.flushOutstandingComponents()
+	a.componentsHealth.Start(a.ctx)
 
 	a.initDirectMessaging(a.nameResolver)
 
@@ -289,17 +359,40 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 		log.Warnf("failed to build HTTP pipeline: %s", err)
 	}
 
+	// Register and initialize gRPC middleware
+	a.grpcMiddlewareRegistry.Register(opts.grpcMiddleware...)
+
+	a.apiTokens, err = a.initAPITokens()
+	if err != nil {
+		log.Fatalf("failed to load api tokens: %s", err)
+	}
+	a.jwtAuthenticator, err = security.NewJWTAuthenticator(a.globalConfig.Spec.JWTAuthentication)
+	if err != nil {
+		log.Fatalf("failed to initialize jwt authentication: %s", err)
+	}
+
 	// Setup allow/deny list for secrets
 	a.populateSecretsConfiguration()
+	// Setup allow/deny list for binding operations
+	a.populateBindingsConfiguration()
 	// Create and start internal and external gRPC servers
 	grpcAPI := a.getGRPCAPI()
-	err = a.startGRPCAPIServer(grpcAPI, a.runtimeConfig.APIGRPCPort)
+	// each server gets its own instances of the middleware, so that stateful ones like rate limiters aren't shared
+	grpcPipeline, err := a.buildGRPCPipeline()
+	if err != nil {
+		return errors.Wrap(err, "failed to build gRPC pipeline")
+	}
+	err = a.startGRPCAPIServer(grpcAPI, a.runtimeConfig.APIGRPCPort, grpcPipeline)
 	if err != nil {
 		log.Fatalf("failed to start API gRPC server: %s", err)
 	}
 	log.Infof("API gRPC server is running on port %v", a.runtimeConfig.APIGRPCPort)
 
-	err = a.startGRPCInternalServer(grpcAPI, a.runtimeConfig.InternalGRPCPort)
+	grpcPipeline, err = a.buildGRPCPipeline()
+	if err != nil {
+		return errors.Wrap(err, "failed to build gRPC pipeline")
+	}
+	err = a.startGRPCInternalServer(grpcAPI, a.runtimeConfig.InternalGRPCPort, grpcPipeline)
 	if err != nil {
 		log.Fatalf("failed to start internal gRPC server: %s", err)
 	}
@@ -312,6 +405,60 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	return nil
 }
 
+// initAPITokens creates the store of the tokens that authenticate calls to the Dapr APIs, and watches the file or
+// secret the tokens are loaded from, if any, for the lifetime of the runtime
+func (a *DaprRuntime) initAPITokens() (*security.APITokenStore, error) {
+	tokens := security.NewAPITokenStore(security.GetAPIToken())
+	spec := a.globalConfig.Spec.APITokens
+
+	var load func() ([]byte, error)
+	switch {
+	case spec.File != "":
+		load = func() ([]byte, error) {
+			return ioutil.ReadFile(spec.File)
+		}
+	case spec.SecretStore != "":
+		load = func() ([]byte, error) {
+			return a.getAPITokensSecret(spec)
+		}
+	default:
+		return tokens, nil
+	}
+
+	if err := tokens.Watch(load, spec.GetRefreshInterval(defaultAPITokensRefreshInterval), nil); err != nil {
+		return nil, err
+	}
+	log.Info("api tokens loaded")
+	return tokens, nil
+}
+
+func (a *DaprRuntime) getAPITokensSecret(spec config.APITokensSpec) ([]byte, error) {
+	secretStore := a.getSecretStore(spec.SecretStore)
+	if secretStore == nil {
+		return nil, errors.Errorf("secret store %s not found", spec.SecretStore)
+	}
+	resp, err := secretStore.GetSecret(secretstores.GetSecretRequest{
+		Name: spec.SecretName,
+		Metadata: map[string]string{
+			"namespace": a.namespace,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the secret name as the key if the key is not given
+	key := spec.SecretKey
+	if key == "" {
+		key = spec.SecretName
+	}
+	val, ok := resp.Data[key]
+	if !ok {
+		return nil, errors.Errorf("key %s not found in secret %s", key, spec.SecretName)
+	}
+	return []byte(val), nil
+}
+
 func (a *DaprRuntime) populateSecretsConfiguration() {
 	// Populate in a map for easy lookup by store name.
 	for _, scope := range a.globalConfig.Spec.Secrets.Scopes {
@@ -319,14 +466,21 @@ func (a *DaprRuntime) populateSecretsConfiguration() {
 	}
 }
 
+func (a *DaprRuntime) populateBindingsConfiguration() {
+	// Populate in a map for easy lookup by binding name.
+	for _, scope := range a.globalConfig.Spec.Bindings.Scopes {
+		a.bindingsConfiguration[scope.BindingName] = scope
+	}
+}
+
 func (a *DaprRuntime) buildHTTPPipeline() (http_middleware.Pipeline, error) {
 	var handlers []http_middleware.Middleware
 
 	if a.globalConfig != nil {
 		for i := 0; i < len(a.globalConfig.Spec.HTTPPipelineSpec.Handlers); i++ {
 			middlewareSpec := a.globalConfig.Spec.HTTPPipelineSpec.Handlers[i]
-			component := a.getComponent(middlewareSpec.Type, middlewareSpec.Name)
-			if component == nil {
+			component, exists := a.getComponent(middlewareSpec.Type, middlewareSpec.Name)
+			if !exists {
 				return http_middleware.Pipeline{}, errors.Errorf("couldn't find middleware component with name %s and type %s",
 					middlewareSpec.Name,
 					middlewareSpec.Type)
@@ -343,6 +497,30 @@ func (a *DaprRuntime) buildHTTPPipeline() (http_middleware.Pipeline, error) {
 	return http_middleware.Pipeline{Handlers: handlers}, nil
 }
 
+func (a *DaprRuntime) buildGRPCPipeline() (grpc_middleware.Pipeline, error) {
+	var handlers []grpc_middleware.Middleware
+
+	if a.globalConfig != nil {
+		for i := 0; i < len(a.globalConfig.Spec.GRPCPipelineSpec.Handlers); i++ {
+			middlewareSpec := a.globalConfig.Spec.GRPCPipelineSpec.Handlers[i]
+			component, exists := a.getComponent(middlewareSpec.Type, middlewareSpec.Name)
+			if !exists {
+				return grpc_middleware.Pipeline{}, errors.Errorf("couldn't find middleware component with name %s and type %s",
+					middlewareSpec.Name,
+					middlewareSpec.Type)
+			}
+			handler, err := a.grpcMiddlewareRegistry.Create(middlewareSpec.Type,
+				middleware.Metadata{Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata)})
+			if err != nil {
+				return grpc_middleware.Pipeline{}, err
+			}
+			log.Infof("enabled %s grpc middleware", middlewareSpec.Type)
+			handlers = append(handlers, handler)
+		}
+	}
+	return grpc_middleware.Pipeline{Handlers: handlers}, nil
+}
+
 func (a *DaprRuntime) initBinding(c components_v1alpha1.Component) error {
 	if err := a.initOutputBinding(c); err != nil {
 		log.Errorf("failed to init output bindings: %s", err)
@@ -389,6 +567,7 @@ func (a *DaprRuntime) beginPubSub(name string, ps pubsub.PubSub) error {
 			}
 
 			msg.Metadata[pubsubName] = name
+			a.waitUntilAppIsHealthy()
 			return publishFunc(msg)
 		}); err != nil {
 			log.Warnf("failed to subscribe to topic %s: %s", topic, err)
@@ -406,8 +585,10 @@ func (a *DaprRuntime) initDirectMessaging(resolver nr.Resolver) {
 		a.runtimeConfig.Mode,
 		a.appChannel,
 		a.grpc.GetGRPCConnection,
+		a.grpc.CloseGRPCConnection,
 		resolver,
-		a.globalConfig.Spec.TracingSpec)
+		a.globalConfig.Spec.TracingSpec,
+		a.globalConfig.Spec.ServiceInvocation)
 }
 
 func (a *DaprRuntime) beginComponentsUpdates() error {
@@ -442,20 +623,17 @@ func (a *DaprRuntime) beginComponentsUpdates() error {
 }
 
 func (a *DaprRuntime) onComponentUpdated(component components_v1alpha1.Component) {
-	existed := a.getComponent(component.Spec.Type, component.Name)
-	if existed != nil && reflect.DeepEqual(existed.Spec.Metadata, component.Spec.Metadata) {
+	existed, exists := a.getComponent(component.Spec.Type, component.Name)
+	if exists && reflect.DeepEqual(existed.Spec.Metadata, component.Spec.Metadata) {
 		return
 	}
 	a.pendingComponents <- component
 }
 
-func (a *DaprRuntime) sendBatchOutputBindingsParallel(to []string, data []byte) {
+func (a *DaprRuntime) sendBatchOutputBindingsParallel(to []string, data []byte, sc trace.SpanContext) {
 	for _, dst := range to {
 		go func(name string) {
-			_, err := a.sendToOutputBinding(name, &bindings.InvokeRequest{
-				Data:      data,
-				Operation: bindings.CreateOperation,
-			})
+			err := a.sendAppResponseToOutputBinding(name, data, sc)
 			if err != nil {
 				log.Error(err)
 			}
@@ -463,12 +641,9 @@ func (a *DaprRuntime) sendBatchOutputBindingsParallel(to []string, data []byte)
 	}
 }
 
-func (a *DaprRuntime) sendBatchOutputBindingsSequential(to []string, data []byte) error {
+func (a *DaprRuntime) sendBatchOutputBindingsSequential(to []string, data []byte, sc trace.SpanContext) error {
 	for _, dst := range to {
-		_, err := a.sendToOutputBinding(dst, &bindings.InvokeRequest{
-			Data:      data,
-			Operation: bindings.CreateOperation,
-		})
+		err := a.sendAppResponseToOutputBinding(dst, data, sc)
 		if err != nil {
 			return err
 		}
@@ -476,28 +651,82 @@ func (a *DaprRuntime) sendBatchOutputBindingsSequential(to []string, data []byte
 	return nil
 }
 
+// sendAppResponseToOutputBinding sends data from an app's input binding response to an output binding,
+// subject to the same bindings configuration as the app's direct calls to the output binding.
+// The trace context of the input binding event is passed on in the output binding metadata.
+func (a *DaprRuntime) sendAppResponseToOutputBinding(name string, data []byte, sc trace.SpanContext) error {
+	_, err := a.sendToOutputBindingAsApp(name, &bindings.InvokeRequest{
+		Data:      data,
+		Metadata:  diag.SpanContextToBindingMetadata(sc, nil),
+		Operation: bindings.CreateOperation,
+	})
+	return err
+}
+
+// sendToOutputBindingAsApp invokes an output binding on behalf of the app, subject to the bindings configuration
+// that applies to the app's direct calls to the output binding
+func (a *DaprRuntime) sendToOutputBindingAsApp(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+	if scope, ok := a.bindingsConfiguration[name]; ok && !scope.IsOperationAllowed(a.runtimeConfig.ID, string(req.Operation)) {
+		return nil, errors.Errorf("access denied by policy to invoke %s on binding %s", req.Operation, name)
+	}
+	return a.sendToOutputBinding(name, req)
+}
+
 func (a *DaprRuntime) sendToOutputBinding(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
-	if req.Operation == "" {
+	binding, err := a.getOutputBindingForOperation(name, req.Operation)
+	if err != nil {
+		return nil, err
+	}
+	return binding.Invoke(req)
+}
+
+// sendToOutputBindingStream invokes an output binding with a streamed payload. Payloads to bindings
+// that do not support streaming are buffered up to the binding's maxStreamBufferSize.
+func (a *DaprRuntime) sendToOutputBindingStream(name string, req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error) {
+	binding, err := a.getOutputBindingForOperation(name, req.Operation)
+	if err != nil {
+		return nil, err
+	}
+	bufferSize, ok := a.outputBindingStreamBufferSizes[name]
+	if !ok {
+		bufferSize = bindings_loader.DefaultMaxStreamBufferSize
+	}
+	return bindings_loader.InvokeStream(binding, req, bufferSize)
+}
+
+func (a *DaprRuntime) getOutputBindingForOperation(name string, operation bindings.OperationKind) (bindings.OutputBinding, error) {
+	if operation == "" {
 		return nil, errors.New("operation field is missing from request")
 	}
 
 	if binding, ok := a.outputBindings[name]; ok {
 		ops := binding.Operations()
 		for _, o := range ops {
-			if o == req.Operation {
-				return binding.Invoke(req)
+			if o == operation {
+				return binding, nil
 			}
 		}
 		supported := make([]string, len(ops))
 		for _, o := range ops {
 			supported = append(supported, string(o))
 		}
-		return nil, errors.Errorf("binding %s does not support operation %s. supported operations:%s", name, req.Operation, strings.Join(supported, " "))
+		return nil, errors.Errorf("binding %s does not support operation %s. supported operations:%s", name, operation, strings.Join(supported, " "))
 	}
 	return nil, errors.Errorf("couldn't find output binding %s", name)
 }
 
-func (a *DaprRuntime) onAppResponse(response *bindings.AppResponse) error {
+func getMaxStreamBufferSize(name string, props map[string]string) int64 {
+	if val := props[maxStreamBufferSize]; val != "" {
+		size, err := strconv.ParseInt(val, 10, 64)
+		if err == nil && size > 0 {
+			return size
+		}
+		log.Warnf("invalid %s %q for output binding %s, using default", maxStreamBufferSize, val, name)
+	}
+	return bindings_loader.DefaultMaxStreamBufferSize
+}
+
+func (a *DaprRuntime) onAppResponse(response *bindings.AppResponse, sc trace.SpanContext) error {
 	if len(response.State) > 0 {
 		go func(reqs []state.SetRequest) {
 			if a.stateStores != nil {
@@ -516,9 +745,9 @@ func (a *DaprRuntime) onAppResponse(response *bindings.AppResponse) error {
 		}
 
 		if response.Concurrency == bindingsConcurrnecyParallel {
-			a.sendBatchOutputBindingsParallel(response.To, b)
+			a.sendBatchOutputBindingsParallel(response.To, b, sc)
 		} else {
-			return a.sendBatchOutputBindingsSequential(response.To, b)
+			return a.sendBatchOutputBindingsSequential(response.To, b, sc)
 		}
 	}
 
@@ -528,7 +757,9 @@ func (a *DaprRuntime) onAppResponse(response *bindings.AppResponse) error {
 func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, metadata map[string]string) error {
 	var response bindings.AppResponse
 	spanName := fmt.Sprintf("bindings/%s", bindingName)
-	ctx, span := diag.StartInternalCallbackSpan(spanName, trace.SpanContext{}, a.globalConfig.Spec.TracingSpec)
+	// continue the trace of the event when the binding carries its trace context in the metadata
+	sc, _ := diag.SpanContextFromBindingMetadata(metadata)
+	ctx, span := diag.StartInternalCallbackSpan(spanName, sc, a.globalConfig.Spec.TracingSpec)
 
 	if a.runtimeConfig.ApplicationProtocol == GRPCProtocol {
 		ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
@@ -585,6 +816,10 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 
 		reqMetadata := map[string][]string{}
 		for k, v := range metadata {
+			// the app channel sends the trace context of the callback span instead
+			if lk := strings.ToLower(k); lk == traceparentMetadata || lk == tracestateMetadata {
+				continue
+			}
 			reqMetadata[k] = []string{v}
 		}
 		req.WithMetadata(reqMetadata)
@@ -603,8 +838,8 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 			span.End()
 		}
 
-		if resp.Status().Code != nethttp.StatusOK {
-			return errors.Errorf("fails to send binding event to http app channel, status code: %d", resp.Status().Code)
+		if statusCode := resp.Status().Code; statusCode < 200 || statusCode > 299 {
+			return errors.Errorf("fails to send binding event to http app channel, status code: %d", statusCode)
 		}
 
 		// TODO: Do we need to check content-type?
@@ -614,7 +849,7 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 	}
 
 	if len(response.State) > 0 || len(response.To) > 0 {
-		if err := a.onAppResponse(&response); err != nil {
+		if err := a.onAppResponse(&response, span.SpanContext()); err != nil {
 			log.Errorf("error executing app response: %s", err)
 		}
 	}
@@ -624,7 +859,9 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 func (a *DaprRuntime) readFromBinding(name string, binding bindings.InputBinding) error {
 	err := binding.Read(func(resp *bindings.ReadResponse) error {
 		if resp != nil {
-			err := a.sendBindingEventToApp(name, resp.Data, resp.Metadata)
+			a.waitUntilAppIsHealthy()
+			// the policy is looked up for every event to follow the re-initialization of the binding
+			err := a.deliverBindingEvent(name, resp, a.getBindingDeliveryPolicy(name))
 			if err != nil {
 				log.Debugf("error from app consumer for binding [%s]: %s", name, err)
 				return err
@@ -637,27 +874,39 @@ func (a *DaprRuntime) readFromBinding(name string, binding bindings.InputBinding
 
 func (a *DaprRuntime) startHTTPServer(port, profilePort int, allowedOrigins string, pipeline http_middleware.Pipeline) {
 	a.daprHTTPAPI = http.NewAPI(a.runtimeConfig.ID, a.appChannel, a.directMessaging, a.stateStores, a.secretStores,
-		a.secretsConfiguration, a.getPublishAdapter(), a.actor, a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec)
+		a.secretsConfiguration, a.bindingsConfiguration, a.getPublishAdapter(), a.actor, a.sendToOutputBinding, a.sendToOutputBindingStream, a.globalConfig.Spec.TracingSpec,
+		a.accessControlList, a.namespace, a.componentsHealth, a.getRegisteredComponents, a.getSubscriptions, a.globalConfig.Name)
 	serverConf := http.NewServerConfig(a.runtimeConfig.ID, a.hostAddress, port, profilePort, allowedOrigins, a.runtimeConfig.EnableProfiling)
+	serverConf.UnixDomainSocket = a.unixDomainSocketPath("http")
 
-	server := http.NewServer(a.daprHTTPAPI, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, pipeline)
+	server := http.NewServer(a.daprHTTPAPI, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, pipeline, a.apiTokens, a.jwtAuthenticator)
 	server.StartNonBlocking()
 }
 
-func (a *DaprRuntime) startGRPCInternalServer(api grpc.API, port int) error {
+func (a *DaprRuntime) startGRPCInternalServer(api grpc.API, port int, pipeline grpc_middleware.Pipeline) error {
 	serverConf := a.getNewServerConfig(port)
-	server := grpc.NewInternalServer(api, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, a.authenticator)
+	server := grpc.NewInternalServer(api, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, a.authenticator, pipeline)
 	err := server.StartNonBlocking()
 	return err
 }
 
-func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int) error {
+func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int, pipeline grpc_middleware.Pipeline) error {
 	serverConf := a.getNewServerConfig(port)
-	server := grpc.NewAPIServer(api, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec)
+	serverConf.UnixDomainSocket = a.unixDomainSocketPath("grpc")
+	server := grpc.NewAPIServer(api, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, pipeline, a.apiTokens, a.jwtAuthenticator)
 	err := server.StartNonBlocking()
 	return err
 }
 
+// unixDomainSocketPath returns the path of the Unix domain socket of the Dapr API over protocol,
+// or an empty string if the APIs listen on their ports
+func (a *DaprRuntime) unixDomainSocketPath(protocol string) string {
+	if a.runtimeConfig.UnixDomainSocket == "" {
+		return ""
+	}
+	return filepath.Join(a.runtimeConfig.UnixDomainSocket, fmt.Sprintf("dapr-%s-%s.socket", protocol, a.runtimeConfig.ID))
+}
+
 func (a *DaprRuntime) getNewServerConfig(port int) grpc.ServerConfig {
 	// Use the trust domain value from the access control policy spec to generate the cert
 	// If no access control policy has been specified, use a default value
@@ -669,9 +918,10 @@ func (a *DaprRuntime) getNewServerConfig(port int) grpc.ServerConfig {
 }
 
 func (a *DaprRuntime) getGRPCAPI() grpc.API {
-	return grpc.NewAPI(a.runtimeConfig.ID, a.appChannel, a.stateStores, a.secretStores, a.secretsConfiguration,
+	return grpc.NewAPI(a.runtimeConfig.ID, a.appChannel, a.stateStores, a.secretStores, a.secretsConfiguration, a.bindingsConfiguration,
 		a.getPublishAdapter(), a.directMessaging, a.actor,
-		a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec, a.accessControlList, string(a.runtimeConfig.ApplicationProtocol))
+		a.sendToOutputBinding, a.sendToOutputBindingStream, a.globalConfig.Spec.TracingSpec, a.accessControlList, a.namespace, string(a.runtimeConfig.ApplicationProtocol),
+		a.getRegisteredComponents, a.getSubscriptions, a.globalConfig.Name)
 }
 
 func (a *DaprRuntime) getPublishAdapter() func(*pubsub.PublishRequest) error {
@@ -733,8 +983,9 @@ func (a *DaprRuntime) initInputBinding(c components_v1alpha1.Component) error {
 		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
 		return err
 	}
+	props := a.convertMetadataItemsToProperties(c.Spec.Metadata)
 	err = binding.Init(bindings.Metadata{
-		Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
+		Properties: props,
 		Name:       c.ObjectMeta.Name,
 	})
 	if err != nil {
@@ -744,6 +995,7 @@ func (a *DaprRuntime) initInputBinding(c components_v1alpha1.Component) error {
 	}
 
 	log.Infof("successful init for input binding %s (%s)", c.ObjectMeta.Name, c.Spec.Type)
+	a.setBindingDeliveryPolicy(c.Name, newBindingDeliveryPolicy(c.Name, props))
 	if _, ok := a.inputBindings[c.Name]; !ok {
 		go func() {
 			err := a.readFromBinding(c.Name, binding)
@@ -766,8 +1018,9 @@ func (a *DaprRuntime) initOutputBinding(c components_v1alpha1.Component) error {
 	}
 
 	if binding != nil {
+		props := a.convertMetadataItemsToProperties(c.Spec.Metadata)
 		err := binding.Init(bindings.Metadata{
-			Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
+			Properties: props,
 			Name:       c.ObjectMeta.Name,
 		})
 		if err != nil {
@@ -777,6 +1030,7 @@ func (a *DaprRuntime) initOutputBinding(c components_v1alpha1.Component) error {
 		}
 		log.Infof("successful init for output binding %s (%s)", c.ObjectMeta.Name, c.Spec.Type)
 		a.outputBindings[c.ObjectMeta.Name] = binding
+		a.outputBindingStreamBufferSizes[c.ObjectMeta.Name] = getMaxStreamBufferSize(c.ObjectMeta.Name, props)
 		diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 	}
 	return nil
@@ -852,6 +1106,9 @@ func (a *DaprRuntime) getDeclarativeSubscriptions() []runtime_pubsub.Subscriptio
 }
 
 func (a *DaprRuntime) getTopicRoutes() (map[string]TopicRoute, error) {
+	a.topicRoutesLock.Lock()
+	defer a.topicRoutesLock.Unlock()
+
 	if a.topicRoutes != nil {
 		return a.topicRoutes, nil
 	}
@@ -980,7 +1237,12 @@ func (a *DaprRuntime) Publish(req *pubsub.PublishRequest) error {
 	}
 
 	if allowed := a.isPubSubOperationAllowed(req.PubsubName, req.Topic, a.scopedPublishings[req.PubsubName]); !allowed {
-		return errors.Errorf("topic %s is not allowed for app id %s", req.Topic, a.runtimeConfig.ID)
+		return runtime_pubsub.NewNotAllowedError("topic %s is not allowed for app id %s", req.Topic, a.runtimeConfig.ID)
+	}
+
+	spiffeID := a.accessControlList.LocalSpiffeID(a.runtimeConfig.ID, a.namespace)
+	if allowed, _ := config.IsBuildingBlockOperationAllowedByAccessControlPolicy(spiffeID, config.PubSubBuildingBlock, req.PubsubName+"/"+req.Topic, a.accessControlList); !allowed {
+		return runtime_pubsub.NewNotAllowedError("access control policy has denied publishing to topic %s of pubsub %s for app id %s", req.Topic, req.PubsubName, a.runtimeConfig.ID)
 	}
 
 	return a.pubSubs[req.PubsubName].Publish(req)
@@ -1021,34 +1283,41 @@ func (a *DaprRuntime) initNameResolution() error {
 	var err error
 	var resolverMetadata = nr.Metadata{}
 
+	resolverName := a.globalConfig.Spec.NameResolution.Component
 	switch a.runtimeConfig.Mode {
 	case modes.KubernetesMode:
-		resolver, err = a.nameResolutionRegistry.Create("kubernetes")
+		if resolverName == "" {
+			resolverName = "kubernetes"
+		}
 	case modes.StandaloneMode:
-		resolver, err = a.nameResolutionRegistry.Create("mdns")
+		if resolverName == "" {
+			resolverName = "mdns"
+		}
 		// properties to register mDNS instances.
 		resolverMetadata.Properties = map[string]string{
 			nr.MDNSInstanceName:    a.runtimeConfig.ID,
 			nr.MDNSInstanceAddress: a.hostAddress,
 			nr.MDNSInstancePort:    strconv.Itoa(a.runtimeConfig.InternalGRPCPort),
+			nr_loader.ZoneProperty: utils.GetZone(),
 		}
 	default:
 		return errors.Errorf("remote calls not supported for %s mode", string(a.runtimeConfig.Mode))
 	}
+	resolver, err = a.nameResolutionRegistry.Create(resolverName)
 
 	if err != nil {
-		log.Warnf("error creating name resolution resolver %s: %s", a.runtimeConfig.Mode, err)
+		log.Warnf("error creating name resolution resolver %s: %s", resolverName, err)
 		return err
 	}
 
 	if err = resolver.Init(resolverMetadata); err != nil {
-		log.Errorf("failed to initialize name resolution resolver %s: %s", a.runtimeConfig.Mode, err)
+		log.Errorf("failed to initialize name resolution resolver %s: %s", resolverName, err)
 		return err
 	}
 
 	a.nameResolver = resolver
 
-	log.Infof("Initialized name resolution to %s", a.runtimeConfig.Mode)
+	log.Infof("Initialized name resolution to %s", resolverName)
 	return nil
 }
 
@@ -1060,7 +1329,9 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 		subject = cloudEvent.Subject
 	}
 
+	a.topicRoutesLock.RLock()
 	route := a.topicRoutes[msg.Metadata[pubsubName]].routes[msg.Topic]
+	a.topicRoutesLock.RUnlock()
 	req := invokev1.NewInvokeMethodRequest(route)
 	req.WithHTTPExtension(nethttp.MethodPost, "")
 	req.WithRawData(msg.Data, pubsub.ContentType)
@@ -1091,7 +1362,7 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 		var appResponse pubsub.AppResponse
 		err := a.json.Unmarshal(body, &appResponse)
 		if err != nil {
-			log.Debugf("skipping status check due to error parsing result from pub/sub event %v", cloudEvent.ID)
+			log.WithContext(ctx).Debugf("skipping status check due to error parsing result from pub/sub event %v", cloudEvent.ID)
 			// Return no error so message does not get reprocessed.
 			return nil
 		}
@@ -1102,7 +1373,7 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 		case pubsub.Retry:
 			return errors.Errorf("RETRY status returned from app while processing pub/sub event %v", cloudEvent.ID)
 		case pubsub.Drop:
-			log.Warn("DROP status returned from app while processing pub/sub event %v", cloudEvent.ID)
+			log.WithContext(ctx).Warn("DROP status returned from app while processing pub/sub event %v", cloudEvent.ID)
 			return nil
 		}
 		return errors.Errorf("unknown status returned from app while processing pub/sub event %v: %v", cloudEvent.ID, appResponse.Status)
@@ -1112,12 +1383,12 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 		// These are errors that are not retriable, for now it is just 404 but more status codes can be added.
 		// When adding/removing an error here, check if that is also applicable to GRPC since there is a mapping between HTTP and GRPC errors:
 		// https://cloud.google.com/apis/design/errors#handling_errors
-		log.Errorf("non-retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent.ID, body, statusCode)
+		log.WithContext(ctx).Errorf("non-retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent.ID, body, statusCode)
 		return nil
 	}
 
 	// Every error from now on is a retriable error.
-	log.Warnf("retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent.ID, body, statusCode)
+	log.WithContext(ctx).Warnf("retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent.ID, body, statusCode)
 	return errors.Errorf("retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent.ID, body, statusCode)
 }
 
@@ -1172,12 +1443,12 @@ func (a *DaprRuntime) publishMessageGRPC(msg *pubsub.NewMessage) error {
 		errStatus, hasErrStatus := status.FromError(err)
 		if hasErrStatus && (errStatus.Code() == codes.Unimplemented) {
 			// DROP
-			log.Warn("non-retriable error returned from app while processing pub/sub event %v: %s", cloudEvent.ID, err)
+			log.WithContext(ctx).Warn("non-retriable error returned from app while processing pub/sub event %v: %s", cloudEvent.ID, err)
 			return nil
 		}
 
 		err = errors.Errorf("error returned from app while processing pub/sub event %v: %s", cloudEvent.ID, err)
-		log.Debug(err)
+		log.WithContext(ctx).Debug(err)
 	}
 
 	switch res.GetStatus() {
@@ -1186,7 +1457,7 @@ func (a *DaprRuntime) publishMessageGRPC(msg *pubsub.NewMessage) error {
 	case runtimev1pb.TopicEventResponse_RETRY:
 		return errors.Errorf("RETRY status returned from app while processing pub/sub event %v", cloudEvent.ID)
 	case runtimev1pb.TopicEventResponse_DROP:
-		log.Warn("DROP status returned from app while processing pub/sub event %v", cloudEvent.ID)
+		log.WithContext(ctx).Warn("DROP status returned from app while processing pub/sub event %v", cloudEvent.ID)
 		return nil
 	}
 
@@ -1200,6 +1471,7 @@ func (a *DaprRuntime) initActors() error {
 	}
 	actorConfig := actors.NewConfig(a.hostAddress, a.runtimeConfig.ID, a.runtimeConfig.PlacementServiceAddress, a.appConfig.Entities,
 		a.runtimeConfig.InternalGRPCPort, a.appConfig.ActorScanInterval, a.appConfig.ActorIdleTimeout, a.appConfig.DrainOngoingCallTimeout, a.appConfig.DrainRebalancedActors, a.namespace)
+	actorConfig.AppHealth = a.appHealth
 	act := actors.NewActors(a.stateStores[a.actorStateStoreName], a.appChannel, a.grpc.GetGRPCConnection, actorConfig, a.runtimeConfig.CertChain, a.globalConfig.Spec.TracingSpec)
 	err = act.Init()
 	a.actor = act
@@ -1248,8 +1520,13 @@ func (a *DaprRuntime) loadComponents(opts *runtimeOpts) error {
 		return err
 	}
 
-	a.components = a.getAuthorizedComponents(comps)
-	for _, comp := range a.components {
+	authorized := a.getAuthorizedComponents(comps)
+
+	a.componentsLock.Lock()
+	a.components = authorized
+	a.componentsLock.Unlock()
+
+	for _, comp := range authorized {
 		a.pendingComponents <- comp
 	}
 
@@ -1257,12 +1534,16 @@ func (a *DaprRuntime) loadComponents(opts *runtimeOpts) error {
 }
 
 func (a *DaprRuntime) appendOrReplaceComponents(component components_v1alpha1.Component) {
-	existed := a.getComponent(component.Spec.Type, component.Name)
-	if existed == nil {
-		a.components = append(a.components, component)
-	} else {
-		*existed = component
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+
+	for i, c := range a.components {
+		if c.Spec.Type == component.Spec.Type && c.ObjectMeta.Name == component.Name {
+			a.components[i] = component
+			return
+		}
 	}
+	a.components = append(a.components, component)
 }
 
 func (a *DaprRuntime) extractComponentCategory(component components_v1alpha1.Component) ComponentCategory {
@@ -1298,20 +1579,25 @@ func (a *DaprRuntime) processComponentAndDependents(comp components_v1alpha1.Com
 	res := a.preprocessOneComponent(&comp)
 	if res.unreadyDependency != "" {
 		a.pendingComponentDependents[res.unreadyDependency] = append(a.pendingComponentDependents[res.unreadyDependency], comp)
+		a.setComponentStatus(comp, components.StatusPending)
 		return nil
 	}
 
 	compCategory := a.extractComponentCategory(comp)
 	if compCategory == "" {
 		// the category entered is incorrect, return error
+		a.setComponentStatus(comp, components.StatusFailed)
 		return errors.Errorf("incorrect type %s", comp.Spec.Type)
 	}
 	if err := a.doProcessOneComponent(compCategory, comp); err != nil {
+		a.setComponentStatus(comp, components.StatusFailed)
 		return err
 	}
 
 	log.Infof("component loaded. name: %s, type: %s", comp.ObjectMeta.Name, comp.Spec.Type)
 	a.appendOrReplaceComponents(comp)
+	a.setComponentStatus(comp, components.StatusLoaded)
+	a.registerComponentHealth(compCategory, comp)
 	diag.DefaultMonitoring.ComponentLoaded()
 
 	dependency := componentDependency(compCategory, comp.Name)
@@ -1327,6 +1613,95 @@ func (a *DaprRuntime) processComponentAndDependents(comp components_v1alpha1.Com
 	return nil
 }
 
+// setComponentStatus records the init status of the component reported by the metadata API
+func (a *DaprRuntime) setComponentStatus(comp components_v1alpha1.Component, status string) {
+	a.componentStatusesLock.Lock()
+	defer a.componentStatusesLock.Unlock()
+
+	a.componentStatuses[fmt.Sprintf("%s/%s", comp.Spec.Type, comp.Name)] = components.RegisteredComponent{
+		Name:    comp.Name,
+		Type:    comp.Spec.Type,
+		Version: comp.Spec.Version,
+		Status:  status,
+	}
+}
+
+// getRegisteredComponents returns the components processed by the runtime with their init status, sorted by name and type
+func (a *DaprRuntime) getRegisteredComponents() []components.RegisteredComponent {
+	a.componentStatusesLock.RLock()
+	registered := make([]components.RegisteredComponent, 0, len(a.componentStatuses))
+	for _, c := range a.componentStatuses {
+		registered = append(registered, c)
+	}
+	a.componentStatusesLock.RUnlock()
+
+	sort.Slice(registered, func(i, j int) bool {
+		if registered[i].Name != registered[j].Name {
+			return registered[i].Name < registered[j].Name
+		}
+		return registered[i].Type < registered[j].Type
+	})
+	return registered
+}
+
+// getSubscriptions returns the pub/sub subscriptions of the app with their routes, sorted by pub/sub and topic
+func (a *DaprRuntime) getSubscriptions() []runtime_pubsub.Subscription {
+	subscriptions := []runtime_pubsub.Subscription{}
+	a.topicRoutesLock.RLock()
+	for pubsubName, v := range a.topicRoutes {
+		for topic, route := range v.routes {
+			subscriptions = append(subscriptions, runtime_pubsub.Subscription{
+				PubsubName: pubsubName,
+				Topic:      topic,
+				Route:      route,
+			})
+		}
+	}
+	a.topicRoutesLock.RUnlock()
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		if subscriptions[i].PubsubName != subscriptions[j].PubsubName {
+			return subscriptions[i].PubsubName < subscriptions[j].PubsubName
+		}
+		return subscriptions[i].Topic < subscriptions[j].Topic
+	})
+	return subscriptions
+}
+
+// registerComponentHealth registers the loaded component for health probing if its category can be probed
+func (a *DaprRuntime) registerComponentHealth(category ComponentCategory, comp components_v1alpha1.Component) {
+	var instance interface{}
+	switch category {
+	case bindingsComponent:
+		if b, ok := a.inputBindings[comp.Name]; ok {
+			instance = b
+		} else if b, ok := a.outputBindings[comp.Name]; ok {
+			instance = b
+		}
+	case pubsubComponent:
+		instance = a.pubSubs[comp.Name]
+	case secretStoreComponent:
+		instance = a.secretStores[comp.Name]
+	case stateComponent:
+		instance = a.stateStores[comp.Name]
+	default:
+		return
+	}
+
+	var ping func() error
+	switch p := instance.(type) {
+	case components.Pinger:
+		ping = p.Ping
+	case state.Store:
+		// state stores that can't be pinged are probed with a read, which needs a round trip to their backing service
+		ping = func() error {
+			_, err := p.Get(&state.GetRequest{Key: componentProbeStateKey})
+			return err
+		}
+	}
+	a.componentsHealth.Register(comp.Name, comp.Spec.Type, ping)
+}
+
 func (a *DaprRuntime) doProcessOneComponent(category ComponentCategory, comp components_v1alpha1.Component) error {
 	switch category {
 	case bindingsComponent:
@@ -1357,6 +1732,22 @@ func (a *DaprRuntime) preprocessOneComponent(comp *components_v1alpha1.Component
 // Stop allows for a graceful shutdown of all runtime internal operations or components
 func (a *DaprRuntime) Stop() {
 	log.Info("stop command issued. Shutting down all operations")
+	a.cancel()
+
+	// name resolvers that advertise the instance deregister it
+	if closer, ok := a.nameResolver.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Warnf("error closing name resolution: %s", err)
+		}
+	}
+
+	// flush the spans that haven't been exported yet
+	for _, e := range a.traceExporters {
+		trace.UnregisterExporter(e)
+		if err := e.Close(); err != nil {
+			log.Warnf("error closing trace exporter: %s", err)
+		}
+	}
 }
 
 func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Component) (components_v1alpha1.Component, string) {
@@ -1404,6 +1795,33 @@ func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Comp
 	return component, ""
 }
 
+// onSecretChanged re-initializes the components that reference a changed secret, so they pick up its new value.
+func (a *DaprRuntime) onSecretChanged(storeName, secretName string) {
+	for _, comp := range a.getComponentsReferencingSecret(storeName, secretName) {
+		log.Infof("secret %s in secret store %s changed, re-initializing component %s", secretName, storeName, comp.ObjectMeta.Name)
+		a.pendingComponents <- comp
+	}
+}
+
+func (a *DaprRuntime) getComponentsReferencingSecret(storeName, secretName string) []components_v1alpha1.Component {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+
+	var referencing []components_v1alpha1.Component
+	for _, c := range a.components {
+		if a.authSecretStoreOrDefault(c) != storeName {
+			continue
+		}
+		for _, m := range c.Spec.Metadata {
+			if m.SecretKeyRef.Name == secretName {
+				referencing = append(referencing, c)
+				break
+			}
+		}
+	}
+	return referencing
+}
+
 func (a *DaprRuntime) authSecretStoreOrDefault(comp components_v1alpha1.Component) string {
 	if comp.SecretStore == "" {
 		switch a.runtimeConfig.Mode {
@@ -1422,14 +1840,25 @@ func (a *DaprRuntime) getSecretStore(storeName string) secretstores.SecretStore
 }
 
 func (a *DaprRuntime) blockUntilAppIsReady() {
+	if a.runtimeConfig.AppUnixSocket != "" {
+		log.Infof("application protocol: %s. waiting on unix domain socket %s.  This will block until the app is listening on that socket.", string(a.runtimeConfig.ApplicationProtocol), a.runtimeConfig.AppUnixSocket)
+		waitUntilListening("unix", a.runtimeConfig.AppUnixSocket)
+		log.Infof("application discovered on unix domain socket %s", a.runtimeConfig.AppUnixSocket)
+		return
+	}
+
 	if a.runtimeConfig.ApplicationPort <= 0 {
 		return
 	}
 
 	log.Infof("application protocol: %s. waiting on port %v.  This will block until the app is listening on that port.", string(a.runtimeConfig.ApplicationProtocol), a.runtimeConfig.ApplicationPort)
+	waitUntilListening("tcp", net.JoinHostPort("localhost", fmt.Sprintf("%v", a.runtimeConfig.ApplicationPort)))
+	log.Infof("application discovered on port %v", a.runtimeConfig.ApplicationPort)
+}
 
+func waitUntilListening(network, address string) {
 	for {
-		conn, _ := net.DialTimeout("tcp", net.JoinHostPort("localhost", fmt.Sprintf("%v", a.runtimeConfig.ApplicationPort)), time.Millisecond*500)
+		conn, _ := net.DialTimeout(network, address, time.Millisecond*500)
 		if conn != nil {
 			conn.Close()
 			break
@@ -1437,8 +1866,6 @@ func (a *DaprRuntime) blockUntilAppIsReady() {
 		// prevents overwhelming the OS with open connections
 		time.Sleep(time.Millisecond * 50)
 	}
-
-	log.Infof("application discovered on port %v", a.runtimeConfig.ApplicationPort)
 }
 
 func (a *DaprRuntime) loadAppConfiguration() {
@@ -1505,8 +1932,8 @@ func (a *DaprRuntime) getConfigurationGRPC() (*config.ApplicationConfig, error)
 }
 
 func (a *DaprRuntime) createAppChannel() error {
-	if a.runtimeConfig.ApplicationPort > 0 {
-		var channelCreatorFn func(port, maxConcurrency int, spec config.TracingSpec) (channel.AppChannel, error)
+	if a.runtimeConfig.ApplicationPort > 0 || a.runtimeConfig.AppUnixSocket != "" {
+		var channelCreatorFn func(port, maxConcurrency int, spec config.TracingSpec, requestTimeout time.Duration, conn channel.AppConnection) (channel.AppChannel, error)
 
 		switch a.runtimeConfig.ApplicationProtocol {
 		case GRPCProtocol:
@@ -1517,7 +1944,17 @@ func (a *DaprRuntime) createAppChannel() error {
 			return errors.Errorf("cannot create app channel for protocol %s", string(a.runtimeConfig.ApplicationProtocol))
 		}
 
-		ch, err := channelCreatorFn(a.runtimeConfig.ApplicationPort, a.runtimeConfig.MaxConcurrency, a.globalConfig.Spec.TracingSpec)
+		conn := channel.AppConnection{UnixSocket: a.runtimeConfig.AppUnixSocket}
+		if a.runtimeConfig.AppSSL {
+			tlsConfig, err := channel.NewAppTLSConfig(a.runtimeConfig.AppCACert)
+			if err != nil {
+				return err
+			}
+			conn.TLSConfig = tlsConfig
+		}
+
+		requestTimeout := a.globalConfig.Spec.ServiceInvocation.RequestTimeout(a.runtimeConfig.ID)
+		ch, err := channelCreatorFn(a.runtimeConfig.ApplicationPort, a.runtimeConfig.MaxConcurrency, a.globalConfig.Spec.TracingSpec, requestTimeout, conn)
 		if err != nil {
 			return err
 		}
@@ -1530,6 +1967,44 @@ func (a *DaprRuntime) createAppChannel() error {
 	return nil
 }
 
+// initAppHealth starts probing the health of the app if enabled. The delivery of pub/sub messages and
+// input binding events to the app is paused while the app is unhealthy.
+func (a *DaprRuntime) initAppHealth() {
+	if !a.runtimeConfig.EnableAppHealthCheck || a.appChannel == nil {
+		return
+	}
+	prober, ok := a.appChannel.(channel.HealthProbeAppChannel)
+	if !ok {
+		log.Warnf("app health check is not supported by the %s app channel", a.runtimeConfig.ApplicationProtocol)
+		return
+	}
+
+	path := a.runtimeConfig.AppHealthCheckPath
+	a.appHealth = health.NewAppHealth(func(ctx context.Context) error {
+		return prober.HealthProbe(ctx, path)
+	},
+		health.WithInterval(a.runtimeConfig.AppHealthProbeInterval),
+		health.WithRequestTimeout(a.runtimeConfig.AppHealthProbeTimeout),
+		health.WithFailureThreshold(a.runtimeConfig.AppHealthThreshold))
+	a.appHealth.OnHealthChange(func(healthy bool) {
+		if healthy {
+			log.Info("app is healthy, resuming pub/sub subscriptions and input bindings")
+		} else {
+			log.Warn("app is unhealthy, pausing pub/sub subscriptions and input bindings")
+		}
+	})
+	a.appHealth.Start(a.ctx)
+	log.Infof("app health check enabled. interval: %s, timeout: %s, threshold: %d",
+		a.runtimeConfig.AppHealthProbeInterval, a.runtimeConfig.AppHealthProbeTimeout, a.runtimeConfig.AppHealthThreshold)
+}
+
+// waitUntilAppIsHealthy blocks the delivery of an event to the app while the app is unhealthy, until the runtime shuts down
+func (a *DaprRuntime) waitUntilAppIsHealthy() {
+	if a.appHealth != nil {
+		a.appHealth.WaitUntilHealthy(a.ctx) // nolint: errcheck
+	}
+}
+
 func (a *DaprRuntime) appendBuiltinSecretStore() {
 	for _, comp := range a.builtinSecretStore() {
 		a.pendingComponents <- comp
@@ -1560,8 +2035,9 @@ func (a *DaprRuntime) initSecretStore(c components_v1alpha1.Component) error {
 		return err
 	}
 
+	props := a.convertMetadataItemsToProperties(c.Spec.Metadata)
 	err = secretStore.Init(secretstores.Metadata{
-		Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
+		Properties: props,
 	})
 	if err != nil {
 		log.Warnf("failed to init state store %s named %s: %s", c.Spec.Type, c.ObjectMeta.Name, err)
@@ -1569,6 +2045,27 @@ func (a *DaprRuntime) initSecretStore(c components_v1alpha1.Component) error {
 		return err
 	}
 
+	// stop refreshing the cache of the store being replaced, if any.
+	if existing, ok := a.secretStores[c.ObjectMeta.Name].(secretstores_loader.CachedSecretStore); ok {
+		existing.Close()
+	}
+
+	// cache secrets of this store if "secretCacheTTL" is set in the spec.
+	if ttl := props[secretCacheTTL]; ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil || d <= 0 {
+			log.Warnf("invalid %s %q for secret store %s, secrets will not be cached", secretCacheTTL, ttl, c.ObjectMeta.Name)
+		} else {
+			storeName := c.ObjectMeta.Name
+			secretStore = secretstores_loader.NewCachedSecretStore(secretStore, d, func(secretName string) {
+				a.onSecretChanged(storeName, secretName)
+			}, func(secretName string) bool {
+				return len(a.getComponentsReferencingSecret(storeName, secretName)) > 0
+			})
+			log.Infof("secrets from secret store %s are cached for %s", storeName, d)
+		}
+	}
+
 	a.secretStores[c.ObjectMeta.Name] = secretStore
 	diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 	return nil
@@ -1582,13 +2079,16 @@ func (a *DaprRuntime) convertMetadataItemsToProperties(items []components_v1alph
 	return properties
 }
 
-func (a *DaprRuntime) getComponent(componentType string, name string) *components_v1alpha1.Component {
-	for i, c := range a.components {
+func (a *DaprRuntime) getComponent(componentType string, name string) (components_v1alpha1.Component, bool) {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+
+	for _, c := range a.components {
 		if c.Spec.Type == componentType && c.ObjectMeta.Name == name {
-			return &a.components[i]
+			return c, true
 		}
 	}
-	return nil
+	return components_v1alpha1.Component{}, false
 }
 
 func (a *DaprRuntime) establishSecurity(sentryAddress string) error {
@@ -6,28 +6,37 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/dapr/components-contrib/bindings"
 	comp_exporters "github.com/dapr/components-contrib/exporters"
+	"github.com/dapr/components-contrib/middleware"
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subscriptionsapi "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/components"
+	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
 	"github.com/dapr/dapr/pkg/components/exporters"
+	grpc_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
 	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
 	state_loader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/health"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
 	"github.com/dapr/dapr/pkg/modes"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/security"
@@ -39,6 +48,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opencensus.io/trace"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -173,6 +183,91 @@ func TestProcessComponentsAndDependents(t *testing.T) {
 	})
 }
 
+func TestBuildGRPCPipeline(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.components = []components_v1alpha1.Component{
+		{ObjectMeta: meta_v1.ObjectMeta{Name: "limiter"}, Spec: components_v1alpha1.ComponentSpec{Type: "middleware.grpc.mock"}},
+		{ObjectMeta: meta_v1.ObjectMeta{Name: "broken"}, Spec: components_v1alpha1.ComponentSpec{Type: "middleware.grpc.failing"}},
+	}
+	created := 0
+	rt.grpcMiddlewareRegistry.Register(
+		grpc_middleware_loader.New("mock", func(metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+			created++
+			return grpc_middleware.Middleware{}, nil
+		}),
+		grpc_middleware_loader.New("failing", func(metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+			return grpc_middleware.Middleware{}, errors.New("invalid metadata")
+		}),
+	)
+
+	t.Run("each pipeline gets its own middleware", func(t *testing.T) {
+		rt.globalConfig.Spec.GRPCPipelineSpec.Handlers = []config.HandlerSpec{{Name: "limiter", Type: "middleware.grpc.mock"}}
+
+		first, err := rt.buildGRPCPipeline()
+		assert.NoError(t, err)
+		assert.Len(t, first.Handlers, 1)
+		second, err := rt.buildGRPCPipeline()
+		assert.NoError(t, err)
+		assert.Len(t, second.Handlers, 1)
+		assert.Equal(t, 2, created)
+	})
+
+	t.Run("middleware that fails to be created fails the pipeline", func(t *testing.T) {
+		rt.globalConfig.Spec.GRPCPipelineSpec.Handlers = []config.HandlerSpec{{Name: "broken", Type: "middleware.grpc.failing"}}
+
+		_, err := rt.buildGRPCPipeline()
+		assert.Error(t, err)
+	})
+}
+
+func TestGetRegisteredComponents(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+
+	mockStateStore := new(daprt.MockStateStore)
+	rt.stateStoreRegistry.Register(
+		state_loader.New("mockState", func() state.Store {
+			return mockStateStore
+		}),
+	)
+	mockStateStore.On("Init", state.Metadata{Properties: getFakeProperties()}).Return(nil)
+	mockStateStore.On("Get", &state.GetRequest{Key: componentProbeStateKey}).Return(nil)
+
+	loaded := components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "statestore"},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:     "state.mockState",
+			Version:  "v1",
+			Metadata: getFakeMetadataItems(),
+		},
+	}
+	failed := components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "pubsub"},
+		Spec:       components_v1alpha1.ComponentSpec{Type: "pubsubs.mockPubSub"},
+	}
+	assert.NoError(t, rt.processComponentAndDependents(loaded))
+	assert.Error(t, rt.processComponentAndDependents(failed))
+
+	assert.Equal(t, []components.RegisteredComponent{
+		{Name: "pubsub", Type: "pubsubs.mockPubSub", Status: components.StatusFailed},
+		{Name: "statestore", Type: "state.mockState", Version: "v1", Status: components.StatusLoaded},
+	}, rt.getRegisteredComponents())
+}
+
+func TestGetSubscriptions(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	assert.Empty(t, rt.getSubscriptions())
+
+	rt.topicRoutes = map[string]TopicRoute{
+		"pubsub2": {routes: map[string]string{"topic1": "/route1"}},
+		"pubsub1": {routes: map[string]string{"topic2": "/route2", "topic1": "/route1"}},
+	}
+	assert.Equal(t, []runtime_pubsub.Subscription{
+		{PubsubName: "pubsub1", Topic: "topic1", Route: "/route1"},
+		{PubsubName: "pubsub1", Topic: "topic2", Route: "/route2"},
+		{PubsubName: "pubsub2", Topic: "topic1", Route: "/route1"},
+	}, rt.getSubscriptions())
+}
+
 func TestDoProcessComponent(t *testing.T) {
 	rt := NewTestDaprRuntime(modes.StandaloneMode)
 
@@ -251,6 +346,45 @@ func TestDoProcessComponent(t *testing.T) {
 	})
 }
 
+type mockPingBinding struct {
+	mockBinding
+	err error
+}
+
+func (b *mockPingBinding) Ping() error {
+	return b.err
+}
+
+func TestRegisterComponentHealth(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.outputBindings["mockBinding"] = &mockPingBinding{err: assert.AnError}
+	mockState := new(daprt.MockStateStore)
+	mockState.On("Get", &state.GetRequest{Key: componentProbeStateKey}).Return(nil)
+	rt.stateStores["mockState"] = mockState
+
+	rt.registerComponentHealth(bindingsComponent, components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "mockBinding"},
+		Spec:       components_v1alpha1.ComponentSpec{Type: "bindings.mockPing"},
+	})
+	rt.registerComponentHealth(stateComponent, components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "mockState"},
+		Spec:       components_v1alpha1.ComponentSpec{Type: "state.mockState"},
+	})
+
+	var statuses []health.ComponentStatus
+	for i := 0; i < 100; i++ {
+		_, statuses = rt.componentsHealth.Report()
+		if statuses[0].Status != health.StatusUnknown && statuses[1].Status != health.StatusUnknown {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	assert.Equal(t, []health.ComponentStatus{
+		{Name: "mockBinding", Type: "bindings.mockPing", Status: health.StatusUnhealthy, Error: assert.AnError.Error()},
+		{Name: "mockState", Type: "state.mockState", Status: health.StatusHealthy},
+	}, statuses)
+}
+
 func TestInitState(t *testing.T) {
 	rt := NewTestDaprRuntime(modes.StandaloneMode)
 
@@ -844,6 +978,103 @@ func TestInitSecretStores(t *testing.T) {
 		s := rt.getSecretStore("kubernetesMock")
 		assert.NotNil(t, s)
 	})
+
+	t.Run("secret store with cache", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		m := NewMockKubernetesStore()
+		rt.secretStoresRegistry.Register(
+			secretstores_loader.New("kubernetesMock", func() secretstores.SecretStore {
+				return m
+			}),
+		)
+
+		rt.processComponentAndDependents(components_v1alpha1.Component{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name: "kubernetesMock",
+			},
+			Spec: components_v1alpha1.ComponentSpec{
+				Type: "secretstores.kubernetesMock",
+				Metadata: []components_v1alpha1.MetadataItem{
+					{
+						Name:  "secretCacheTTL",
+						Value: "1m",
+					},
+				},
+			},
+		})
+
+		s, ok := rt.getSecretStore("kubernetesMock").(secretstores_loader.CachedSecretStore)
+		assert.True(t, ok)
+		s.Close()
+	})
+}
+
+func TestGetComponentsReferencingSecret(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.components = []components_v1alpha1.Component{
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "referencing"},
+			Spec: components_v1alpha1.ComponentSpec{
+				Type: "bindings.mock",
+				Metadata: []components_v1alpha1.MetadataItem{
+					{Name: "a", SecretKeyRef: components_v1alpha1.SecretKeyRef{Name: "name1", Key: "key1"}},
+				},
+			},
+			Auth: components_v1alpha1.Auth{SecretStore: "store1"},
+		},
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "otherStore"},
+			Spec: components_v1alpha1.ComponentSpec{
+				Type: "bindings.mock",
+				Metadata: []components_v1alpha1.MetadataItem{
+					{Name: "a", SecretKeyRef: components_v1alpha1.SecretKeyRef{Name: "name1", Key: "key1"}},
+				},
+			},
+			Auth: components_v1alpha1.Auth{SecretStore: "store2"},
+		},
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "otherSecret"},
+			Spec: components_v1alpha1.ComponentSpec{
+				Type: "bindings.mock",
+				Metadata: []components_v1alpha1.MetadataItem{
+					{Name: "a", SecretKeyRef: components_v1alpha1.SecretKeyRef{Name: "name2", Key: "key1"}},
+				},
+			},
+			Auth: components_v1alpha1.Auth{SecretStore: "store1"},
+		},
+	}
+
+	comps := rt.getComponentsReferencingSecret("store1", "name1")
+	assert.Equal(t, 1, len(comps))
+	assert.Equal(t, "referencing", comps[0].ObjectMeta.Name)
+}
+
+func TestGetComponentsReferencingSecretWhileProcessingComponents(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+
+	// secret changes are handled on the refresh goroutine of the cache while components are processed
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			rt.appendOrReplaceComponents(components_v1alpha1.Component{
+				ObjectMeta: meta_v1.ObjectMeta{Name: fmt.Sprintf("comp%d", i)},
+				Spec: components_v1alpha1.ComponentSpec{
+					Type: "bindings.mock",
+					Metadata: []components_v1alpha1.MetadataItem{
+						{Name: "a", SecretKeyRef: components_v1alpha1.SecretKeyRef{Name: "name1", Key: "key1"}},
+					},
+				},
+				Auth: components_v1alpha1.Auth{SecretStore: "store1"},
+			})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		rt.getComponentsReferencingSecret("store1", "name1")
+	}
+	<-done
+
+	assert.Equal(t, 100, len(rt.getComponentsReferencingSecret("store1", "name1")))
 }
 
 func TestMetadataItemsToPropertiesConversion(t *testing.T) {
@@ -1195,6 +1426,22 @@ func TestInitSecretStoresInKubernetesMode(t *testing.T) {
 	assert.Equal(t, "value1", fakeSecretStoreWithAuth.Spec.Metadata[0].Value)
 }
 
+func TestPublishNotAllowedByAccessControlPolicy(t *testing.T) {
+	acl := &config.AccessControlList{
+		DefaultAction:         config.DenyAccess,
+		TrustDomain:           "public",
+		GuardedBuildingBlocks: map[string]bool{config.PubSubBuildingBlock: true},
+	}
+	rt := NewDaprRuntime(&Config{ID: TestRuntimeConfigID}, &config.Configuration{}, acl)
+	rt.pubSubs[TestPubsubName] = &mockPublishPubSub{}
+
+	err := rt.Publish(&pubsub.PublishRequest{
+		PubsubName: TestPubsubName,
+		Topic:      "topic0",
+	})
+	assert.IsType(t, &runtime_pubsub.NotAllowedError{}, err)
+}
+
 func TestOnNewPublishedMessage(t *testing.T) {
 	topic := "topic1"
 
@@ -1494,6 +1741,100 @@ func TestInvokeOutputBindings(t *testing.T) {
 	})
 }
 
+type mockStreamingBinding struct {
+	mockOutputBinding
+	streamed []byte
+}
+
+func (b *mockStreamingBinding) InvokeStream(req *bindings_loader.StreamInvokeRequest) (*bindings_loader.StreamInvokeResponse, error) {
+	data, err := ioutil.ReadAll(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	b.streamed = data
+	return &bindings_loader.StreamInvokeResponse{Data: ioutil.NopCloser(strings.NewReader("streamed"))}, nil
+}
+
+func TestSendBatchOutputBindingsWithBindingsScopes(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	allowed := &mockOutputBinding{}
+	denied := &mockOutputBinding{}
+	rt.outputBindings["allowed"] = allowed
+	rt.outputBindings["denied"] = denied
+	rt.globalConfig.Spec.Bindings.Scopes = []config.BindingsScope{
+		{
+			BindingName: "denied",
+			AppPolicies: []config.BindingAppPolicy{
+				{AppID: rt.runtimeConfig.ID, DeniedOperations: []string{string(bindings.CreateOperation)}},
+			},
+		},
+	}
+	rt.populateBindingsConfiguration()
+
+	err := rt.sendBatchOutputBindingsSequential([]string{"allowed", "denied"}, []byte("data"), trace.SpanContext{})
+	assert.Error(t, err)
+	assert.Equal(t, 1, len(allowed.requests))
+	assert.Empty(t, denied.requests)
+}
+
+func TestInvokeOutputBindingsStream(t *testing.T) {
+	t.Run("streaming binding receives the stream", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		binding := &mockStreamingBinding{}
+		rt.outputBindings["mockBinding"] = binding
+
+		resp, err := rt.sendToOutputBindingStream("mockBinding", &bindings_loader.StreamInvokeRequest{
+			Data:      strings.NewReader("payload"),
+			Operation: bindings.CreateOperation,
+		})
+		assert.NoError(t, err)
+		data, _ := ioutil.ReadAll(resp.Data)
+		assert.Equal(t, "streamed", string(data))
+		assert.Equal(t, "payload", string(binding.streamed))
+		assert.Empty(t, binding.requests)
+	})
+
+	t.Run("non-streaming binding receives buffered payload", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		binding := &mockOutputBinding{}
+		rt.outputBindings["mockBinding"] = binding
+
+		_, err := rt.sendToOutputBindingStream("mockBinding", &bindings_loader.StreamInvokeRequest{
+			Data:      strings.NewReader("payload"),
+			Operation: bindings.CreateOperation,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(binding.requests))
+		assert.Equal(t, "payload", string(binding.requests[0].Data))
+	})
+
+	t.Run("payload exceeding buffer size", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		binding := &mockOutputBinding{}
+		rt.outputBindings["mockBinding"] = binding
+		rt.outputBindingStreamBufferSizes["mockBinding"] = getMaxStreamBufferSize("mockBinding", map[string]string{
+			maxStreamBufferSize: "4",
+		})
+
+		_, err := rt.sendToOutputBindingStream("mockBinding", &bindings_loader.StreamInvokeRequest{
+			Data:      strings.NewReader("payload"),
+			Operation: bindings.CreateOperation,
+		})
+		assert.Error(t, err)
+		assert.Empty(t, binding.requests)
+	})
+
+	t.Run("output binding invalid operation", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.outputBindings["mockBinding"] = &mockStreamingBinding{}
+
+		_, err := rt.sendToOutputBindingStream("mockBinding", &bindings_loader.StreamInvokeRequest{
+			Operation: bindings.GetOperation,
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestReadInputBindings(t *testing.T) {
 	t.Run("app acknowledge, no retry", func(t *testing.T) {
 		rt := NewTestDaprRuntime(modes.StandaloneMode)
@@ -1519,6 +1860,47 @@ func TestReadInputBindings(t *testing.T) {
 		assert.False(t, b.hasError)
 	})
 
+	t.Run("delivery paused while app is unhealthy", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeResp.WithRawData([]byte("OK"), "application/json")
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything).Return(fakeResp, nil)
+
+		var healthy int32
+		rt.appHealth = health.NewAppHealth(func(ctx context.Context) error {
+			if atomic.LoadInt32(&healthy) == 1 {
+				return nil
+			}
+			return assert.AnError
+		}, health.WithInitialDelay(0), health.WithInterval(time.Millisecond*10), health.WithFailureThreshold(1))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		rt.appHealth.Start(ctx)
+		for rt.appHealth.IsHealthy() {
+			time.Sleep(time.Millisecond * 5)
+		}
+
+		done := make(chan struct{})
+		go func() {
+			rt.readFromBinding("test", &mockBinding{})
+			close(done)
+		}()
+
+		time.Sleep(time.Millisecond * 50)
+		mockAppChannel.AssertNotCalled(t, "InvokeMethod", mock.Anything, mock.Anything)
+
+		atomic.StoreInt32(&healthy, 1)
+		select {
+		case <-done:
+			mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+		case <-time.After(time.Second * 2):
+			assert.Fail(t, "binding event wasn't delivered when the app became healthy")
+		}
+	})
+
 	t.Run("app returns error", func(t *testing.T) {
 		rt := NewTestDaprRuntime(modes.StandaloneMode)
 		mockAppChannel := new(channelt.MockAppChannel)
@@ -1565,6 +1947,211 @@ func TestReadInputBindings(t *testing.T) {
 
 		assert.Equal(t, "test", b.data)
 	})
+
+	t.Run("binding metadata carries trace context", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.globalConfig.Spec.TracingSpec.SamplingRate = "1"
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		traceparent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+		fakeReq := invokev1.NewInvokeMethodRequest("test")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+		fakeReq.WithRawData([]byte("test"), "application/json")
+		fakeReq.WithMetadata(map[string][]string{"bindings": {"input"}})
+
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeResp.WithRawData([]byte("OK"), "application/json")
+
+		continuesTrace := mock.MatchedBy(func(ctx context.Context) bool {
+			span := trace.FromContext(ctx)
+			return span != nil && span.SpanContext().TraceID.String() == "0af7651916cd43dd8448eb211c80319c"
+		})
+		mockAppChannel.On("InvokeMethod", continuesTrace, fakeReq).Return(fakeResp, nil)
+
+		b := mockBinding{metadata: map[string]string{"bindings": "input", "Traceparent": traceparent}}
+		rt.readFromBinding("test", &b)
+
+		assert.False(t, b.hasError)
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	})
+
+	t.Run("app returns error, retried and sent to dead letter", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeReq := invokev1.NewInvokeMethodRequest("test")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+		fakeReq.WithRawData([]byte("test"), "application/json")
+		fakeReq.WithMetadata(map[string][]string{})
+
+		fakeResp := invokev1.NewInvokeMethodResponse(503, "Service Unavailable", nil)
+		fakeResp.WithRawData([]byte("Service Unavailable"), "application/json")
+
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), fakeReq).Return(fakeResp, nil)
+
+		deadLetter := &mockOutputBinding{}
+		rt.outputBindings["deadletter"] = deadLetter
+		rt.setBindingDeliveryPolicy("test", newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeliveryMaxConcurrency: "2",
+			bindingDeliveryMaxRetries:     "2",
+			bindingDeliveryRetryInterval:  "1ms",
+			bindingDeadLetter:             "deadletter",
+		}))
+
+		b := mockBinding{}
+		rt.readFromBinding("test", &b)
+
+		assert.False(t, b.hasError)
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 3)
+		assert.Equal(t, 1, len(deadLetter.requests))
+		assert.Equal(t, "test", string(deadLetter.requests[0].Data))
+		assert.Equal(t, "test", deadLetter.requests[0].Metadata[deadLetterSourceBinding])
+	})
+
+	t.Run("dead letter denied by bindings configuration", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(503, "Service Unavailable", nil)
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(fakeResp, nil)
+
+		deadLetter := &mockOutputBinding{}
+		rt.outputBindings["deadletter"] = deadLetter
+		rt.globalConfig.Spec.Bindings.Scopes = []config.BindingsScope{
+			{
+				BindingName: "deadletter",
+				AppPolicies: []config.BindingAppPolicy{
+					{AppID: rt.runtimeConfig.ID, DeniedOperations: []string{string(bindings.CreateOperation)}},
+				},
+			},
+		}
+		rt.populateBindingsConfiguration()
+		rt.setBindingDeliveryPolicy("test", newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeadLetter: "deadletter",
+		}))
+
+		b := mockBinding{}
+		rt.readFromBinding("test", &b)
+
+		assert.True(t, b.hasError)
+		assert.Empty(t, deadLetter.requests)
+	})
+
+	t.Run("retries stop on shutdown", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(503, "Service Unavailable", nil)
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(fakeResp, nil)
+
+		rt.setBindingDeliveryPolicy("test", newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeliveryMaxRetries:    "5",
+			bindingDeliveryRetryInterval: "1h",
+		}))
+
+		done := make(chan struct{})
+		b := mockBinding{}
+		go func() {
+			rt.readFromBinding("test", &b)
+			close(done)
+		}()
+		rt.Stop()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "retries didn't stop on shutdown")
+		}
+		assert.True(t, b.hasError)
+	})
+
+	t.Run("async delivery acknowledges events once a slot is acquired", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		delivered := make(chan struct{})
+		fakeResp := invokev1.NewInvokeMethodResponse(503, "Service Unavailable", nil)
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.Anything).
+			Run(func(args mock.Arguments) { close(delivered) }).
+			Return(fakeResp, nil)
+
+		rt.setBindingDeliveryPolicy("test", newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeliveryMaxConcurrency: "2",
+			bindingDeliveryAsync:          "true",
+		}))
+
+		b := mockBinding{}
+		rt.readFromBinding("test", &b)
+		<-delivered
+
+		assert.False(t, b.hasError)
+	})
+}
+
+type mockOutputBinding struct {
+	requests []*bindings.InvokeRequest
+}
+
+func (b *mockOutputBinding) Init(metadata bindings.Metadata) error {
+	return nil
+}
+
+func (b *mockOutputBinding) Operations() []bindings.OperationKind {
+	return []bindings.OperationKind{bindings.CreateOperation}
+}
+
+func (b *mockOutputBinding) Invoke(req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+	b.requests = append(b.requests, req)
+	return nil, nil
+}
+
+func TestNewBindingDeliveryPolicy(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		policy := newBindingDeliveryPolicy("test", map[string]string{})
+		assert.Nil(t, policy.limiter)
+		assert.Equal(t, 0, policy.maxRetries)
+		assert.Empty(t, policy.deadLetterBinding)
+	})
+
+	t.Run("invalid values are ignored", func(t *testing.T) {
+		policy := newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeliveryMaxConcurrency: "-1",
+			bindingDeliveryMaxRetries:     "a",
+			bindingDeliveryRetryInterval:  "1",
+		})
+		assert.Nil(t, policy.limiter)
+		assert.Equal(t, 0, policy.maxRetries)
+		assert.Equal(t, time.Second, policy.retryInterval)
+	})
+
+	t.Run("async requires a concurrency limit", func(t *testing.T) {
+		policy := newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeliveryAsync: "true",
+		})
+		assert.False(t, policy.async)
+
+		policy = newBindingDeliveryPolicy("test", map[string]string{
+			bindingDeliveryMaxConcurrency: "4",
+			bindingDeliveryAsync:          "true",
+		})
+		assert.True(t, policy.async)
+	})
+}
+
+func TestBindingDeliveryPolicyFollowsReinit(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	assert.Nil(t, rt.getBindingDeliveryPolicy("test").limiter)
+
+	rt.setBindingDeliveryPolicy("test", newBindingDeliveryPolicy("test", map[string]string{bindingDeliveryMaxRetries: "1"}))
+	assert.Equal(t, 1, rt.getBindingDeliveryPolicy("test").maxRetries)
+
+	rt.setBindingDeliveryPolicy("test", newBindingDeliveryPolicy("test", map[string]string{bindingDeliveryMaxRetries: "3"}))
+	assert.Equal(t, 3, rt.getBindingDeliveryPolicy("test").maxRetries)
 }
 
 func TestNamespace(t *testing.T) {
@@ -0,0 +1,236 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package security
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/pkg/errors"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// AuthorizationHeader is the header name for http/gRPC calls to hold the bearer token of the caller
+	AuthorizationHeader = "authorization"
+	// CallerClaimHeaderPrefix prefixes the headers the verified claims of the caller are forwarded to the app with
+	CallerClaimHeaderPrefix = "dapr-caller-"
+
+	bearerPrefix = "bearer "
+	// minJWKSReloadInterval limits how often the JWKS document is reloaded for tokens signed with unknown keys
+	minJWKSReloadInterval = time.Minute
+	jwksRequestTimeout    = time.Second * 10
+)
+
+var (
+	// ErrMissingBearerToken is returned when a call has no bearer token
+	ErrMissingBearerToken = errors.New("authentication error: missing bearer token")
+	// ErrInvalidBearerToken is returned when a bearer token can't be verified
+	ErrInvalidBearerToken = errors.New("authentication error: invalid bearer token")
+)
+
+// JWTAuthenticator verifies the bearer JWTs of the callers of the Dapr APIs with the keys of a JWKS document.
+type JWTAuthenticator struct {
+	issuers         []string
+	audiences       []string
+	forwardedClaims []string
+	loadJWKS        func() ([]byte, error)
+
+	lock     sync.RWMutex
+	keys     jose.JSONWebKeySet
+	loadedAt time.Time
+}
+
+// NewJWTAuthenticator returns an authenticator for spec, or nil if JWT authentication is not enabled.
+// The JWKS document is loaded from its file or URL, and reloaded when a token is signed with an unknown key.
+func NewJWTAuthenticator(spec config.JWTAuthenticationSpec) (*JWTAuthenticator, error) {
+	if len(spec.Issuers) == 0 {
+		return nil, nil
+	}
+
+	a := &JWTAuthenticator{
+		issuers:         spec.Issuers,
+		audiences:       spec.Audiences,
+		forwardedClaims: spec.ForwardedClaims,
+	}
+	if spec.JWKSFile != "" {
+		a.loadJWKS = func() ([]byte, error) {
+			return ioutil.ReadFile(spec.JWKSFile)
+		}
+	} else {
+		a.loadJWKS = func() ([]byte, error) {
+			return fetchJWKS(spec.JWKSURL)
+		}
+	}
+
+	if err := a.reloadKeys(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Authenticate verifies the bearer token of an authorization header value and returns the claims of the caller:
+// the subject, issuer and audience as sub, iss and aud, and the forwarded custom claims.
+func (a *JWTAuthenticator) Authenticate(authorization string) (map[string]string, error) {
+	if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return nil, ErrMissingBearerToken
+	}
+
+	token, err := jwt.ParseSigned(strings.TrimSpace(authorization[len(bearerPrefix):]))
+	if err != nil || len(token.Headers) == 0 {
+		return nil, ErrInvalidBearerToken
+	}
+
+	var claims jwt.Claims
+	custom := map[string]interface{}{}
+	if !a.verify(token, token.Headers[0].KeyID, &claims, &custom) {
+		return nil, ErrInvalidBearerToken
+	}
+
+	if err := claims.ValidateWithLeeway(jwt.Expected{Time: time.Now()}, jwt.DefaultLeeway); err != nil {
+		return nil, errors.Wrap(ErrInvalidBearerToken, err.Error())
+	}
+	if !containsString(a.issuers, claims.Issuer) {
+		return nil, errors.Wrapf(ErrInvalidBearerToken, "untrusted issuer %s", claims.Issuer)
+	}
+	if len(a.audiences) > 0 && !containsAny(claims.Audience, a.audiences) {
+		return nil, errors.Wrap(ErrInvalidBearerToken, "invalid audience")
+	}
+
+	callerClaims := map[string]string{
+		"sub": claims.Subject,
+		"iss": claims.Issuer,
+	}
+	if len(claims.Audience) > 0 {
+		callerClaims["aud"] = strings.Join(claims.Audience, ",")
+	}
+	for _, name := range a.forwardedClaims {
+		if v, ok := custom[name]; ok {
+			callerClaims[name] = claimString(v)
+		}
+	}
+	return callerClaims, nil
+}
+
+// verify checks the signature of token with the keys with id kid, or all the keys if the token has no key id,
+// and decodes its claims into out.
+func (a *JWTAuthenticator) verify(token *jwt.JSONWebToken, kid string, out ...interface{}) bool {
+	for _, key := range a.verificationKeys(kid) {
+		if err := token.Claims(key.Key, out...); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *JWTAuthenticator) verificationKeys(kid string) []jose.JSONWebKey {
+	a.lock.RLock()
+	keys, loadedAt := a.keys, a.loadedAt
+	a.lock.RUnlock()
+
+	if kid == "" {
+		return keys.Keys
+	}
+	if found := keys.Key(kid); len(found) > 0 || time.Since(loadedAt) < minJWKSReloadInterval {
+		return found
+	}
+
+	// the key may have been rotated since the document was loaded
+	if err := a.reloadKeys(); err != nil {
+		log.Warnf("error reloading jwks document: %s", err)
+		return nil
+	}
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.keys.Key(kid)
+}
+
+func (a *JWTAuthenticator) reloadKeys() error {
+	data, err := a.loadJWKS()
+	if err != nil {
+		return errors.Wrap(err, "error loading jwks document")
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return errors.Wrap(err, "error parsing jwks document")
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.keys = keys
+	a.loadedAt = time.Now()
+	return nil
+}
+
+func fetchJWKS(url string) ([]byte, error) {
+	client := http.Client{Timeout: jwksRequestTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code %d fetching %s", resp.StatusCode, url)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// CallerClaimHeader returns the name of the header the claim of the caller is forwarded with.
+func CallerClaimHeader(claim string) string {
+	return CallerClaimHeaderPrefix + strings.ToLower(claim)
+}
+
+// IsCallerClaimHeader returns whether a header or metadata key holds a forwarded claim of the caller.
+func IsCallerClaimHeader(key string) bool {
+	return len(key) > len(CallerClaimHeaderPrefix) && strings.EqualFold(key[:len(CallerClaimHeaderPrefix)], CallerClaimHeaderPrefix)
+}
+
+// CallerClaimsFromMetadata returns the forwarded claims of the caller in the metadata of a call.
+func CallerClaimsFromMetadata(md map[string][]string) map[string]string {
+	var claims map[string]string
+	for key, values := range md {
+		if !IsCallerClaimHeader(key) || len(values) == 0 {
+			continue
+		}
+		if claims == nil {
+			claims = map[string]string{}
+		}
+		claims[strings.ToLower(key[len(CallerClaimHeaderPrefix):])] = values[0]
+	}
+	return claims
+}
+
+func claimString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values, candidates []string) bool {
+	for _, c := range candidates {
+		if containsString(values, c) {
+			return true
+		}
+	}
+	return false
+}
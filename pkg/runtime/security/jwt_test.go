@@ -0,0 +1,176 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+func writeTestJWKS(t *testing.T, kid string, key *rsa.PrivateKey) string {
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"},
+	}}
+	b, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	f, err := ioutil.TempFile("", "jwks")
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.Write(b)
+	require.NoError(t, err)
+	return f.Name()
+}
+
+func signTestJWT(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.Claims, custom map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid))
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Claims(custom).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksFile := writeTestJWKS(t, "key1", key)
+	defer os.Remove(jwksFile)
+
+	authenticator, err := NewJWTAuthenticator(config.JWTAuthenticationSpec{
+		Issuers:         []string{testIssuer},
+		Audiences:       []string{"dapr"},
+		JWKSFile:        jwksFile,
+		ForwardedClaims: []string{"role", "groups"},
+	})
+	require.NoError(t, err)
+
+	validClaims := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "user1",
+		Audience: jwt.Audience{"dapr", "other"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+	custom := map[string]interface{}{"role": "admin", "groups": []string{"a", "b"}, "email": "user1@example.com"}
+
+	t.Run("valid token", func(t *testing.T) {
+		token := signTestJWT(t, "key1", key, validClaims, custom)
+		claims, err := authenticator.Authenticate("Bearer " + token)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"sub":    "user1",
+			"iss":    testIssuer,
+			"aud":    "dapr,other",
+			"role":   "admin",
+			"groups": `["a","b"]`,
+		}, claims)
+	})
+
+	t.Run("missing bearer token", func(t *testing.T) {
+		_, err := authenticator.Authenticate("")
+		assert.Equal(t, ErrMissingBearerToken, err)
+
+		_, err = authenticator.Authenticate("Basic dXNlcjpwYXNz")
+		assert.Equal(t, ErrMissingBearerToken, err)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		_, err := authenticator.Authenticate("Bearer not-a-jwt")
+		assert.Equal(t, ErrInvalidBearerToken, err)
+	})
+
+	t.Run("untrusted signing key", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		token := signTestJWT(t, "key1", otherKey, validClaims, custom)
+		_, err = authenticator.Authenticate("Bearer " + token)
+		assert.Equal(t, ErrInvalidBearerToken, err)
+	})
+
+	t.Run("untrusted issuer", func(t *testing.T) {
+		claims := validClaims
+		claims.Issuer = "https://other.example.com"
+
+		token := signTestJWT(t, "key1", key, claims, custom)
+		_, err := authenticator.Authenticate("Bearer " + token)
+		assert.Equal(t, ErrInvalidBearerToken, errors.Cause(err))
+	})
+
+	t.Run("invalid audience", func(t *testing.T) {
+		claims := validClaims
+		claims.Audience = jwt.Audience{"other"}
+
+		token := signTestJWT(t, "key1", key, claims, custom)
+		_, err := authenticator.Authenticate("Bearer " + token)
+		assert.Equal(t, ErrInvalidBearerToken, errors.Cause(err))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := validClaims
+		claims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+		token := signTestJWT(t, "key1", key, claims, custom)
+		_, err := authenticator.Authenticate("Bearer " + token)
+		assert.Equal(t, ErrInvalidBearerToken, errors.Cause(err))
+	})
+
+	t.Run("rotated signing key", func(t *testing.T) {
+		newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		newJWKSFile := writeTestJWKS(t, "key2", newKey)
+		defer os.Remove(newJWKSFile)
+
+		authenticator.loadJWKS = func() ([]byte, error) {
+			return ioutil.ReadFile(newJWKSFile)
+		}
+		token := signTestJWT(t, "key2", newKey, validClaims, custom)
+
+		// the document was just loaded, so it's not reloaded yet
+		_, err = authenticator.Authenticate("Bearer " + token)
+		assert.Equal(t, ErrInvalidBearerToken, err)
+
+		authenticator.loadedAt = time.Now().Add(-minJWKSReloadInterval)
+		_, err = authenticator.Authenticate("Bearer " + token)
+		assert.NoError(t, err)
+	})
+}
+
+func TestNewJWTAuthenticator(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		authenticator, err := NewJWTAuthenticator(config.JWTAuthenticationSpec{})
+		assert.NoError(t, err)
+		assert.Nil(t, authenticator)
+	})
+
+	t.Run("missing jwks file", func(t *testing.T) {
+		_, err := NewJWTAuthenticator(config.JWTAuthenticationSpec{Issuers: []string{testIssuer}, JWKSFile: "missing.json"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCallerClaimsFromMetadata(t *testing.T) {
+	md := map[string][]string{
+		"Dapr-Caller-Sub":  {"user1"},
+		"dapr-caller-role": {"admin"},
+		"dapr-caller-":     {"empty"},
+		"authorization":    {"Bearer token"},
+	}
+	assert.Equal(t, map[string]string{"sub": "user1", "role": "admin"}, CallerClaimsFromMetadata(md))
+	assert.Nil(t, CallerClaimsFromMetadata(map[string][]string{"authorization": {"Bearer token"}}))
+	assert.Equal(t, "dapr-caller-role", CallerClaimHeader("Role"))
+}
@@ -1,8 +1,14 @@
 package security
 
 import (
+	"crypto/subtle"
 	"os"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
 )
 
 /* #nosec */
@@ -13,8 +19,78 @@ const (
 	APITokenHeader = "dapr-api-token"
 )
 
+// Scopes of the api tokens, named after the building blocks of the Dapr APIs
+const (
+	InvokeScope   = "invoke"
+	StateScope    = "state"
+	PubSubScope   = "pubsub"
+	BindingsScope = "bindings"
+	SecretsScope  = "secrets"
+	ActorsScope   = "actors"
+	MetadataScope = "metadata"
+)
+
+// daprGRPCService is the name of the gRPC service of the Dapr API
+const daprGRPCService = "/dapr.proto.runtime.v1.Dapr/"
+
 var excludedRoutes = []string{"/healthz"}
 
+// httpRouteScopes maps the first segment of the versioned routes of the Dapr HTTP API to scopes
+var httpRouteScopes = map[string]string{
+	"invoke":   InvokeScope,
+	"state":    StateScope,
+	"publish":  PubSubScope,
+	"bindings": BindingsScope,
+	"secrets":  SecretsScope,
+	"actors":   ActorsScope,
+	"metadata": MetadataScope,
+}
+
+// grpcMethodScopes maps the methods of the Dapr gRPC API to scopes
+var grpcMethodScopes = map[string]string{
+	"InvokeService":           InvokeScope,
+	"GetState":                StateScope,
+	"GetBulkState":            StateScope,
+	"SaveState":               StateScope,
+	"DeleteState":             StateScope,
+	"ExecuteStateTransaction": StateScope,
+	"PublishEvent":            PubSubScope,
+	"InvokeBinding":           BindingsScope,
+	"InvokeBindingStream":     BindingsScope,
+	"GetSecret":               SecretsScope,
+	"GetBulkSecret":           SecretsScope,
+	"GetMetadata":             MetadataScope,
+}
+
+var (
+	// ErrAPITokenMismatch is returned when a token is not valid
+	ErrAPITokenMismatch = errors.New("authentication error: api token mismatch")
+	// ErrAPITokenScope is returned when a valid token is not allowed to call an API
+	ErrAPITokenScope = errors.New("authorization error: api token is not allowed to call this api")
+)
+
+// APIToken is a token that authenticates calls to the APIs of the building blocks in its scopes,
+// or to all the APIs if it has no scopes
+type APIToken struct {
+	Token  string   `json:"token" yaml:"token"`
+	Scopes []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+}
+
+type apiTokens struct {
+	Tokens []APIToken `json:"tokens" yaml:"tokens"`
+}
+
+// APITokenStore holds the api tokens that are valid at a time. Several tokens can be valid at once,
+// so that a token can be rotated without downtime by loading a new set of tokens.
+type APITokenStore struct {
+	lock    sync.RWMutex
+	enabled bool
+	// static is the token of the environment variable, which is valid for all the APIs
+	static string
+	// tokens are the tokens loaded from the token source
+	tokens []APIToken
+}
+
 // GetAPIToken returns the value of the api token from an environment variable
 func GetAPIToken() string {
 	return os.Getenv(APITokenEnvVar)
@@ -29,3 +105,130 @@ func ExcludedRoute(route string) bool {
 	}
 	return false
 }
+
+// HTTPRouteScope returns the scope a token needs to call a route of the Dapr HTTP API, e.g. /v1.0/state/store1,
+// or an empty string if only the tokens without scopes can call it.
+func HTTPRouteScope(route string) string {
+	parts := strings.SplitN(strings.TrimPrefix(route, "/"), "/", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return httpRouteScopes[parts[1]]
+}
+
+// GRPCMethodScope returns the scope a token needs to call a full method of the Dapr gRPC API.
+// Methods of other services are proxied to apps, so they need the invoke scope.
+func GRPCMethodScope(fullMethod string) string {
+	if !strings.HasPrefix(fullMethod, daprGRPCService) {
+		return InvokeScope
+	}
+	return grpcMethodScopes[strings.TrimPrefix(fullMethod, daprGRPCService)]
+}
+
+// ParseAPITokens parses a YAML or JSON document with a list of tokens.
+func ParseAPITokens(data []byte) ([]APIToken, error) {
+	var doc apiTokens
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrap(err, "error parsing api tokens")
+	}
+	for _, t := range doc.Tokens {
+		if t.Token == "" {
+			return nil, errors.New("error parsing api tokens: empty token")
+		}
+	}
+	return doc.Tokens, nil
+}
+
+// NewAPITokenStore returns a token store where token, if not empty, is valid for all the APIs.
+func NewAPITokenStore(token string) *APITokenStore {
+	return &APITokenStore{
+		enabled: token != "",
+		static:  token,
+	}
+}
+
+// Enabled returns whether the calls to the APIs must be authenticated with a token.
+func (s *APITokenStore) Enabled() bool {
+	if s == nil {
+		return false
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.enabled
+}
+
+// SetTokens replaces the tokens loaded from the token source. Once set, calls must be authenticated
+// even if there are no tokens.
+func (s *APITokenStore) SetTokens(tokens []APIToken) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.tokens = tokens
+	s.enabled = true
+}
+
+// Authorize returns an error if token is not valid or not allowed to call the APIs of scope.
+// APIs without a scope can only be called with tokens without scopes.
+func (s *APITokenStore) Authorize(token, scope string) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.static != "" && tokensEqual(token, s.static) {
+		return nil
+	}
+	for _, t := range s.tokens {
+		if !tokensEqual(token, t.Token) {
+			continue
+		}
+		if len(t.Scopes) == 0 {
+			return nil
+		}
+		for _, allowed := range t.Scopes {
+			if scope != "" && allowed == scope {
+				return nil
+			}
+		}
+		return ErrAPITokenScope
+	}
+	return ErrAPITokenMismatch
+}
+
+// Watch loads the tokens with load and reloads them every interval until stopCh is closed.
+// The current tokens are kept if reloading fails, so that a transient error does not lock out callers.
+func (s *APITokenStore) Watch(load func() ([]byte, error), interval time.Duration, stopCh <-chan struct{}) error {
+	if err := s.reload(load); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+				if err := s.reload(load); err != nil {
+					log.Warnf("error reloading api tokens, keeping the current tokens: %s", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *APITokenStore) reload(load func() ([]byte, error)) error {
+	data, err := load()
+	if err != nil {
+		return errors.Wrap(err, "error loading api tokens")
+	}
+	tokens, err := ParseAPITokens(data)
+	if err != nil {
+		return err
+	}
+	s.SetTokens(tokens)
+	return nil
+}
+
+func tokensEqual(token, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1
+}
@@ -3,7 +3,9 @@ package security
 import (
 	"os"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,3 +39,103 @@ func TestExcludedRoute(t *testing.T) {
 		assert.False(t, excluded)
 	})
 }
+
+func TestParseAPITokens(t *testing.T) {
+	t.Run("yaml tokens", func(t *testing.T) {
+		tokens, err := ParseAPITokens([]byte(`
+tokens:
+- token: "1234"
+- token: "5678"
+  scopes: [state, pubsub]
+`))
+		assert.NoError(t, err)
+		assert.Equal(t, []APIToken{
+			{Token: "1234"},
+			{Token: "5678", Scopes: []string{StateScope, PubSubScope}},
+		}, tokens)
+	})
+
+	t.Run("json tokens", func(t *testing.T) {
+		tokens, err := ParseAPITokens([]byte(`{"tokens": [{"token": "1234", "scopes": ["secrets"]}]}`))
+		assert.NoError(t, err)
+		assert.Equal(t, []APIToken{{Token: "1234", Scopes: []string{SecretsScope}}}, tokens)
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		_, err := ParseAPITokens([]byte(`tokens: [{scopes: [state]}]`))
+		assert.Error(t, err)
+	})
+}
+
+func TestAPITokenStore(t *testing.T) {
+	t.Run("no tokens", func(t *testing.T) {
+		store := NewAPITokenStore("")
+		assert.False(t, store.Enabled())
+	})
+
+	t.Run("static token", func(t *testing.T) {
+		store := NewAPITokenStore("1234")
+		assert.True(t, store.Enabled())
+		assert.NoError(t, store.Authorize("1234", StateScope))
+		assert.NoError(t, store.Authorize("1234", ""))
+		assert.Equal(t, ErrAPITokenMismatch, store.Authorize("5678", StateScope))
+	})
+
+	t.Run("rotated tokens", func(t *testing.T) {
+		store := NewAPITokenStore("")
+		store.SetTokens([]APIToken{{Token: "old"}, {Token: "new"}})
+		assert.True(t, store.Enabled())
+		assert.NoError(t, store.Authorize("old", InvokeScope))
+		assert.NoError(t, store.Authorize("new", InvokeScope))
+
+		store.SetTokens([]APIToken{{Token: "new"}})
+		assert.Equal(t, ErrAPITokenMismatch, store.Authorize("old", InvokeScope))
+		assert.NoError(t, store.Authorize("new", InvokeScope))
+	})
+
+	t.Run("scoped tokens", func(t *testing.T) {
+		store := NewAPITokenStore("")
+		store.SetTokens([]APIToken{{Token: "1234", Scopes: []string{StateScope}}})
+		assert.NoError(t, store.Authorize("1234", StateScope))
+		assert.Equal(t, ErrAPITokenScope, store.Authorize("1234", PubSubScope))
+		assert.Equal(t, ErrAPITokenScope, store.Authorize("1234", ""))
+	})
+
+	t.Run("watched tokens", func(t *testing.T) {
+		data := []byte(`tokens: [{token: "1234"}]`)
+		load := func() ([]byte, error) {
+			return data, nil
+		}
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+
+		store := NewAPITokenStore("")
+		assert.NoError(t, store.Watch(load, time.Millisecond, stopCh))
+		assert.NoError(t, store.Authorize("1234", StateScope))
+	})
+
+	t.Run("watch fails to load tokens", func(t *testing.T) {
+		load := func() ([]byte, error) {
+			return nil, errors.New("file not found")
+		}
+		store := NewAPITokenStore("")
+		assert.Error(t, store.Watch(load, time.Millisecond, nil))
+	})
+}
+
+func TestRouteScopes(t *testing.T) {
+	t.Run("http routes", func(t *testing.T) {
+		assert.Equal(t, StateScope, HTTPRouteScope("/v1.0/state/store1/key1"))
+		assert.Equal(t, PubSubScope, HTTPRouteScope("/v1.0/publish/pubsub1/topic1"))
+		assert.Equal(t, InvokeScope, HTTPRouteScope("/v1.0/invoke/app1/method/method1"))
+		assert.Equal(t, "", HTTPRouteScope("/v1.0/unknown"))
+		assert.Equal(t, "", HTTPRouteScope("/"))
+	})
+
+	t.Run("grpc methods", func(t *testing.T) {
+		assert.Equal(t, StateScope, GRPCMethodScope("/dapr.proto.runtime.v1.Dapr/SaveState"))
+		assert.Equal(t, BindingsScope, GRPCMethodScope("/dapr.proto.runtime.v1.Dapr/InvokeBindingStream"))
+		assert.Equal(t, MetadataScope, GRPCMethodScope("/dapr.proto.runtime.v1.Dapr/GetMetadata"))
+		assert.Equal(t, InvokeScope, GRPCMethodScope("/myapp.Greeter/SayHello"))
+	})
+}
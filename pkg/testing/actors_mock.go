@@ -220,3 +220,12 @@ func (_m *MockActors) GetActiveActorsCount(ctx context.Context) []actors.ActiveA
 		},
 	}
 }
+
+// GetActorRuntimeMetadata provides a mock function
+func (_m *MockActors) GetActorRuntimeMetadata() actors.ActorRuntimeMetadata {
+	_m.Called()
+	return actors.ActorRuntimeMetadata{
+		HostedActorTypes:      []string{"abcd", "xyz"},
+		PlacementTableVersion: "1",
+	}
+}
@@ -4,6 +4,9 @@ package testing
 
 import (
 	context "context"
+	io "io"
+
+	grpc "google.golang.org/grpc"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -37,3 +40,49 @@ func (_m *MockDirectMessaging) Invoke(ctx context.Context, targetAppID string, r
 
 	return r0, r1
 }
+
+// InvokeStream provides a mock function with given fields: ctx, targetAppID, req, body
+func (_m *MockDirectMessaging) InvokeStream(ctx context.Context, targetAppID string, req *v1.InvokeMethodRequest, body io.Reader) (*v1.InvokeMethodResponse, io.ReadCloser, error) {
+	ret := _m.Called(ctx, targetAppID, req, body)
+
+	var r0 *v1.InvokeMethodResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1.InvokeMethodRequest, io.Reader) *v1.InvokeMethodResponse); ok {
+		r0 = rf(ctx, targetAppID, req, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.InvokeMethodResponse)
+		}
+	}
+
+	var r1 io.ReadCloser
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1.InvokeMethodRequest, io.Reader) io.ReadCloser); ok {
+		r1 = rf(ctx, targetAppID, req, body)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *v1.InvokeMethodRequest, io.Reader) error); ok {
+		r2 = rf(ctx, targetAppID, req, body)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ProxyStream provides a mock function with given fields: ctx, targetAppID, fullMethod, stream
+func (_m *MockDirectMessaging) ProxyStream(ctx context.Context, targetAppID string, fullMethod string, stream grpc.ServerStream) error {
+	ret := _m.Called(ctx, targetAppID, fullMethod, stream)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, grpc.ServerStream) error); ok {
+		r0 = rf(ctx, targetAppID, fullMethod, stream)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
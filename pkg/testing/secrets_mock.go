@@ -5,7 +5,10 @@
 
 package testing
 
-import "github.com/dapr/components-contrib/secretstores"
+import (
+	"github.com/dapr/components-contrib/secretstores"
+	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
+)
 
 type FakeSecretStore struct {
 }
@@ -19,6 +22,28 @@ func (c FakeSecretStore) GetSecret(req secretstores.GetSecretRequest) (secretsto
 	return secretstores.GetSecretResponse{}, nil
 }
 
+func (c FakeSecretStore) BulkGetSecret(req secretstores_loader.BulkGetSecretRequest) (secretstores_loader.BulkGetSecretResponse, error) {
+	return secretstores_loader.BulkGetSecretResponse{
+		Data: map[string]map[string]string{
+			"good-key":    {"good-key": "life is good"},
+			"good-key-2":  {"good-key-2": "life is still good"},
+			"not-allowed": {"not-allowed": "life is bad"},
+		},
+	}, nil
+}
+
 func (c FakeSecretStore) Init(metadata secretstores.Metadata) error {
 	return nil
 }
+
+// FakeSingleSecretStore is a secret store that can only return secrets by name, like the stores of components-contrib
+type FakeSingleSecretStore struct {
+}
+
+func (c FakeSingleSecretStore) GetSecret(req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	return FakeSecretStore{}.GetSecret(req)
+}
+
+func (c FakeSingleSecretStore) Init(metadata secretstores.Metadata) error {
+	return nil
+}
@@ -10,8 +10,15 @@ import (
 const (
 	// HostIPEnvVar is the environment variable to override host's chosen IP address.
 	HostIPEnvVar = "DAPR_HOST_IP"
+	// ZoneEnvVar is the environment variable with the locality zone of the host.
+	ZoneEnvVar = "DAPR_ZONE"
 )
 
+// GetZone returns the locality zone of the host, or an empty string if it is unknown.
+func GetZone() string {
+	return os.Getenv(ZoneEnvVar)
+}
+
 // GetHostAddress selects a valid outbound IP address for the host.
 func GetHostAddress() (string, error) {
 	if val, ok := os.LookupEnv(HostIPEnvVar); ok && val != "" {